@@ -2,28 +2,73 @@ package main
 
 import (
 	"log"
+	netmail "net/mail"
 	"os"
 	"os/signal"
 	"syscall"
 
 	// Application layer
+	ingredientCommands "POSFlowBackend/internal/application/ingredient/commands"
+	ingredientQueries "POSFlowBackend/internal/application/ingredient/queries"
+	kitchenCommands "POSFlowBackend/internal/application/kitchen/commands"
+	kitchenQueries "POSFlowBackend/internal/application/kitchen/queries"
+	locationCommands "POSFlowBackend/internal/application/location/commands"
+	locationQueries "POSFlowBackend/internal/application/location/queries"
+	mailCommands "POSFlowBackend/internal/application/mail/commands"
+	mailQueries "POSFlowBackend/internal/application/mail/queries"
 	orderCommands "POSFlowBackend/internal/application/order/commands"
 	orderQueries "POSFlowBackend/internal/application/order/querys"
+	peripheralCommands "POSFlowBackend/internal/application/peripheral/commands"
+	peripheralQueries "POSFlowBackend/internal/application/peripheral/queries"
+	printingCommands "POSFlowBackend/internal/application/printing/commands"
+	printingQueries "POSFlowBackend/internal/application/printing/queries"
 	productCommands "POSFlowBackend/internal/application/product/commands"
 	productQueries "POSFlowBackend/internal/application/product/queries"
+	purchasingCommands "POSFlowBackend/internal/application/purchasing/commands"
+	purchasingQueries "POSFlowBackend/internal/application/purchasing/queries"
+	replenishmentQueries "POSFlowBackend/internal/application/replenishment/queries"
 	salesCommands "POSFlowBackend/internal/application/sales/commands"
 	salesQueries "POSFlowBackend/internal/application/sales/queries"
+	stocktakeCommands "POSFlowBackend/internal/application/stocktake/commands"
+	stocktakeQueries "POSFlowBackend/internal/application/stocktake/queries"
+	tableCommands "POSFlowBackend/internal/application/table/commands"
+	tableQueries "POSFlowBackend/internal/application/table/queries"
+	wasteCommands "POSFlowBackend/internal/application/waste/commands"
+	wasteQueries "POSFlowBackend/internal/application/waste/queries"
+	webhookCommands "POSFlowBackend/internal/application/webhook/commands"
+	webhookQueries "POSFlowBackend/internal/application/webhook/queries"
+	weighingQueries "POSFlowBackend/internal/application/weighing/queries"
 
 	// Domain layer
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/ingredient"
+	"POSFlowBackend/internal/domain/kitchen"
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/mail"
 	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/peripheral"
+	"POSFlowBackend/internal/domain/printing"
+	"POSFlowBackend/internal/domain/purchasing"
+	"POSFlowBackend/internal/domain/replenishment"
+	"POSFlowBackend/internal/domain/stocktake"
+	"POSFlowBackend/internal/domain/table"
+	"POSFlowBackend/internal/domain/waste"
+	"POSFlowBackend/internal/domain/webhook"
+	"POSFlowBackend/internal/domain/weighing"
 
 	// Infrastructure layer
 	"POSFlowBackend/internal/domain/sales"
 	"POSFlowBackend/internal/infrastructure/config"
+	"POSFlowBackend/internal/infrastructure/display"
 	"POSFlowBackend/internal/infrastructure/http"
 	"POSFlowBackend/internal/infrastructure/http/handlers"
 	"POSFlowBackend/internal/infrastructure/http/routes"
+	"POSFlowBackend/internal/infrastructure/mailer"
 	"POSFlowBackend/internal/infrastructure/persistence/sqlite"
+	"POSFlowBackend/internal/infrastructure/printer"
+	"POSFlowBackend/internal/infrastructure/realtime"
+	"POSFlowBackend/internal/infrastructure/scale"
+	webhookSender "POSFlowBackend/internal/infrastructure/webhook"
 )
 
 func main() {
@@ -47,39 +92,411 @@ func main() {
 	productRepo := sqlite.NewProductRepository(database.DB)
 	orderRepo := sqlite.NewOrderRepository(database.DB)
 	salesRepo := sqlite.NewSalesRepository(database.DB)
+	ingredientRepo := sqlite.NewIngredientRepository(database.DB)
+	recipeRepo := sqlite.NewRecipeRepository(database.DB)
+	supplierRepo := sqlite.NewSupplierRepository(database.DB)
+	purchaseOrderRepo := sqlite.NewPurchaseOrderRepository(database.DB)
+	stocktakeRepo := sqlite.NewStocktakeRepository(database.DB)
+	wasteRepo := sqlite.NewWasteRepository(database.DB)
+	locationRepo := sqlite.NewLocationRepository(database.DB)
+	stockLevelRepo := sqlite.NewStockLevelRepository(database.DB)
+	transferRepo := sqlite.NewTransferRepository(database.DB)
+	stationRepo := sqlite.NewStationRepository(database.DB)
+	kitchenPrinterRepo := sqlite.NewKitchenPrinterRepository(database.DB)
+	printJobRepo := sqlite.NewPrintJobRepository(database.DB)
+	outboxRepo := sqlite.NewOutboxRepository(database.DB)
+	webhookSubscriptionRepo := sqlite.NewWebhookSubscriptionRepository(database.DB)
+	webhookDeliveryRepo := sqlite.NewWebhookDeliveryRepository(database.DB)
+	drawerOpeningRepo := sqlite.NewDrawerOpeningRepository(database.DB)
+	emailRepo := sqlite.NewEmailRepository(database.DB)
+	ticketRepo := sqlite.NewTicketRepository(database.DB)
+	tableRepo := sqlite.NewTableRepository(database.DB)
+	orderUnitOfWork := sqlite.NewOrderUnitOfWork(database.DB)
+	stocktakeUnitOfWork := sqlite.NewStocktakeUnitOfWork(database.DB)
+	locationUnitOfWork := sqlite.NewLocationUnitOfWork(database.DB)
+	purchasingUnitOfWork := sqlite.NewPurchasingUnitOfWork(database.DB)
 	log.Println("✅ Repositories initialized")
 
+	// Initialize devices
+	var weighingScale weighing.Scale
+	var scaleSimulator *scale.Simulator
+	switch cfg.ScaleDriver {
+	case "none":
+	case "serial":
+		weighingScale = scale.NewSICSScale(scale.SerialDialer(cfg.ScaleDevice), cfg.ScaleTimeout)
+	case "tcp":
+		weighingScale = scale.NewSICSScale(scale.TCPDialer(cfg.ScaleAddress, cfg.ScaleTimeout), cfg.ScaleTimeout)
+	case "simulator":
+		scaleSimulator = scale.NewSimulator()
+		weighingScale = scale.NewSICSScale(scaleSimulator.Dial, cfg.ScaleTimeout)
+	default:
+		log.Fatalf("❌ Unknown scale driver: %s", cfg.ScaleDriver)
+	}
+
+	var receiptPrinter printing.Printer
+	var receiptDevice *printer.ESCPOSPrinter
+	memoryPrinter := printer.NewMemoryPrinter()
+	switch cfg.PrinterDriver {
+	case "none":
+	case "tcp":
+		receiptDevice = printer.NewESCPOSPrinter(printer.TCPDialer(cfg.PrinterAddress, cfg.PrinterTimeout))
+	case "file":
+		receiptDevice = printer.NewESCPOSPrinter(printer.FileDialer(cfg.PrinterDevice))
+	case "memory":
+		receiptDevice = printer.NewESCPOSPrinter(memoryPrinter.Dial)
+	default:
+		log.Fatalf("❌ Unknown printer driver: %s", cfg.PrinterDriver)
+	}
+	if receiptDevice != nil {
+		receiptPrinter = receiptDevice
+	}
+	receiptPaper, err := printing.ParsePaperWidth(cfg.PrinterPaper)
+	if err != nil {
+		log.Fatalf("❌ Invalid printer paper: %v", err)
+	}
+
+	var cashDrawer peripheral.CashDrawer
+	var drawerSimulator *printer.DrawerSimulator
+	switch cfg.DrawerDriver {
+	case "none":
+	case "printer":
+		if receiptDevice == nil {
+			log.Fatalf("❌ Drawer driver printer needs a receipt printer")
+		}
+		cashDrawer = printer.NewESCPOSDrawer(receiptDevice)
+	case "simulator":
+		drawerSimulator = printer.NewDrawerSimulator()
+		cashDrawer = printer.NewESCPOSDrawer(printer.NewESCPOSPrinter(drawerSimulator.Dial))
+	default:
+		log.Fatalf("❌ Unknown drawer driver: %s", cfg.DrawerDriver)
+	}
+
+	var poleDisplay peripheral.PoleDisplay
+	var displaySimulator *display.Simulator
+	switch cfg.DisplayDriver {
+	case "none":
+	case "serial":
+		poleDisplay = display.NewESCPOSDisplay(display.SerialDialer(cfg.DisplayDevice), cfg.DisplayColumns)
+	case "tcp":
+		poleDisplay = display.NewESCPOSDisplay(display.TCPDialer(cfg.DisplayAddress, cfg.PrinterTimeout), cfg.DisplayColumns)
+	case "simulator":
+		displaySimulator = display.NewSimulator()
+		poleDisplay = display.NewESCPOSDisplay(displaySimulator.Dial, cfg.DisplayColumns)
+	default:
+		log.Fatalf("❌ Unknown display driver: %s", cfg.DisplayDriver)
+	}
+
+	var mailSender mail.Sender
+	switch cfg.MailDriver {
+	case "none":
+	case "smtp":
+		fromName := cfg.MailFromName
+		if fromName == "" {
+			fromName = cfg.StoreName
+		}
+		smtpSender, err := mailer.NewSMTPSender(
+			cfg.SMTPHost,
+			cfg.SMTPPort,
+			mailer.Security(cfg.SMTPSecurity),
+			cfg.SMTPUsername,
+			cfg.SMTPPassword,
+			netmail.Address{Name: fromName, Address: cfg.MailFrom},
+			cfg.MailTimeout,
+		)
+		if err != nil {
+			log.Fatalf("❌ Invalid SMTP settings: %v", err)
+		}
+		mailSender = smtpSender
+	default:
+		log.Fatalf("❌ Unknown mail driver: %s", cfg.MailDriver)
+	}
+	log.Printf("✅ Devices initialized - Scale: %s, Printer: %s, Drawer: %s, Display: %s",
+		cfg.ScaleDriver, cfg.PrinterDriver, cfg.DrawerDriver, cfg.DisplayDriver)
+	log.Printf("✅ Mail initialized - Driver: %s", cfg.MailDriver)
+
+	// Initialize real-time event broker
+	eventBroker := realtime.NewBroker(cfg.EventHistorySize)
+
+	// Initialize outgoing webhooks
+	webhookPolicy, err := webhook.NewRetryPolicy(cfg.WebhookMaxAttempts, cfg.WebhookRetryBase, cfg.WebhookRetryMax)
+	if err != nil {
+		log.Fatalf("❌ Invalid webhook retry policy: %v", err)
+	}
+	webhookService := webhook.NewWebhookService(
+		webhookSubscriptionRepo,
+		webhookDeliveryRepo,
+		webhookSender.NewHTTPSender(cfg.WebhookTimeout),
+		webhookPolicy,
+	)
+
+	// Initialize kitchen chit printing
+	printPolicy, err := printing.NewRetryPolicy(cfg.PrintMaxAttempts, cfg.PrintRetryInterval)
+	if err != nil {
+		log.Fatalf("❌ Invalid print retry policy: %v", err)
+	}
+	kitchenTicketService := printing.NewKitchenTicketService(
+		orderRepo,
+		productRepo,
+		stationRepo,
+		kitchenPrinterRepo,
+		printJobRepo,
+		printer.NewConnector(cfg.PrinterTimeout, memoryPrinter),
+		printPolicy,
+	)
+
+	// Initialize cash drawer and customer display
+	drawerService := peripheral.NewDrawerService(cashDrawer, drawerOpeningRepo)
+	displayService := peripheral.NewDisplayService(poleDisplay, cfg.DisplayGreeting)
+
+	// Initialize emailed receipts
+	mailComposer, err := mailer.NewTemplateComposer(cfg.MailTemplateDir)
+	if err != nil {
+		log.Fatalf("❌ Failed to load email templates: %v", err)
+	}
+	mailPolicy, err := mail.NewRetryPolicy(cfg.MailMaxAttempts, cfg.MailRetryBase, cfg.MailRetryMax)
+	if err != nil {
+		log.Fatalf("❌ Invalid mail retry policy: %v", err)
+	}
+	receiptMailer := mail.NewReceiptMailer(
+		orderRepo,
+		productRepo,
+		emailRepo,
+		mailComposer,
+		mailSender,
+		mail.Branding{
+			Name:        cfg.StoreName,
+			Address:     cfg.StoreAddress,
+			Phone:       cfg.StorePhone,
+			TaxID:       cfg.StoreTaxID,
+			LogoURL:     cfg.StoreLogoURL,
+			AccentColor: cfg.StoreAccentColor,
+			Footer:      cfg.ReceiptFooter,
+		},
+		mailPolicy,
+	)
+
+	// Initialize domain event dispatcher and its subscribers
+	dispatcher := event.NewDispatcher(outboxRepo, cfg.OutboxMaxAttempts)
+	dispatcher.Subscribe(event.TopicStockLow.String(), "realtime", eventBroker.Forward)
+	for _, topic := range webhook.SupportedTopics {
+		dispatcher.Subscribe(topic.String(), "webhooks", webhookService.Enqueue)
+	}
+	dispatcher.Subscribe(event.TopicOrderCreated.String(), "kitchen-chits", kitchenTicketService.PrintNewOrder)
+	dispatcher.Subscribe(event.TopicOrderStatusChanged.String(), "kitchen-voids", kitchenTicketService.PrintVoids)
+	dispatcher.Subscribe(event.TopicOrderCompleted.String(), "cash-drawer", drawerService.OpenForSale)
+	dispatcher.Subscribe(event.TopicOrderCreated.String(), "pole-display-total", displayService.ShowTotal)
+	dispatcher.Subscribe(event.TopicOrderCompleted.String(), "pole-display-paid", displayService.ShowPaid)
+	dispatcher.Subscribe(event.TopicOrderCompleted.String(), "receipt-emails", receiptMailer.SendOnCompletion)
+
 	// Initialize domain services
-	orderService := order.NewOrderService(orderRepo, productRepo)
-	salesService := sales.NewSalesService(salesRepo, orderRepo)
+	inventoryService := ingredient.NewInventoryService(ingredientRepo, recipeRepo, productRepo)
+	stockService := location.NewStockService(locationRepo, stockLevelRepo, transferRepo, productRepo)
+	if _, err := stockService.EnsureDefault(location.LocationID("main"), "Main"); err != nil {
+		log.Fatalf("❌ Failed to create default stock location: %v", err)
+	}
+	transferService := location.NewTransferService(locationUnitOfWork)
+	routingService := kitchen.NewRoutingService(stationRepo)
+	ticketPrefixes, err := order.ParseTicketPrefixes(cfg.TicketPrefixes)
+	if err != nil {
+		log.Fatalf("❌ Invalid ticket prefixes: %v", err)
+	}
+	ticketService := order.NewTicketService(ticketRepo, ticketPrefixes)
+	floorService := table.NewFloorService(tableRepo)
+	orderService := order.NewOrderService(
+		orderUnitOfWork,
+		orderRepo,
+		routingService,
+		ticketService,
+		floorService,
+	)
+	salesService := sales.NewSalesService(salesRepo, orderRepo, productRepo)
+	receivingService := purchasing.NewReceivingService(purchasingUnitOfWork, purchaseOrderRepo, supplierRepo)
+	stocktakeService := stocktake.NewStocktakeService(stocktakeUnitOfWork, stocktakeRepo, productRepo, stockService)
+	wasteService := waste.NewWasteService(wasteRepo, productRepo, inventoryService, stockService, salesService)
+	weighingService := weighing.NewWeighingService(weighingScale, productRepo, cfg.ScaleTimeout)
+	receiptService := printing.NewReceiptService(
+		orderRepo,
+		productRepo,
+		receiptPrinter,
+		receiptPaper,
+		printing.StoreInfo{
+			Name:    cfg.StoreName,
+			Address: cfg.StoreAddress,
+			Phone:   cfg.StorePhone,
+			TaxID:   cfg.StoreTaxID,
+		},
+		cfg.ReceiptFooter,
+	)
+	replenishmentService := replenishment.NewReplenishmentService(orderRepo, productRepo, purchaseOrderRepo, ingredientRepo, inventoryService)
+	reorderPolicy, err := replenishment.NewPolicy(cfg.ReorderWindowDays, cfg.ReorderCoverDays)
+	if err != nil {
+		log.Fatalf("❌ Invalid reorder policy: %v", err)
+	}
 	log.Println("✅ Domain services initialized")
 
 	// Initialize application layer - Product commands
 	createProductCmd := productCommands.NewCreateProductCommand(productRepo)
-	updateProductCmd := productCommands.NewUpdateProductCommand(productRepo)
+	updateProductCmd := productCommands.NewUpdateProductCommand(productRepo, eventBroker)
 	deleteProductCmd := productCommands.NewDeleteProductCommand(productRepo)
-	updateStockCmd := productCommands.NewUpdateStockCommand(productRepo)
+	updateStockCmd := productCommands.NewUpdateStockCommand(locationUnitOfWork, eventBroker)
+	restoreProductCmd := productCommands.NewRestoreProductCommand(productRepo, eventBroker)
+	purgeProductCmd := productCommands.NewPurgeProductCommand(productRepo, orderRepo, purchaseOrderRepo, wasteRepo)
 
 	// Initialize application layer - Product queries
 	listProductsQuery := productQueries.NewListProductsQuery(productRepo)
 	getProductQuery := productQueries.NewGetProductQuery(productRepo)
 	getLowStockQuery := productQueries.NewGetLowStockQuery(productRepo)
+	listArchivedProductsQuery := productQueries.NewListArchivedProductsQuery(productRepo)
+	getCostHistoryQuery := productQueries.NewGetCostHistoryQuery(productRepo)
 
 	// Initialize application layer - Order commands
-	createOrderCmd := orderCommands.NewCreateOrderCommand(orderService, productRepo)
-	updateOrderStatusCmd := orderCommands.NewUpdateOrderStatusCommand(orderRepo, productRepo)
+	createOrderCmd := orderCommands.NewCreateOrderCommand(orderService, productRepo, eventBroker)
+	updateOrderStatusCmd := orderCommands.NewUpdateOrderStatusCommand(orderRepo, productRepo, eventBroker)
+	updateItemStatusCmd := orderCommands.NewUpdateItemStatusCommand(orderRepo, productRepo, eventBroker)
+	moveOrderCmd := orderCommands.NewMoveOrderCommand(orderService, productRepo, eventBroker)
+	mergeOrdersCmd := orderCommands.NewMergeOrdersCommand(orderService, productRepo, eventBroker)
+	splitOrderCmd := orderCommands.NewSplitOrderCommand(orderService, productRepo, eventBroker)
 
 	// Initialize application layer - Order queries
-	listOrdersQuery := orderQueries.NewListOrdersQuery(orderRepo, productRepo)
+	listOrdersQuery := orderQueries.NewListOrdersQuery(orderRepo, productRepo, ticketService)
 	getOrderQuery := orderQueries.NewGetOrderQuery(orderRepo, productRepo)
 	getPendingOrdersQuery := orderQueries.NewGetPendingOrdersQuery(orderRepo, productRepo)
+	getOrderAuditQuery := orderQueries.NewGetOrderAuditQuery(orderRepo)
 
 	// Initialize application layer - Sales commands
-	closeDayCmd := salesCommands.NewCloseDayCommand(salesService)
+	closeDayCmd := salesCommands.NewCloseDayCommand(salesService, ticketService)
 
 	// Initialize application layer - Sales queries
 	getDailySalesQuery := salesQueries.NewGetDailySalesQuery(salesService)
 	getSalesReportQuery := salesQueries.NewGetSalesReportQuery(salesService)
+
+	// Initialize application layer - Ingredient commands
+	createIngredientCmd := ingredientCommands.NewCreateIngredientCommand(ingredientRepo)
+	updateIngredientCmd := ingredientCommands.NewUpdateIngredientCommand(ingredientRepo)
+	updateIngredientStockCmd := ingredientCommands.NewUpdateIngredientStockCommand(ingredientRepo, inventoryService)
+	setRecipeCmd := ingredientCommands.NewSetRecipeCommand(recipeRepo, ingredientRepo, productRepo, inventoryService)
+	deleteRecipeCmd := ingredientCommands.NewDeleteRecipeCommand(recipeRepo, productRepo)
+
+	// Initialize application layer - Ingredient queries
+	listIngredientsQuery := ingredientQueries.NewListIngredientsQuery(ingredientRepo)
+	getIngredientQuery := ingredientQueries.NewGetIngredientQuery(ingredientRepo)
+	getLowStockIngredientsQuery := ingredientQueries.NewGetLowStockIngredientsQuery(ingredientRepo)
+	getRecipeQuery := ingredientQueries.NewGetRecipeQuery(recipeRepo, ingredientRepo, productRepo)
+
+	// Initialize application layer - Purchasing commands
+	createSupplierCmd := purchasingCommands.NewCreateSupplierCommand(supplierRepo)
+	updateSupplierCmd := purchasingCommands.NewUpdateSupplierCommand(supplierRepo)
+	createPurchaseOrderCmd := purchasingCommands.NewCreatePurchaseOrderCommand(purchaseOrderRepo, supplierRepo, productRepo)
+	updatePurchaseOrderCmd := purchasingCommands.NewUpdatePurchaseOrderCommand(purchaseOrderRepo, supplierRepo, productRepo)
+	updatePurchaseOrderStatusCmd := purchasingCommands.NewUpdatePurchaseOrderStatusCommand(purchaseOrderRepo, supplierRepo, productRepo)
+	receivePurchaseOrderCmd := purchasingCommands.NewReceivePurchaseOrderCommand(receivingService, supplierRepo, productRepo)
+
+	// Initialize application layer - Purchasing queries
+	listSuppliersQuery := purchasingQueries.NewListSuppliersQuery(supplierRepo)
+	getSupplierQuery := purchasingQueries.NewGetSupplierQuery(supplierRepo)
+	listPurchaseOrdersQuery := purchasingQueries.NewListPurchaseOrdersQuery(purchaseOrderRepo, supplierRepo, productRepo)
+	getPurchaseOrderQuery := purchasingQueries.NewGetPurchaseOrderQuery(purchaseOrderRepo, supplierRepo, productRepo)
+	getOpenPurchaseOrdersQuery := purchasingQueries.NewGetOpenPurchaseOrdersQuery(receivingService, supplierRepo, productRepo)
+
+	// Initialize application layer - Stocktake commands
+	openStocktakeCmd := stocktakeCommands.NewOpenStocktakeCommand(stocktakeService, productRepo)
+	submitCountsCmd := stocktakeCommands.NewSubmitCountsCommand(stocktakeService, productRepo)
+	finalizeStocktakeCmd := stocktakeCommands.NewFinalizeStocktakeCommand(stocktakeService, productRepo)
+	cancelStocktakeCmd := stocktakeCommands.NewCancelStocktakeCommand(stocktakeRepo, productRepo)
+
+	// Initialize application layer - Stocktake queries
+	listStocktakesQuery := stocktakeQueries.NewListStocktakesQuery(stocktakeRepo, productRepo)
+	getStocktakeQuery := stocktakeQueries.NewGetStocktakeQuery(stocktakeRepo, productRepo)
+	getVarianceReportQuery := stocktakeQueries.NewGetVarianceReportQuery(stocktakeService, productRepo)
+
+	// Initialize application layer - Waste
+	recordWasteCmd := wasteCommands.NewRecordWasteCommand(wasteService, productRepo)
+	writeOffLotCmd := wasteCommands.NewWriteOffLotCommand(wasteService, productRepo)
+	writeOffExpiredLotsCmd := wasteCommands.NewWriteOffExpiredLotsCommand(wasteService, productRepo)
+	listWasteQuery := wasteQueries.NewListWasteQuery(wasteService, productRepo)
+	getWasteReportQuery := wasteQueries.NewGetWasteReportQuery(wasteService)
+
+	// Initialize application layer - Inventory planning
+	getReorderSuggestionsQuery := replenishmentQueries.NewGetReorderSuggestionsQuery(replenishmentService, reorderPolicy)
+	createDraftFromSuggestionsCmd := purchasingCommands.NewCreateDraftFromSuggestionsCommand(
+		replenishmentService,
+		reorderPolicy,
+		purchaseOrderRepo,
+		supplierRepo,
+		productRepo,
+	)
+	receiveLotCmd := productCommands.NewReceiveLotCommand(productRepo)
+	listLotsQuery := productQueries.NewListLotsQuery(productRepo)
+	getExpiringLotsQuery := productQueries.NewGetExpiringLotsQuery(productRepo, cfg.ExpiryWarningDays)
+
+	// Initialize application layer - Locations and transfers
+	createLocationCmd := locationCommands.NewCreateLocationCommand(locationRepo)
+	updateLocationCmd := locationCommands.NewUpdateLocationCommand(locationRepo)
+	setLowStockLevelCmd := locationCommands.NewSetLowStockLevelCommand(stockService)
+	createTransferCmd := locationCommands.NewCreateTransferCommand(transferService, productRepo)
+	listLocationsQuery := locationQueries.NewListLocationsQuery(locationRepo)
+	getLocationQuery := locationQueries.NewGetLocationQuery(locationRepo)
+	getLocationStockQuery := locationQueries.NewGetLocationStockQuery(locationRepo, stockService)
+	getLocationLowStockQuery := locationQueries.NewGetLocationLowStockQuery(locationRepo, stockService)
+	getProductLocationsQuery := locationQueries.NewGetProductLocationsQuery(productRepo, stockService)
+	listTransfersQuery := locationQueries.NewListTransfersQuery(transferRepo, locationRepo, productRepo)
+	getTransferQuery := locationQueries.NewGetTransferQuery(transferRepo, productRepo)
+
+	// Initialize application layer - Weighing scale
+	getWeightQuery := weighingQueries.NewGetWeightQuery(weighingService)
+
+	// Initialize application layer - Kitchen stations
+	createStationCmd := kitchenCommands.NewCreateStationCommand(stationRepo, productRepo)
+	updateStationCmd := kitchenCommands.NewUpdateStationCommand(stationRepo, productRepo)
+	bumpStationCmd := kitchenCommands.NewBumpStationCommand(orderService, stationRepo, orderRepo, eventBroker)
+	listStationsQuery := kitchenQueries.NewListStationsQuery(stationRepo)
+	getStationQuery := kitchenQueries.NewGetStationQuery(stationRepo)
+	getStationQueueQuery := kitchenQueries.NewGetStationQueueQuery(stationRepo, orderService, productRepo)
+
+	// Initialize application layer - Tables
+	createTableCmd := tableCommands.NewCreateTableCommand(tableRepo, orderRepo)
+	updateTableCmd := tableCommands.NewUpdateTableCommand(tableRepo, orderRepo)
+	deleteTableCmd := tableCommands.NewDeleteTableCommand(tableRepo, orderRepo)
+	requestBillCmd := tableCommands.NewRequestBillCommand(tableRepo, orderRepo)
+	listTablesQuery := tableQueries.NewListTablesQuery(tableRepo, orderRepo)
+	getTableQuery := tableQueries.NewGetTableQuery(tableRepo, orderRepo)
+
+	// Initialize application layer - Receipts
+	previewReceiptQuery := printingQueries.NewPreviewReceiptQuery(receiptService)
+	printReceiptCmd := printingCommands.NewPrintReceiptCommand(receiptService)
+
+	// Initialize application layer - Kitchen printers
+	createKitchenPrinterCmd := printingCommands.NewCreateKitchenPrinterCommand(kitchenPrinterRepo, stationRepo)
+	updateKitchenPrinterCmd := printingCommands.NewUpdateKitchenPrinterCommand(kitchenPrinterRepo, stationRepo)
+	deleteKitchenPrinterCmd := printingCommands.NewDeleteKitchenPrinterCommand(kitchenPrinterRepo)
+	reprintKitchenTicketsCmd := printingCommands.NewReprintKitchenTicketsCommand(kitchenTicketService)
+	retryPrintJobCmd := printingCommands.NewRetryPrintJobCommand(kitchenTicketService)
+	listKitchenPrintersQuery := printingQueries.NewListKitchenPrintersQuery(kitchenPrinterRepo)
+	getKitchenPrinterQuery := printingQueries.NewGetKitchenPrinterQuery(kitchenPrinterRepo)
+	listPrintJobsQuery := printingQueries.NewListPrintJobsQuery(printJobRepo)
+	getPrintJobQuery := printingQueries.NewGetPrintJobQuery(printJobRepo)
+
+	// Initialize application layer - Cash drawer and pole display
+	openDrawerCmd := peripheralCommands.NewOpenDrawerCommand(drawerService)
+	updateDisplayCmd := peripheralCommands.NewUpdateDisplayCommand(displayService)
+	listDrawerOpeningsQuery := peripheralQueries.NewListDrawerOpeningsQuery(drawerService)
+
+	// Initialize application layer - Emailed receipts
+	sendReceiptEmailCmd := mailCommands.NewSendReceiptEmailCommand(receiptMailer)
+	retryEmailCmd := mailCommands.NewRetryEmailCommand(receiptMailer)
+	previewReceiptEmailQuery := mailQueries.NewPreviewReceiptEmailQuery(receiptMailer)
+	listEmailsQuery := mailQueries.NewListEmailsQuery(emailRepo)
+	getEmailQuery := mailQueries.NewGetEmailQuery(emailRepo)
+
+	// Initialize application layer - Webhooks
+	createSubscriptionCmd := webhookCommands.NewCreateSubscriptionCommand(webhookSubscriptionRepo)
+	updateSubscriptionCmd := webhookCommands.NewUpdateSubscriptionCommand(webhookSubscriptionRepo)
+	deleteSubscriptionCmd := webhookCommands.NewDeleteSubscriptionCommand(webhookSubscriptionRepo)
+	redeliverCmd := webhookCommands.NewRedeliverCommand(webhookService, webhookDeliveryRepo)
+	listSubscriptionsQuery := webhookQueries.NewListSubscriptionsQuery(webhookSubscriptionRepo)
+	getSubscriptionQuery := webhookQueries.NewGetSubscriptionQuery(webhookSubscriptionRepo)
+	listDeliveriesQuery := webhookQueries.NewListDeliveriesQuery(webhookSubscriptionRepo, webhookDeliveryRepo)
+	getDeliveryQuery := webhookQueries.NewGetDeliveryQuery(webhookDeliveryRepo)
 	log.Println("✅ Application layer initialized")
 
 	// Initialize HTTP handlers (Interfaces layer)
@@ -91,14 +508,23 @@ func main() {
 		listProductsQuery,
 		getProductQuery,
 		getLowStockQuery,
+		restoreProductCmd,
+		purgeProductCmd,
+		listArchivedProductsQuery,
+		getCostHistoryQuery,
 	)
 
 	orderHandler := handlers.NewOrderHandler(
 		createOrderCmd,
 		updateOrderStatusCmd,
+		updateItemStatusCmd,
+		moveOrderCmd,
+		mergeOrdersCmd,
+		splitOrderCmd,
 		listOrdersQuery,
 		getOrderQuery,
 		getPendingOrdersQuery,
+		getOrderAuditQuery,
 	)
 
 	salesHandler := handlers.NewSalesHandler( // ← Agregar
@@ -107,19 +533,182 @@ func main() {
 		closeDayCmd,
 	)
 
+	ingredientHandler := handlers.NewIngredientHandler(
+		createIngredientCmd,
+		updateIngredientCmd,
+		updateIngredientStockCmd,
+		setRecipeCmd,
+		deleteRecipeCmd,
+		listIngredientsQuery,
+		getIngredientQuery,
+		getLowStockIngredientsQuery,
+		getRecipeQuery,
+	)
+
+	purchasingHandler := handlers.NewPurchasingHandler(
+		createSupplierCmd,
+		updateSupplierCmd,
+		createPurchaseOrderCmd,
+		updatePurchaseOrderCmd,
+		updatePurchaseOrderStatusCmd,
+		receivePurchaseOrderCmd,
+		listSuppliersQuery,
+		getSupplierQuery,
+		listPurchaseOrdersQuery,
+		getPurchaseOrderQuery,
+		getOpenPurchaseOrdersQuery,
+	)
+
+	stocktakeHandler := handlers.NewStocktakeHandler(
+		openStocktakeCmd,
+		submitCountsCmd,
+		finalizeStocktakeCmd,
+		cancelStocktakeCmd,
+		listStocktakesQuery,
+		getStocktakeQuery,
+		getVarianceReportQuery,
+	)
+
+	wasteHandler := handlers.NewWasteHandler(
+		recordWasteCmd,
+		writeOffLotCmd,
+		writeOffExpiredLotsCmd,
+		listWasteQuery,
+		getWasteReportQuery,
+	)
+
+	inventoryHandler := handlers.NewInventoryHandler(
+		getReorderSuggestionsQuery,
+		createDraftFromSuggestionsCmd,
+		receiveLotCmd,
+		listLotsQuery,
+		getExpiringLotsQuery,
+	)
+
+	locationHandler := handlers.NewLocationHandler(
+		createLocationCmd,
+		updateLocationCmd,
+		setLowStockLevelCmd,
+		createTransferCmd,
+		listLocationsQuery,
+		getLocationQuery,
+		getLocationStockQuery,
+		getLocationLowStockQuery,
+		getProductLocationsQuery,
+		listTransfersQuery,
+		getTransferQuery,
+	)
+
+	scaleHandler := handlers.NewScaleHandler(
+		getWeightQuery,
+		scaleSimulator,
+	)
+
+	stationHandler := handlers.NewStationHandler(
+		createStationCmd,
+		updateStationCmd,
+		bumpStationCmd,
+		listStationsQuery,
+		getStationQuery,
+		getStationQueueQuery,
+	)
+
+	tableHandler := handlers.NewTableHandler(
+		createTableCmd,
+		updateTableCmd,
+		deleteTableCmd,
+		requestBillCmd,
+		listTablesQuery,
+		getTableQuery,
+	)
+
+	eventHandler := handlers.NewEventHandler(eventBroker)
+
+	webhookHandler := handlers.NewWebhookHandler(
+		createSubscriptionCmd,
+		updateSubscriptionCmd,
+		deleteSubscriptionCmd,
+		redeliverCmd,
+		listSubscriptionsQuery,
+		getSubscriptionQuery,
+		listDeliveriesQuery,
+		getDeliveryQuery,
+	)
+
+	receiptHandler := handlers.NewReceiptHandler(
+		previewReceiptQuery,
+		printReceiptCmd,
+		memoryPrinter,
+	)
+
+	kitchenPrinterHandler := handlers.NewKitchenPrinterHandler(
+		createKitchenPrinterCmd,
+		updateKitchenPrinterCmd,
+		deleteKitchenPrinterCmd,
+		reprintKitchenTicketsCmd,
+		retryPrintJobCmd,
+		listKitchenPrintersQuery,
+		getKitchenPrinterQuery,
+		listPrintJobsQuery,
+		getPrintJobQuery,
+	)
+
+	peripheralHandler := handlers.NewPeripheralHandler(
+		openDrawerCmd,
+		updateDisplayCmd,
+		listDrawerOpeningsQuery,
+		drawerSimulator,
+		displaySimulator,
+	)
+
+	mailHandler := handlers.NewMailHandler(
+		sendReceiptEmailCmd,
+		retryEmailCmd,
+		previewReceiptEmailQuery,
+		listEmailsQuery,
+		getEmailQuery,
+	)
+
 	log.Println("✅ HTTP handlers initialized")
 
 	// Initialize HTTP server
 	server := http.NewServer(cfg.ServerPort)
 
 	// Register routes
-	routes.RegisterRoutes(server.Router(), productHandler, orderHandler, salesHandler)
+	routes.RegisterRoutes(
+		server.Router(),
+		productHandler,
+		orderHandler,
+		salesHandler,
+		ingredientHandler,
+		purchasingHandler,
+		stocktakeHandler,
+		wasteHandler,
+		inventoryHandler,
+		locationHandler,
+		scaleHandler,
+		stationHandler,
+		eventHandler,
+		webhookHandler,
+		receiptHandler,
+		kitchenPrinterHandler,
+		peripheralHandler,
+		mailHandler,
+		tableHandler,
+	)
 	log.Println("✅ Routes registered")
 
 	// Setup graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
+	// Deliver domain events in the background
+	stopDispatcher := make(chan struct{})
+	go dispatcher.Run(cfg.OutboxPollInterval, stopDispatcher)
+	go webhookService.Run(cfg.WebhookPollInterval, stopDispatcher)
+	go kitchenTicketService.Run(cfg.PrintPollInterval, stopDispatcher)
+	go receiptMailer.Run(cfg.MailPollInterval, stopDispatcher)
+
 	// Start server in a goroutine
 	go func() {
 		if err := server.Start(); err != nil {
@@ -130,5 +719,6 @@ func main() {
 	// Wait for interrupt signal
 	<-quit
 	log.Println("🛑 Shutting down server...")
+	close(stopDispatcher)
 	log.Println("✅ Server stopped gracefully")
 }
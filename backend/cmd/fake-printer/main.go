@@ -0,0 +1,58 @@
+// Command fake-printer is a local stand-in for a network receipt printer:
+// it accepts raw ESC/POS jobs on a TCP port, the way printers listen on
+// port 9100, and shows each job as the text it would have printed.
+package main
+
+import (
+	"flag"
+	"io"
+	"log"
+	"net"
+	"os"
+
+	"POSFlowBackend/internal/infrastructure/printer"
+)
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:9100", "address to listen on")
+	out := flag.String("out", "", "file the raw jobs are appended to (optional)")
+	flag.Parse()
+
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("❌ Failed to listen: %v", err)
+	}
+	log.Printf("🖨️  Fake printer listening on %s", *addr)
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			log.Printf("Error accepting job: %v", err)
+			continue
+		}
+		go receive(conn, *out)
+	}
+}
+
+func receive(conn net.Conn, out string) {
+	defer conn.Close()
+
+	job, err := io.ReadAll(conn)
+	if err != nil {
+		log.Printf("Error reading job: %v", err)
+		return
+	}
+	log.Printf("📄 Job of %d bytes from %s:\n%s", len(job), conn.RemoteAddr(), printer.PlainText(job))
+
+	if out != "" {
+		file, err := os.OpenFile(out, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+		if err != nil {
+			log.Printf("Error saving job: %v", err)
+			return
+		}
+		defer file.Close()
+		if _, err := file.Write(job); err != nil {
+			log.Printf("Error saving job: %v", err)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Command smtp-sink is a local stand-in for a mail server: it speaks just
+// enough SMTP to accept messages, logs them and optionally saves each one
+// as an .eml file. Recipients can be refused to try out failed deliveries.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"mime"
+	"net"
+	netmail "net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+var received atomic.Int64
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:2525", "address to listen on")
+	dir := flag.String("dir", "", "directory the messages are saved to as .eml files (optional)")
+	reject := flag.String("reject", "", "comma-separated recipients refused with 550")
+	tempfail := flag.Bool("tempfail", false, "answer every message with 451, as a server in trouble would")
+	flag.Parse()
+
+	refused := map[string]bool{}
+	for _, r := range strings.Split(*reject, ",") {
+		if r = strings.TrimSpace(strings.ToLower(r)); r != "" {
+			refused[r] = true
+		}
+	}
+
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("❌ Failed to listen: %v", err)
+	}
+	log.Printf("📮 SMTP sink listening on %s", *addr)
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			log.Printf("Error accepting connection: %v", err)
+			continue
+		}
+		go serve(conn, *dir, refused, *tempfail)
+	}
+}
+
+func serve(conn net.Conn, dir string, refused map[string]bool, tempfail bool) {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(time.Minute))
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { fmt.Fprintf(conn, "%s\r\n", line) }
+	reply("220 smtp-sink ready")
+
+	var from string
+	var to []string
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+		switch verb {
+		case "EHLO":
+			reply("250-smtp-sink")
+			reply("250 8BITMIME")
+		case "HELO", "NOOP":
+			reply("250 OK")
+		case "RSET":
+			from, to = "", nil
+			reply("250 OK")
+		case "MAIL":
+			from = address(line)
+			reply("250 OK")
+		case "RCPT":
+			rcpt := address(line)
+			if refused[strings.ToLower(rcpt)] {
+				reply("550 5.1.1 Mailbox unavailable")
+				continue
+			}
+			to = append(to, rcpt)
+			reply("250 OK")
+		case "DATA":
+			if len(to) == 0 {
+				reply("503 5.5.1 No valid recipients")
+				continue
+			}
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			data, err := readData(reader)
+			if err != nil {
+				return
+			}
+			if tempfail {
+				reply("451 4.3.0 Try again later")
+				continue
+			}
+			store(data, from, to, dir)
+			reply("250 OK queued")
+			from, to = "", nil
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 5.5.2 Command not implemented")
+		}
+	}
+}
+
+// address extracts the path from "MAIL FROM:<a@b>" or "RCPT TO:<a@b>"
+func address(line string) string {
+	start, end := strings.Index(line, "<"), strings.LastIndex(line, ">")
+	if start < 0 || end <= start {
+		return ""
+	}
+	return line[start+1 : end]
+}
+
+// readData reads the message up to the lone dot, undoing dot-stuffing
+func readData(reader *bufio.Reader) (string, error) {
+	var b strings.Builder
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+		if strings.TrimRight(line, "\r\n") == "." {
+			return b.String(), nil
+		}
+		b.WriteString(strings.TrimPrefix(line, "."))
+	}
+}
+
+func store(data, from string, to []string, dir string) {
+	n := received.Add(1)
+
+	subject := ""
+	if msg, err := netmail.ReadMessage(strings.NewReader(data)); err == nil {
+		subject = msg.Header.Get("Subject")
+		if decoded, err := new(mime.WordDecoder).DecodeHeader(subject); err == nil {
+			subject = decoded
+		}
+	}
+	log.Printf("✉️  Message %d from %s to %s (%d bytes): %s", n, from, strings.Join(to, ", "), len(data), subject)
+
+	if dir != "" {
+		path := filepath.Join(dir, fmt.Sprintf("%d-%06d.eml", time.Now().Unix(), n))
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			log.Printf("Error saving message: %v", err)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Command webhook-receiver is a local endpoint for trying out outgoing
+// webhooks: it verifies each delivery's signature, logs the event and
+// answers with a configurable status code to exercise retries.
+package main
+
+import (
+	"flag"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"POSFlowBackend/internal/domain/webhook"
+)
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:9900", "address to listen on")
+	secret := flag.String("secret", "", "subscription secret used to verify signatures (skipped when empty)")
+	status := flag.Int("status", http.StatusOK, "status code returned for every delivery")
+	flag.Parse()
+
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if *secret != "" {
+			if err := webhook.Verify(*secret, r.Header.Get(webhook.SignatureHeader), body, 5*time.Minute, time.Now()); err != nil {
+				log.Printf("❌ %s %s rejected: %v", r.Header.Get(webhook.DeliveryHeader), r.Header.Get(webhook.EventHeader), err)
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+		}
+
+		log.Printf("📨 %s %s (replying %d): %s", r.Header.Get(webhook.DeliveryHeader), r.Header.Get(webhook.EventHeader), *status, body)
+		w.WriteHeader(*status)
+	})
+
+	log.Printf("🚀 Webhook receiver listening on %s", *addr)
+	if err := http.ListenAndServe(*addr, nil); err != nil {
+		log.Fatalf("❌ Failed to start receiver: %v", err)
+	}
+}
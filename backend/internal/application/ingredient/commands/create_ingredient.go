@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/ingredient/dto"
+	"POSFlowBackend/internal/domain/ingredient"
+	"POSFlowBackend/internal/domain/shared"
+
+	"github.com/google/uuid"
+)
+
+type CreateIngredientCommand struct {
+	repo ingredient.IngredientRepository
+}
+
+func NewCreateIngredientCommand(repo ingredient.IngredientRepository) *CreateIngredientCommand {
+	return &CreateIngredientCommand{repo: repo}
+}
+
+func (c *CreateIngredientCommand) Execute(req dto.CreateIngredientRequest) (*dto.IngredientResponse, error) {
+	// Generate ID
+	id := ingredient.IngredientID(uuid.New().String())
+
+	// Create ingredient entity using domain factory
+	ing, err := ingredient.NewIngredient(
+		id,
+		req.Name,
+		shared.UnitOfMeasure(req.Unit),
+		req.Stock,
+		req.LowStockLevel,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to repository
+	if err := c.repo.Save(ing); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return &dto.IngredientResponse{
+		ID:            ing.ID().String(),
+		Name:          ing.Name(),
+		Unit:          string(ing.Unit()),
+		Stock:         ing.Stock(),
+		LowStockLevel: ing.LowStockLevel(),
+		IsLowStock:    ing.IsLowStock(),
+		IsOutOfStock:  ing.IsOutOfStock(),
+		CreatedAt:     ing.CreatedAt(),
+		UpdatedAt:     ing.UpdatedAt(),
+	}, nil
+}
@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/domain/ingredient"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type DeleteRecipeCommand struct {
+	recipeRepo  ingredient.RecipeRepository
+	productRepo product.ProductRepository
+}
+
+func NewDeleteRecipeCommand(
+	recipeRepo ingredient.RecipeRepository,
+	productRepo product.ProductRepository,
+) *DeleteRecipeCommand {
+	return &DeleteRecipeCommand{
+		recipeRepo:  recipeRepo,
+		productRepo: productRepo,
+	}
+}
+
+// Execute removes the recipe of a product, which goes back to its own stock counter
+func (c *DeleteRecipeCommand) Execute(productID string) error {
+	// Find recipe first to ensure it exists
+	recipe, err := c.recipeRepo.FindByProduct(shared.ProductID(productID))
+	if err != nil {
+		return err
+	}
+
+	if err := c.recipeRepo.Delete(recipe.ProductID()); err != nil {
+		return err
+	}
+
+	// Without a recipe, ingredients no longer make the product unavailable
+	prod, err := c.productRepo.FindByID(recipe.ProductID())
+	if err != nil {
+		return err
+	}
+	if !prod.IsAvailable() {
+		prod.MarkAvailable()
+		return c.productRepo.Save(prod)
+	}
+
+	return nil
+}
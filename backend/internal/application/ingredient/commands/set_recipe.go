@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/ingredient/dto"
+	"POSFlowBackend/internal/domain/ingredient"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type SetRecipeCommand struct {
+	recipeRepo     ingredient.RecipeRepository
+	ingredientRepo ingredient.IngredientRepository
+	productRepo    product.ProductRepository
+	inventory      *ingredient.InventoryService
+}
+
+func NewSetRecipeCommand(
+	recipeRepo ingredient.RecipeRepository,
+	ingredientRepo ingredient.IngredientRepository,
+	productRepo product.ProductRepository,
+	inventory *ingredient.InventoryService,
+) *SetRecipeCommand {
+	return &SetRecipeCommand{
+		recipeRepo:     recipeRepo,
+		ingredientRepo: ingredientRepo,
+		productRepo:    productRepo,
+		inventory:      inventory,
+	}
+}
+
+// Execute replaces the recipe of a product
+func (c *SetRecipeCommand) Execute(productID string, req dto.SetRecipeRequest) (*dto.RecipeResponse, error) {
+	// Find product
+	prod, err := c.productRepo.FindByID(shared.ProductID(productID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure every ingredient exists
+	var lines []ingredient.RecipeLine
+	for _, line := range req.Lines {
+		ing, err := c.ingredientRepo.FindByID(ingredient.IngredientID(line.IngredientID))
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, ingredient.RecipeLine{
+			IngredientID: ing.ID(),
+			Quantity:     line.Quantity,
+		})
+	}
+
+	// Create recipe entity using domain factory
+	recipe, err := ingredient.NewRecipe(prod.ID(), lines)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to repository
+	if err := c.recipeRepo.Save(recipe); err != nil {
+		return nil, err
+	}
+
+	// The product is available only if every ingredient is in stock
+	if err := c.inventory.RefreshProductAvailability(recipe); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return c.mapToDTO(recipe)
+}
+
+func (c *SetRecipeCommand) mapToDTO(recipe *ingredient.Recipe) (*dto.RecipeResponse, error) {
+	prod, err := c.productRepo.FindByIDIncludingArchived(recipe.ProductID())
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []dto.RecipeLineResponse
+	for _, line := range recipe.Lines() {
+		ing, err := c.ingredientRepo.FindByID(line.IngredientID)
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, dto.RecipeLineResponse{
+			IngredientID:   ing.ID().String(),
+			IngredientName: ing.Name(),
+			Unit:           string(ing.Unit()),
+			Quantity:       line.Quantity,
+			InStock:        ing.Stock(),
+		})
+	}
+
+	return &dto.RecipeResponse{
+		ProductID:   prod.ID().String(),
+		ProductName: prod.Name(),
+		Available:   prod.IsAvailable(),
+		Lines:       lines,
+		UpdatedAt:   recipe.UpdatedAt(),
+	}, nil
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/ingredient/dto"
+	"POSFlowBackend/internal/domain/ingredient"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type UpdateIngredientCommand struct {
+	repo ingredient.IngredientRepository
+}
+
+func NewUpdateIngredientCommand(repo ingredient.IngredientRepository) *UpdateIngredientCommand {
+	return &UpdateIngredientCommand{repo: repo}
+}
+
+func (c *UpdateIngredientCommand) Execute(id string, req dto.UpdateIngredientRequest) (*dto.IngredientResponse, error) {
+	// Find ingredient
+	ing, err := c.repo.FindByID(ingredient.IngredientID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep current values for fields not provided
+	name := req.Name
+	if name == "" {
+		name = ing.Name()
+	}
+
+	unit := shared.UnitOfMeasure(req.Unit)
+	if req.Unit == "" {
+		unit = ing.Unit()
+	}
+
+	lowStockLevel := ing.LowStockLevel()
+	if req.LowStockLevel != nil {
+		lowStockLevel = *req.LowStockLevel
+	}
+
+	if err := ing.UpdateInfo(name, unit, lowStockLevel); err != nil {
+		return nil, err
+	}
+
+	// Save changes
+	if err := c.repo.Save(ing); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return &dto.IngredientResponse{
+		ID:            ing.ID().String(),
+		Name:          ing.Name(),
+		Unit:          string(ing.Unit()),
+		Stock:         ing.Stock(),
+		LowStockLevel: ing.LowStockLevel(),
+		IsLowStock:    ing.IsLowStock(),
+		IsOutOfStock:  ing.IsOutOfStock(),
+		CreatedAt:     ing.CreatedAt(),
+		UpdatedAt:     ing.UpdatedAt(),
+	}, nil
+}
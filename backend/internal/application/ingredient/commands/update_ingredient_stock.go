@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/ingredient/dto"
+	"POSFlowBackend/internal/domain/ingredient"
+)
+
+type UpdateIngredientStockCommand struct {
+	repo      ingredient.IngredientRepository
+	inventory *ingredient.InventoryService
+}
+
+func NewUpdateIngredientStockCommand(
+	repo ingredient.IngredientRepository,
+	inventory *ingredient.InventoryService,
+) *UpdateIngredientStockCommand {
+	return &UpdateIngredientStockCommand{
+		repo:      repo,
+		inventory: inventory,
+	}
+}
+
+func (c *UpdateIngredientStockCommand) Execute(id string, req dto.UpdateIngredientStockRequest) (*dto.IngredientResponse, error) {
+	// Find ingredient
+	ing, err := c.repo.FindByID(ingredient.IngredientID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Update stock based on type
+	switch req.Type {
+	case "add":
+		if err := ing.Restock(req.Quantity); err != nil {
+			return nil, err
+		}
+	case "remove":
+		if err := ing.Consume(req.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	// Save changes
+	if err := c.repo.Save(ing); err != nil {
+		return nil, err
+	}
+
+	// Products using this ingredient may have become (un)available
+	if err := c.inventory.RefreshAvailability([]ingredient.IngredientID{ing.ID()}); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return &dto.IngredientResponse{
+		ID:            ing.ID().String(),
+		Name:          ing.Name(),
+		Unit:          string(ing.Unit()),
+		Stock:         ing.Stock(),
+		LowStockLevel: ing.LowStockLevel(),
+		IsLowStock:    ing.IsLowStock(),
+		IsOutOfStock:  ing.IsOutOfStock(),
+		CreatedAt:     ing.CreatedAt(),
+		UpdatedAt:     ing.UpdatedAt(),
+	}, nil
+}
@@ -0,0 +1,71 @@
+package dto
+
+import "time"
+
+// CreateIngredientRequest - Input DTO for creating an ingredient
+type CreateIngredientRequest struct {
+	Name          string  `json:"name" binding:"required"`
+	Unit          string  `json:"unit" binding:"required,oneof=unit g kg ml l"`
+	Stock         float64 `json:"stock" binding:"gte=0"`
+	LowStockLevel float64 `json:"low_stock_level" binding:"gte=0"`
+}
+
+// UpdateIngredientRequest - Input DTO for updating an ingredient
+type UpdateIngredientRequest struct {
+	Name          string   `json:"name"`
+	Unit          string   `json:"unit" binding:"omitempty,oneof=unit g kg ml l"`
+	LowStockLevel *float64 `json:"low_stock_level" binding:"omitempty,gte=0"`
+}
+
+// UpdateIngredientStockRequest - Input DTO for updating ingredient stock
+type UpdateIngredientStockRequest struct {
+	Quantity float64 `json:"quantity" binding:"required,gt=0"`
+	Type     string  `json:"type" binding:"required,oneof=add remove"`
+}
+
+// IngredientResponse - Output DTO
+type IngredientResponse struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	Unit          string    `json:"unit"`
+	Stock         float64   `json:"stock"`
+	LowStockLevel float64   `json:"low_stock_level"`
+	IsLowStock    bool      `json:"is_low_stock"`
+	IsOutOfStock  bool      `json:"is_out_of_stock"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// IngredientListResponse - Output DTO for list
+type IngredientListResponse struct {
+	Ingredients []*IngredientResponse `json:"ingredients"`
+	Total       int                   `json:"total"`
+}
+
+// SetRecipeRequest - Input DTO for replacing the recipe of a product
+type SetRecipeRequest struct {
+	Lines []RecipeLine `json:"lines" binding:"required,min=1,dive"`
+}
+
+// RecipeLine - quantity of an ingredient, in its unit of measure, per unit of product
+type RecipeLine struct {
+	IngredientID string  `json:"ingredient_id" binding:"required"`
+	Quantity     float64 `json:"quantity" binding:"required,gt=0"`
+}
+
+// RecipeResponse - Output DTO
+type RecipeResponse struct {
+	ProductID   string               `json:"product_id"`
+	ProductName string               `json:"product_name"`
+	Available   bool                 `json:"available"`
+	Lines       []RecipeLineResponse `json:"lines"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+}
+
+type RecipeLineResponse struct {
+	IngredientID   string  `json:"ingredient_id"`
+	IngredientName string  `json:"ingredient_name"`
+	Unit           string  `json:"unit"`
+	Quantity       float64 `json:"quantity"`
+	InStock        float64 `json:"in_stock"`
+}
@@ -0,0 +1,35 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/ingredient/dto"
+	"POSFlowBackend/internal/domain/ingredient"
+)
+
+type GetIngredientQuery struct {
+	repo ingredient.IngredientRepository
+}
+
+func NewGetIngredientQuery(repo ingredient.IngredientRepository) *GetIngredientQuery {
+	return &GetIngredientQuery{repo: repo}
+}
+
+func (q *GetIngredientQuery) Execute(id string) (*dto.IngredientResponse, error) {
+	// Find ingredient
+	ing, err := q.repo.FindByID(ingredient.IngredientID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return &dto.IngredientResponse{
+		ID:            ing.ID().String(),
+		Name:          ing.Name(),
+		Unit:          string(ing.Unit()),
+		Stock:         ing.Stock(),
+		LowStockLevel: ing.LowStockLevel(),
+		IsLowStock:    ing.IsLowStock(),
+		IsOutOfStock:  ing.IsOutOfStock(),
+		CreatedAt:     ing.CreatedAt(),
+		UpdatedAt:     ing.UpdatedAt(),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/ingredient/dto"
+	"POSFlowBackend/internal/domain/ingredient"
+)
+
+type GetLowStockIngredientsQuery struct {
+	repo ingredient.IngredientRepository
+}
+
+func NewGetLowStockIngredientsQuery(repo ingredient.IngredientRepository) *GetLowStockIngredientsQuery {
+	return &GetLowStockIngredientsQuery{repo: repo}
+}
+
+func (q *GetLowStockIngredientsQuery) Execute() (*dto.IngredientListResponse, error) {
+	// Find low stock ingredients
+	ingredients, err := q.repo.FindLowStock()
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	var ingredientResponses []*dto.IngredientResponse
+	for _, ing := range ingredients {
+		ingredientResponses = append(ingredientResponses, &dto.IngredientResponse{
+			ID:            ing.ID().String(),
+			Name:          ing.Name(),
+			Unit:          string(ing.Unit()),
+			Stock:         ing.Stock(),
+			LowStockLevel: ing.LowStockLevel(),
+			IsLowStock:    ing.IsLowStock(),
+			IsOutOfStock:  ing.IsOutOfStock(),
+			CreatedAt:     ing.CreatedAt(),
+			UpdatedAt:     ing.UpdatedAt(),
+		})
+	}
+
+	return &dto.IngredientListResponse{
+		Ingredients: ingredientResponses,
+		Total:       len(ingredientResponses),
+	}, nil
+}
@@ -0,0 +1,68 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/ingredient/dto"
+	"POSFlowBackend/internal/domain/ingredient"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type GetRecipeQuery struct {
+	recipeRepo     ingredient.RecipeRepository
+	ingredientRepo ingredient.IngredientRepository
+	productRepo    product.ProductRepository
+}
+
+func NewGetRecipeQuery(
+	recipeRepo ingredient.RecipeRepository,
+	ingredientRepo ingredient.IngredientRepository,
+	productRepo product.ProductRepository,
+) *GetRecipeQuery {
+	return &GetRecipeQuery{
+		recipeRepo:     recipeRepo,
+		ingredientRepo: ingredientRepo,
+		productRepo:    productRepo,
+	}
+}
+
+func (q *GetRecipeQuery) Execute(productID string) (*dto.RecipeResponse, error) {
+	// Find recipe
+	recipe, err := q.recipeRepo.FindByProduct(shared.ProductID(productID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return q.mapToDTO(recipe)
+}
+
+func (q *GetRecipeQuery) mapToDTO(recipe *ingredient.Recipe) (*dto.RecipeResponse, error) {
+	prod, err := q.productRepo.FindByIDIncludingArchived(recipe.ProductID())
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []dto.RecipeLineResponse
+	for _, line := range recipe.Lines() {
+		ing, err := q.ingredientRepo.FindByID(line.IngredientID)
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, dto.RecipeLineResponse{
+			IngredientID:   ing.ID().String(),
+			IngredientName: ing.Name(),
+			Unit:           string(ing.Unit()),
+			Quantity:       line.Quantity,
+			InStock:        ing.Stock(),
+		})
+	}
+
+	return &dto.RecipeResponse{
+		ProductID:   prod.ID().String(),
+		ProductName: prod.Name(),
+		Available:   prod.IsAvailable(),
+		Lines:       lines,
+		UpdatedAt:   recipe.UpdatedAt(),
+	}, nil
+}
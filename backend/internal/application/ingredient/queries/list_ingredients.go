@@ -0,0 +1,43 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/ingredient/dto"
+	"POSFlowBackend/internal/domain/ingredient"
+)
+
+type ListIngredientsQuery struct {
+	repo ingredient.IngredientRepository
+}
+
+func NewListIngredientsQuery(repo ingredient.IngredientRepository) *ListIngredientsQuery {
+	return &ListIngredientsQuery{repo: repo}
+}
+
+func (q *ListIngredientsQuery) Execute() (*dto.IngredientListResponse, error) {
+	// Find all ingredients
+	ingredients, err := q.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	var ingredientResponses []*dto.IngredientResponse
+	for _, ing := range ingredients {
+		ingredientResponses = append(ingredientResponses, &dto.IngredientResponse{
+			ID:            ing.ID().String(),
+			Name:          ing.Name(),
+			Unit:          string(ing.Unit()),
+			Stock:         ing.Stock(),
+			LowStockLevel: ing.LowStockLevel(),
+			IsLowStock:    ing.IsLowStock(),
+			IsOutOfStock:  ing.IsOutOfStock(),
+			CreatedAt:     ing.CreatedAt(),
+			UpdatedAt:     ing.UpdatedAt(),
+		})
+	}
+
+	return &dto.IngredientListResponse{
+		Ingredients: ingredientResponses,
+		Total:       len(ingredientResponses),
+	}, nil
+}
@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/kitchen/dto"
+	orderDTO "POSFlowBackend/internal/application/order/dto"
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/kitchen"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type BumpStationCommand struct {
+	orderService *order.OrderService
+	stationRepo  kitchen.StationRepository
+	orderRepo    order.OrderRepository
+	publisher    event.Publisher
+}
+
+func NewBumpStationCommand(
+	orderService *order.OrderService,
+	stationRepo kitchen.StationRepository,
+	orderRepo order.OrderRepository,
+	publisher event.Publisher,
+) *BumpStationCommand {
+	return &BumpStationCommand{
+		orderService: orderService,
+		stationRepo:  stationRepo,
+		orderRepo:    orderRepo,
+		publisher:    publisher,
+	}
+}
+
+// Execute marks a station's part of an order as done; the order becomes
+// ready once every station has bumped
+func (c *BumpStationCommand) Execute(stationID, orderID string) (*dto.BumpResponse, error) {
+	// Find station
+	station, err := c.stationRepo.FindByID(kitchen.StationID(stationID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Find order, to report the status it had before the bump
+	before, err := c.orderRepo.FindByID(shared.OrderID(orderID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Bump using domain service
+	ord, err := c.orderService.BumpStation(before.ID(), station.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	// Notify connected clients
+	c.publisher.Publish(event.TopicOrderStatusChanged, mapToStatusChangedEvent(ord, before.Status()))
+
+	// Map to response DTO
+	pending := []string{}
+	for _, id := range ord.PendingStations() {
+		pending = append(pending, id.String())
+	}
+
+	return &dto.BumpResponse{
+		OrderID:         ord.ID().String(),
+		StationID:       station.ID().String(),
+		OrderStatus:     string(ord.Status()),
+		PendingStations: pending,
+	}, nil
+}
+
+// mapToStatusChangedEvent builds the event payload for an order status change
+func mapToStatusChangedEvent(o *order.Order, previousStatus order.OrderStatus) orderDTO.OrderStatusChangedEvent {
+	items := make([]orderDTO.ItemStatusEventEntry, 0, len(o.Items()))
+	for _, item := range o.Items() {
+		items = append(items, orderDTO.ItemStatusEventEntry{
+			Line:      item.Line(),
+			ProductID: item.ProductID().String(),
+			StationID: item.StationID().String(),
+			Status:    string(item.Status()),
+		})
+	}
+
+	return orderDTO.OrderStatusChangedEvent{
+		OrderID:        o.ID().String(),
+		TicketNumber:   o.TicketNumber().String(),
+		TableNumber:    o.TableNumber().String(),
+		PreviousStatus: string(previousStatus),
+		Status:         string(o.Status()),
+		Items:          items,
+		UpdatedAt:      o.UpdatedAt(),
+	}
+}
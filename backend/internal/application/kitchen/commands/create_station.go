@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/kitchen/dto"
+	"POSFlowBackend/internal/domain/kitchen"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+
+	"github.com/google/uuid"
+)
+
+type CreateStationCommand struct {
+	repo        kitchen.StationRepository
+	productRepo product.ProductRepository
+}
+
+func NewCreateStationCommand(repo kitchen.StationRepository, productRepo product.ProductRepository) *CreateStationCommand {
+	return &CreateStationCommand{
+		repo:        repo,
+		productRepo: productRepo,
+	}
+}
+
+func (c *CreateStationCommand) Execute(req dto.CreateStationRequest) (*dto.StationResponse, error) {
+	// Check assigned products exist
+	products, err := parseProductIDs(c.productRepo, req.ProductIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate ID
+	id := kitchen.StationID(uuid.New().String())
+
+	// Create station entity using domain factory
+	station, err := kitchen.NewStation(id, req.Name, products, parseCategories(req.Categories))
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to repository
+	if err := c.repo.Save(station); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapStationToDTO(station), nil
+}
+
+// parseProductIDs converts product ID strings, rejecting unknown products
+func parseProductIDs(productRepo product.ProductRepository, values []string) ([]shared.ProductID, error) {
+	products := make([]shared.ProductID, 0, len(values))
+	for _, value := range values {
+		prod, err := productRepo.FindByID(shared.ProductID(value))
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, prod.ID())
+	}
+	return products, nil
+}
+
+// parseCategories converts category strings; invalid values are rejected by the domain
+func parseCategories(values []string) []product.Category {
+	categories := make([]product.Category, 0, len(values))
+	for _, value := range values {
+		categories = append(categories, product.Category(value))
+	}
+	return categories
+}
+
+func mapStationToDTO(station *kitchen.Station) *dto.StationResponse {
+	productIDs := make([]string, 0, len(station.Products()))
+	for _, productID := range station.Products() {
+		productIDs = append(productIDs, productID.String())
+	}
+
+	categories := make([]string, 0, len(station.Categories()))
+	for _, category := range station.Categories() {
+		categories = append(categories, string(category))
+	}
+
+	return &dto.StationResponse{
+		ID:         station.ID().String(),
+		Name:       station.Name(),
+		ProductIDs: productIDs,
+		Categories: categories,
+		CreatedAt:  station.CreatedAt(),
+		UpdatedAt:  station.UpdatedAt(),
+	}
+}
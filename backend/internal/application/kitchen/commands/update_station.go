@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/kitchen/dto"
+	"POSFlowBackend/internal/domain/kitchen"
+	"POSFlowBackend/internal/domain/product"
+)
+
+type UpdateStationCommand struct {
+	repo        kitchen.StationRepository
+	productRepo product.ProductRepository
+}
+
+func NewUpdateStationCommand(repo kitchen.StationRepository, productRepo product.ProductRepository) *UpdateStationCommand {
+	return &UpdateStationCommand{
+		repo:        repo,
+		productRepo: productRepo,
+	}
+}
+
+func (c *UpdateStationCommand) Execute(id string, req dto.UpdateStationRequest) (*dto.StationResponse, error) {
+	// Find station
+	station, err := c.repo.FindByID(kitchen.StationID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != "" {
+		if err := station.Rename(req.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	// Keep current routing for fields not provided
+	products := station.Products()
+	if req.ProductIDs != nil {
+		products, err = parseProductIDs(c.productRepo, *req.ProductIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	categories := station.Categories()
+	if req.Categories != nil {
+		categories = parseCategories(*req.Categories)
+	}
+
+	if err := station.AssignRouting(products, categories); err != nil {
+		return nil, err
+	}
+
+	// Save changes
+	if err := c.repo.Save(station); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapStationToDTO(station), nil
+}
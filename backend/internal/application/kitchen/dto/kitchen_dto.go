@@ -0,0 +1,70 @@
+package dto
+
+import "time"
+
+// CreateStationRequest - Input DTO for creating a kitchen station
+type CreateStationRequest struct {
+	Name       string   `json:"name" binding:"required"`
+	ProductIDs []string `json:"product_ids"` // products prepared here, taking precedence over categories
+	Categories []string `json:"categories"`  // product categories prepared here
+}
+
+// UpdateStationRequest - Input DTO for updating a station; omitted fields are kept
+type UpdateStationRequest struct {
+	Name       string    `json:"name"`
+	ProductIDs *[]string `json:"product_ids"`
+	Categories *[]string `json:"categories"`
+}
+
+// StationResponse - Output DTO
+type StationResponse struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	ProductIDs []string  `json:"product_ids"`
+	Categories []string  `json:"categories"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// StationListResponse - Output DTO for list
+type StationListResponse struct {
+	Stations []*StationResponse `json:"stations"`
+	Total    int                `json:"total"`
+}
+
+// StationTicketResponse - the part of an order a station has to prepare
+type StationTicketResponse struct {
+	OrderID         string                       `json:"order_id"`
+	TicketNumber    string                       `json:"ticket_number"`
+	TableNumber     string                       `json:"table_number"`
+	OrderStatus     string                       `json:"order_status"`
+	Items           []*StationTicketItemResponse `json:"items"`
+	PendingStations []string                     `json:"pending_stations"` // stations still preparing this order, this one included
+	CreatedAt       time.Time                    `json:"created_at"`
+}
+
+type StationTicketItemResponse struct {
+	Line              int      `json:"line"`
+	ProductID         string   `json:"product_id"`
+	ProductName       string   `json:"product_name"`
+	Quantity          float64  `json:"quantity"`
+	Weighed           bool     `json:"weighed"`
+	Status            string   `json:"status"`
+	AllergenConflicts []string `json:"allergen_conflicts,omitempty"`
+}
+
+// StationQueueResponse - Output DTO for a station's pending queue, oldest first
+type StationQueueResponse struct {
+	StationID   string                   `json:"station_id"`
+	StationName string                   `json:"station_name"`
+	Tickets     []*StationTicketResponse `json:"tickets"`
+	Total       int                      `json:"total"`
+}
+
+// BumpResponse - Output DTO after a station bumps its part of an order
+type BumpResponse struct {
+	OrderID         string   `json:"order_id"`
+	StationID       string   `json:"station_id"`
+	OrderStatus     string   `json:"order_status"`
+	PendingStations []string `json:"pending_stations"`
+}
@@ -0,0 +1,46 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/kitchen/dto"
+	"POSFlowBackend/internal/domain/kitchen"
+)
+
+type GetStationQuery struct {
+	repo kitchen.StationRepository
+}
+
+func NewGetStationQuery(repo kitchen.StationRepository) *GetStationQuery {
+	return &GetStationQuery{repo: repo}
+}
+
+func (q *GetStationQuery) Execute(id string) (*dto.StationResponse, error) {
+	// Find station
+	station, err := q.repo.FindByID(kitchen.StationID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapStationToDTO(station), nil
+}
+
+func mapStationToDTO(station *kitchen.Station) *dto.StationResponse {
+	productIDs := make([]string, 0, len(station.Products()))
+	for _, productID := range station.Products() {
+		productIDs = append(productIDs, productID.String())
+	}
+
+	categories := make([]string, 0, len(station.Categories()))
+	for _, category := range station.Categories() {
+		categories = append(categories, string(category))
+	}
+
+	return &dto.StationResponse{
+		ID:         station.ID().String(),
+		Name:       station.Name(),
+		ProductIDs: productIDs,
+		Categories: categories,
+		CreatedAt:  station.CreatedAt(),
+		UpdatedAt:  station.UpdatedAt(),
+	}
+}
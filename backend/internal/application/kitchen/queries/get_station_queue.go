@@ -0,0 +1,92 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/kitchen/dto"
+	"POSFlowBackend/internal/domain/kitchen"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/product"
+)
+
+type GetStationQueueQuery struct {
+	stationRepo  kitchen.StationRepository
+	orderService *order.OrderService
+	productRepo  product.ProductRepository
+}
+
+func NewGetStationQueueQuery(
+	stationRepo kitchen.StationRepository,
+	orderService *order.OrderService,
+	productRepo product.ProductRepository,
+) *GetStationQueueQuery {
+	return &GetStationQueueQuery{
+		stationRepo:  stationRepo,
+		orderService: orderService,
+		productRepo:  productRepo,
+	}
+}
+
+func (q *GetStationQueueQuery) Execute(id string) (*dto.StationQueueResponse, error) {
+	// Find station
+	station, err := q.stationRepo.FindByID(kitchen.StationID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Find orders waiting on the station
+	orders, err := q.orderService.GetStationQueue(station.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs, keeping only the station's items
+	tickets := []*dto.StationTicketResponse{}
+	for _, ord := range orders {
+		ticket, err := q.mapTicketToDTO(ord, station.ID())
+		if err != nil {
+			return nil, err
+		}
+		tickets = append(tickets, ticket)
+	}
+
+	return &dto.StationQueueResponse{
+		StationID:   station.ID().String(),
+		StationName: station.Name(),
+		Tickets:     tickets,
+		Total:       len(tickets),
+	}, nil
+}
+
+func (q *GetStationQueueQuery) mapTicketToDTO(ord *order.Order, stationID kitchen.StationID) (*dto.StationTicketResponse, error) {
+	var items []*dto.StationTicketItemResponse
+	for _, item := range ord.ItemsForStation(stationID) {
+		prod, err := q.productRepo.FindByIDIncludingArchived(item.ProductID())
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &dto.StationTicketItemResponse{
+			Line:              item.Line(),
+			ProductID:         item.ProductID().String(),
+			ProductName:       prod.Name(),
+			Quantity:          item.Quantity(),
+			Weighed:           item.Weighed(),
+			Status:            string(item.Status()),
+			AllergenConflicts: product.AllergenStrings(item.AllergenConflicts()),
+		})
+	}
+
+	pending := []string{}
+	for _, id := range ord.PendingStations() {
+		pending = append(pending, id.String())
+	}
+
+	return &dto.StationTicketResponse{
+		OrderID:         ord.ID().String(),
+		TicketNumber:    ord.TicketNumber().String(),
+		TableNumber:     ord.TableNumber().String(),
+		OrderStatus:     string(ord.Status()),
+		Items:           items,
+		PendingStations: pending,
+		CreatedAt:       ord.CreatedAt(),
+	}, nil
+}
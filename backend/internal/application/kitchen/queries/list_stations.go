@@ -0,0 +1,33 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/kitchen/dto"
+	"POSFlowBackend/internal/domain/kitchen"
+)
+
+type ListStationsQuery struct {
+	repo kitchen.StationRepository
+}
+
+func NewListStationsQuery(repo kitchen.StationRepository) *ListStationsQuery {
+	return &ListStationsQuery{repo: repo}
+}
+
+func (q *ListStationsQuery) Execute() (*dto.StationListResponse, error) {
+	// Find stations
+	stations, err := q.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	responses := []*dto.StationResponse{}
+	for _, station := range stations {
+		responses = append(responses, mapStationToDTO(station))
+	}
+
+	return &dto.StationListResponse{
+		Stations: responses,
+		Total:    len(responses),
+	}, nil
+}
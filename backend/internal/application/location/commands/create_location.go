@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/location/dto"
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
+
+	"github.com/google/uuid"
+)
+
+type CreateLocationCommand struct {
+	repo location.LocationRepository
+}
+
+func NewCreateLocationCommand(repo location.LocationRepository) *CreateLocationCommand {
+	return &CreateLocationCommand{repo: repo}
+}
+
+func (c *CreateLocationCommand) Execute(req dto.CreateLocationRequest) (*dto.LocationResponse, error) {
+	// Generate ID
+	id := location.LocationID(uuid.New().String())
+
+	// Create location entity using domain factory
+	loc, err := location.NewLocation(id, req.Name, req.Terminals, parseCategories(req.Categories))
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to repository
+	if err := c.repo.Save(loc); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapLocationToDTO(loc), nil
+}
+
+// parseCategories converts category strings; invalid values are rejected by the domain
+func parseCategories(values []string) []product.Category {
+	categories := make([]product.Category, 0, len(values))
+	for _, value := range values {
+		categories = append(categories, product.Category(value))
+	}
+	return categories
+}
+
+func mapLocationToDTO(loc *location.Location) *dto.LocationResponse {
+	categories := make([]string, 0, len(loc.Categories()))
+	for _, category := range loc.Categories() {
+		categories = append(categories, string(category))
+	}
+
+	return &dto.LocationResponse{
+		ID:         loc.ID().String(),
+		Name:       loc.Name(),
+		IsDefault:  loc.IsDefault(),
+		Terminals:  loc.Terminals(),
+		Categories: categories,
+		CreatedAt:  loc.CreatedAt(),
+		UpdatedAt:  loc.UpdatedAt(),
+	}
+}
@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/location/dto"
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+
+	"github.com/google/uuid"
+)
+
+type CreateTransferCommand struct {
+	transferService *location.TransferService
+	productRepo     product.ProductRepository
+}
+
+func NewCreateTransferCommand(
+	transferService *location.TransferService,
+	productRepo product.ProductRepository,
+) *CreateTransferCommand {
+	return &CreateTransferCommand{
+		transferService: transferService,
+		productRepo:     productRepo,
+	}
+}
+
+func (c *CreateTransferCommand) Execute(req dto.CreateTransferRequest) (*dto.TransferResponse, error) {
+	// Generate ID
+	id := location.TransferID(uuid.New().String())
+
+	var lines []location.TransferLine
+	for _, line := range req.Lines {
+		lines = append(lines, location.TransferLine{
+			ProductID: shared.ProductID(line.ProductID),
+			Quantity:  line.Quantity,
+		})
+	}
+
+	// Move stock through the domain service
+	transfer, err := c.transferService.Transfer(
+		id,
+		location.LocationID(req.FromLocationID),
+		location.LocationID(req.ToLocationID),
+		lines,
+		req.Note,
+		req.CreatedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapTransferToDTO(transfer, c.productRepo)
+}
+
+func mapTransferToDTO(transfer *location.Transfer, productRepo product.ProductRepository) (*dto.TransferResponse, error) {
+	var lines []dto.TransferLineResponse
+	for _, line := range transfer.Lines() {
+		prod, err := productRepo.FindByIDIncludingArchived(line.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, dto.TransferLineResponse{
+			ProductID:   line.ProductID.String(),
+			ProductName: prod.Name(),
+			Quantity:    line.Quantity,
+		})
+	}
+
+	return &dto.TransferResponse{
+		ID:             transfer.ID().String(),
+		FromLocationID: transfer.FromLocationID().String(),
+		ToLocationID:   transfer.ToLocationID().String(),
+		Lines:          lines,
+		Note:           transfer.Note(),
+		CreatedBy:      transfer.CreatedBy(),
+		CreatedAt:      transfer.CreatedAt(),
+	}, nil
+}
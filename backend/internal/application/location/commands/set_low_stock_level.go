@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/location/dto"
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type SetLowStockLevelCommand struct {
+	stockService *location.StockService
+}
+
+func NewSetLowStockLevelCommand(stockService *location.StockService) *SetLowStockLevelCommand {
+	return &SetLowStockLevelCommand{stockService: stockService}
+}
+
+func (c *SetLowStockLevelCommand) Execute(locationID, productID string, req dto.SetLowStockLevelRequest) (*dto.StockPositionResponse, error) {
+	// Update threshold through the domain service
+	position, err := c.stockService.SetLowStockLevel(
+		location.LocationID(locationID),
+		shared.ProductID(productID),
+		*req.LowStockLevel,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return &dto.StockPositionResponse{
+		LocationID:    position.LocationID.String(),
+		ProductID:     position.Product.ID().String(),
+		ProductName:   position.Product.Name(),
+		Category:      string(position.Product.Category()),
+		Quantity:      position.Quantity,
+		LowStockLevel: position.LowStockLevel,
+		IsLowStock:    position.IsLowStock(),
+	}, nil
+}
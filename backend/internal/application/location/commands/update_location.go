@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/location/dto"
+	"POSFlowBackend/internal/domain/location"
+)
+
+type UpdateLocationCommand struct {
+	repo location.LocationRepository
+}
+
+func NewUpdateLocationCommand(repo location.LocationRepository) *UpdateLocationCommand {
+	return &UpdateLocationCommand{repo: repo}
+}
+
+func (c *UpdateLocationCommand) Execute(id string, req dto.UpdateLocationRequest) (*dto.LocationResponse, error) {
+	// Find location
+	loc, err := c.repo.FindByID(location.LocationID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != "" {
+		if err := loc.Rename(req.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	// Keep current routing for fields not provided
+	terminals := loc.Terminals()
+	if req.Terminals != nil {
+		terminals = *req.Terminals
+	}
+	categories := loc.Categories()
+	if req.Categories != nil {
+		categories = parseCategories(*req.Categories)
+	}
+
+	if err := loc.AssignRouting(terminals, categories); err != nil {
+		return nil, err
+	}
+
+	// Save changes
+	if err := c.repo.Save(loc); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapLocationToDTO(loc), nil
+}
@@ -0,0 +1,108 @@
+package dto
+
+import "time"
+
+// CreateLocationRequest - Input DTO for creating a stock location
+type CreateLocationRequest struct {
+	Name       string   `json:"name" binding:"required"`
+	Terminals  []string `json:"terminals"`  // terminals that sell from this location
+	Categories []string `json:"categories"` // product categories sold from this location
+}
+
+// UpdateLocationRequest - Input DTO for updating a location; omitted fields are kept
+type UpdateLocationRequest struct {
+	Name       string    `json:"name"`
+	Terminals  *[]string `json:"terminals"`
+	Categories *[]string `json:"categories"`
+}
+
+// SetLowStockLevelRequest - Input DTO for the low stock threshold of a product at a location
+type SetLowStockLevelRequest struct {
+	LowStockLevel *float64 `json:"low_stock_level" binding:"required,gte=0"`
+}
+
+// CreateTransferRequest - Input DTO for moving stock between locations
+type CreateTransferRequest struct {
+	FromLocationID string         `json:"from_location_id" binding:"required"`
+	ToLocationID   string         `json:"to_location_id" binding:"required"`
+	Lines          []TransferLine `json:"lines" binding:"required,min=1,dive"`
+	Note           string         `json:"note"`
+	CreatedBy      string         `json:"created_by" binding:"required"`
+}
+
+type TransferLine struct {
+	ProductID string  `json:"product_id" binding:"required"`
+	Quantity  float64 `json:"quantity" binding:"required,gt=0"`
+}
+
+// ListTransfersRequest - Query parameters for transfer history
+type ListTransfersRequest struct {
+	LocationID string `form:"location_id"`
+}
+
+// LocationResponse - Output DTO
+type LocationResponse struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	IsDefault  bool      `json:"is_default"`
+	Terminals  []string  `json:"terminals"`
+	Categories []string  `json:"categories"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// LocationListResponse - Output DTO for list
+type LocationListResponse struct {
+	Locations []*LocationResponse `json:"locations"`
+	Total     int                 `json:"total"`
+}
+
+// StockPositionResponse - stock of a product held at a location
+type StockPositionResponse struct {
+	LocationID    string  `json:"location_id"`
+	ProductID     string  `json:"product_id"`
+	ProductName   string  `json:"product_name"`
+	Category      string  `json:"category"`
+	Quantity      float64 `json:"quantity"`
+	LowStockLevel float64 `json:"low_stock_level"`
+	IsLowStock    bool    `json:"is_low_stock"`
+}
+
+// LocationStockResponse - Output DTO for the stock held at a location
+type LocationStockResponse struct {
+	LocationID   string                  `json:"location_id"`
+	LocationName string                  `json:"location_name"`
+	Positions    []StockPositionResponse `json:"positions"`
+	Total        int                     `json:"total"`
+}
+
+// ProductLocationsResponse - Output DTO for the stock of a product across locations
+type ProductLocationsResponse struct {
+	ProductID   string                  `json:"product_id"`
+	ProductName string                  `json:"product_name"`
+	TotalStock  float64                 `json:"total_stock"`
+	Positions   []StockPositionResponse `json:"positions"`
+}
+
+// TransferResponse - Output DTO
+type TransferResponse struct {
+	ID             string                 `json:"id"`
+	FromLocationID string                 `json:"from_location_id"`
+	ToLocationID   string                 `json:"to_location_id"`
+	Lines          []TransferLineResponse `json:"lines"`
+	Note           string                 `json:"note,omitempty"`
+	CreatedBy      string                 `json:"created_by"`
+	CreatedAt      time.Time              `json:"created_at"`
+}
+
+type TransferLineResponse struct {
+	ProductID   string  `json:"product_id"`
+	ProductName string  `json:"product_name"`
+	Quantity    float64 `json:"quantity"`
+}
+
+// TransferListResponse - Output DTO for list
+type TransferListResponse struct {
+	Transfers []*TransferResponse `json:"transfers"`
+	Total     int                 `json:"total"`
+}
@@ -0,0 +1,42 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/location/dto"
+	"POSFlowBackend/internal/domain/location"
+)
+
+type GetLocationQuery struct {
+	repo location.LocationRepository
+}
+
+func NewGetLocationQuery(repo location.LocationRepository) *GetLocationQuery {
+	return &GetLocationQuery{repo: repo}
+}
+
+func (q *GetLocationQuery) Execute(id string) (*dto.LocationResponse, error) {
+	// Find location
+	loc, err := q.repo.FindByID(location.LocationID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapLocationToDTO(loc), nil
+}
+
+func mapLocationToDTO(loc *location.Location) *dto.LocationResponse {
+	categories := make([]string, 0, len(loc.Categories()))
+	for _, category := range loc.Categories() {
+		categories = append(categories, string(category))
+	}
+
+	return &dto.LocationResponse{
+		ID:         loc.ID().String(),
+		Name:       loc.Name(),
+		IsDefault:  loc.IsDefault(),
+		Terminals:  loc.Terminals(),
+		Categories: categories,
+		CreatedAt:  loc.CreatedAt(),
+		UpdatedAt:  loc.UpdatedAt(),
+	}
+}
@@ -0,0 +1,38 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/location/dto"
+	"POSFlowBackend/internal/domain/location"
+)
+
+type GetLocationLowStockQuery struct {
+	locationRepo location.LocationRepository
+	stockService *location.StockService
+}
+
+func NewGetLocationLowStockQuery(
+	locationRepo location.LocationRepository,
+	stockService *location.StockService,
+) *GetLocationLowStockQuery {
+	return &GetLocationLowStockQuery{
+		locationRepo: locationRepo,
+		stockService: stockService,
+	}
+}
+
+func (q *GetLocationLowStockQuery) Execute(id string) (*dto.LocationStockResponse, error) {
+	// Find location
+	loc, err := q.locationRepo.FindByID(location.LocationID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Get low stock positions through the domain service
+	positions, err := q.stockService.GetLowStock(loc.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapLocationStockToDTO(loc, positions), nil
+}
@@ -0,0 +1,64 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/location/dto"
+	"POSFlowBackend/internal/domain/location"
+)
+
+type GetLocationStockQuery struct {
+	locationRepo location.LocationRepository
+	stockService *location.StockService
+}
+
+func NewGetLocationStockQuery(
+	locationRepo location.LocationRepository,
+	stockService *location.StockService,
+) *GetLocationStockQuery {
+	return &GetLocationStockQuery{
+		locationRepo: locationRepo,
+		stockService: stockService,
+	}
+}
+
+func (q *GetLocationStockQuery) Execute(id string) (*dto.LocationStockResponse, error) {
+	// Find location
+	loc, err := q.locationRepo.FindByID(location.LocationID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Get stock positions through the domain service
+	positions, err := q.stockService.GetPositions(loc.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapLocationStockToDTO(loc, positions), nil
+}
+
+func mapLocationStockToDTO(loc *location.Location, positions []location.StockPosition) *dto.LocationStockResponse {
+	responses := make([]dto.StockPositionResponse, 0, len(positions))
+	for _, position := range positions {
+		responses = append(responses, mapStockPositionToDTO(position))
+	}
+
+	return &dto.LocationStockResponse{
+		LocationID:   loc.ID().String(),
+		LocationName: loc.Name(),
+		Positions:    responses,
+		Total:        len(responses),
+	}
+}
+
+func mapStockPositionToDTO(position location.StockPosition) dto.StockPositionResponse {
+	return dto.StockPositionResponse{
+		LocationID:    position.LocationID.String(),
+		ProductID:     position.Product.ID().String(),
+		ProductName:   position.Product.Name(),
+		Category:      string(position.Product.Category()),
+		Quantity:      position.Quantity,
+		LowStockLevel: position.LowStockLevel,
+		IsLowStock:    position.IsLowStock(),
+	}
+}
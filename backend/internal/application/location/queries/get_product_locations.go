@@ -0,0 +1,50 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/location/dto"
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type GetProductLocationsQuery struct {
+	productRepo  product.ProductRepository
+	stockService *location.StockService
+}
+
+func NewGetProductLocationsQuery(
+	productRepo product.ProductRepository,
+	stockService *location.StockService,
+) *GetProductLocationsQuery {
+	return &GetProductLocationsQuery{
+		productRepo:  productRepo,
+		stockService: stockService,
+	}
+}
+
+func (q *GetProductLocationsQuery) Execute(productID string) (*dto.ProductLocationsResponse, error) {
+	// Find product
+	prod, err := q.productRepo.FindByID(shared.ProductID(productID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Get stock positions through the domain service
+	positions, err := q.stockService.GetProductPositions(prod.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	responses := make([]dto.StockPositionResponse, 0, len(positions))
+	for _, position := range positions {
+		responses = append(responses, mapStockPositionToDTO(position))
+	}
+
+	return &dto.ProductLocationsResponse{
+		ProductID:   prod.ID().String(),
+		ProductName: prod.Name(),
+		TotalStock:  prod.Stock(),
+		Positions:   responses,
+	}, nil
+}
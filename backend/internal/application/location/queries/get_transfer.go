@@ -0,0 +1,59 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/location/dto"
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
+)
+
+type GetTransferQuery struct {
+	transferRepo location.TransferRepository
+	productRepo  product.ProductRepository
+}
+
+func NewGetTransferQuery(
+	transferRepo location.TransferRepository,
+	productRepo product.ProductRepository,
+) *GetTransferQuery {
+	return &GetTransferQuery{
+		transferRepo: transferRepo,
+		productRepo:  productRepo,
+	}
+}
+
+func (q *GetTransferQuery) Execute(id string) (*dto.TransferResponse, error) {
+	// Find transfer
+	transfer, err := q.transferRepo.FindByID(location.TransferID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapTransferToDTO(transfer, q.productRepo)
+}
+
+func mapTransferToDTO(transfer *location.Transfer, productRepo product.ProductRepository) (*dto.TransferResponse, error) {
+	var lines []dto.TransferLineResponse
+	for _, line := range transfer.Lines() {
+		prod, err := productRepo.FindByIDIncludingArchived(line.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, dto.TransferLineResponse{
+			ProductID:   line.ProductID.String(),
+			ProductName: prod.Name(),
+			Quantity:    line.Quantity,
+		})
+	}
+
+	return &dto.TransferResponse{
+		ID:             transfer.ID().String(),
+		FromLocationID: transfer.FromLocationID().String(),
+		ToLocationID:   transfer.ToLocationID().String(),
+		Lines:          lines,
+		Note:           transfer.Note(),
+		CreatedBy:      transfer.CreatedBy(),
+		CreatedAt:      transfer.CreatedAt(),
+	}, nil
+}
@@ -0,0 +1,33 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/location/dto"
+	"POSFlowBackend/internal/domain/location"
+)
+
+type ListLocationsQuery struct {
+	repo location.LocationRepository
+}
+
+func NewListLocationsQuery(repo location.LocationRepository) *ListLocationsQuery {
+	return &ListLocationsQuery{repo: repo}
+}
+
+func (q *ListLocationsQuery) Execute() (*dto.LocationListResponse, error) {
+	// Find all locations
+	locations, err := q.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	var responses []*dto.LocationResponse
+	for _, loc := range locations {
+		responses = append(responses, mapLocationToDTO(loc))
+	}
+
+	return &dto.LocationListResponse{
+		Locations: responses,
+		Total:     len(responses),
+	}, nil
+}
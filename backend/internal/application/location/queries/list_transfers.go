@@ -0,0 +1,58 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/location/dto"
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
+)
+
+type ListTransfersQuery struct {
+	transferRepo location.TransferRepository
+	locationRepo location.LocationRepository
+	productRepo  product.ProductRepository
+}
+
+func NewListTransfersQuery(
+	transferRepo location.TransferRepository,
+	locationRepo location.LocationRepository,
+	productRepo product.ProductRepository,
+) *ListTransfersQuery {
+	return &ListTransfersQuery{
+		transferRepo: transferRepo,
+		locationRepo: locationRepo,
+		productRepo:  productRepo,
+	}
+}
+
+func (q *ListTransfersQuery) Execute(req dto.ListTransfersRequest) (*dto.TransferListResponse, error) {
+	var transfers []*location.Transfer
+	var err error
+
+	// Apply location filter
+	if req.LocationID != "" {
+		if _, err := q.locationRepo.FindByID(location.LocationID(req.LocationID)); err != nil {
+			return nil, err
+		}
+		transfers, err = q.transferRepo.FindByLocation(location.LocationID(req.LocationID))
+	} else {
+		transfers, err = q.transferRepo.FindAll()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	var responses []*dto.TransferResponse
+	for _, transfer := range transfers {
+		response, err := mapTransferToDTO(transfer, q.productRepo)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, response)
+	}
+
+	return &dto.TransferListResponse{
+		Transfers: responses,
+		Total:     len(responses),
+	}, nil
+}
@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/mail/dto"
+	"POSFlowBackend/internal/domain/mail"
+)
+
+type RetryEmailCommand struct {
+	receiptMailer *mail.ReceiptMailer
+}
+
+func NewRetryEmailCommand(receiptMailer *mail.ReceiptMailer) *RetryEmailCommand {
+	return &RetryEmailCommand{receiptMailer: receiptMailer}
+}
+
+// Execute sends an unsent email again right away; the response carries the outcome
+func (c *RetryEmailCommand) Execute(id string) (*dto.EmailResponse, error) {
+	// Retry using domain service
+	email, err := c.receiptMailer.Retry(mail.EmailID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapEmailToDTO(email), nil
+}
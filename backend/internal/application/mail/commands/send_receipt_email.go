@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/mail/dto"
+	"POSFlowBackend/internal/domain/mail"
+	"POSFlowBackend/internal/domain/shared"
+
+	"github.com/google/uuid"
+)
+
+type SendReceiptEmailCommand struct {
+	receiptMailer *mail.ReceiptMailer
+}
+
+func NewSendReceiptEmailCommand(receiptMailer *mail.ReceiptMailer) *SendReceiptEmailCommand {
+	return &SendReceiptEmailCommand{receiptMailer: receiptMailer}
+}
+
+// Execute queues an order's receipt for emailing; it is sent by the
+// background sender, so the response shows it pending
+func (c *SendReceiptEmailCommand) Execute(orderID string, req dto.SendReceiptEmailRequest) (*dto.EmailResponse, error) {
+	// Generate ID
+	id := mail.EmailID(uuid.New().String())
+
+	// Queue using domain service
+	email, err := c.receiptMailer.SendReceipt(id, shared.OrderID(orderID), req.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapEmailToDTO(email), nil
+}
+
+func mapEmailToDTO(email *mail.Email) *dto.EmailResponse {
+	msg := email.Message()
+	return &dto.EmailResponse{
+		ID:            email.ID().String(),
+		OrderID:       email.OrderID().String(),
+		To:            msg.To,
+		Subject:       msg.Subject,
+		Status:        string(email.Status()),
+		Attempts:      email.Attempts(),
+		LastError:     email.LastError(),
+		NextAttemptAt: email.NextAttemptAt(),
+		SentAt:        email.SentAt(),
+		TextBody:      msg.TextBody,
+		CreatedAt:     email.CreatedAt(),
+		UpdatedAt:     email.UpdatedAt(),
+	}
+}
@@ -0,0 +1,50 @@
+package dto
+
+import "time"
+
+// SendReceiptEmailRequest - Input DTO; without an email the order's customer email is used
+type SendReceiptEmailRequest struct {
+	Email string `json:"email" binding:"omitempty,email"`
+}
+
+// PreviewReceiptEmailRequest - Query DTO for rendering a receipt email without sending it
+type PreviewReceiptEmailRequest struct {
+	Email string `form:"email" binding:"omitempty,email"`
+}
+
+// ListEmailsRequest - Query DTO for the outgoing email queue
+type ListEmailsRequest struct {
+	Status  string `form:"status" binding:"omitempty,oneof=pending sent failed"`
+	OrderID string `form:"order_id"`
+	Limit   int    `form:"limit" binding:"omitempty,gte=1,lte=200"`
+}
+
+// MessageResponse - Output DTO for a composed email
+type MessageResponse struct {
+	To       string `json:"to"`
+	Subject  string `json:"subject"`
+	TextBody string `json:"text_body"`
+	HTMLBody string `json:"html_body"`
+}
+
+// EmailResponse - Output DTO for an email in the outgoing queue
+type EmailResponse struct {
+	ID            string     `json:"id"`
+	OrderID       string     `json:"order_id,omitempty"`
+	To            string     `json:"to"`
+	Subject       string     `json:"subject"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"last_error,omitempty"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	SentAt        *time.Time `json:"sent_at,omitempty"`
+	TextBody      string     `json:"text_body"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// EmailListResponse - Output DTO for a list of queued emails
+type EmailListResponse struct {
+	Emails []*EmailResponse `json:"emails"`
+	Total  int              `json:"total"`
+}
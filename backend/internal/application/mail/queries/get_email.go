@@ -0,0 +1,25 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/mail/dto"
+	"POSFlowBackend/internal/domain/mail"
+)
+
+type GetEmailQuery struct {
+	repo mail.EmailRepository
+}
+
+func NewGetEmailQuery(repo mail.EmailRepository) *GetEmailQuery {
+	return &GetEmailQuery{repo: repo}
+}
+
+func (q *GetEmailQuery) Execute(id string) (*dto.EmailResponse, error) {
+	// Find email
+	email, err := q.repo.FindByID(mail.EmailID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapEmailToDTO(email), nil
+}
@@ -0,0 +1,61 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/mail/dto"
+	"POSFlowBackend/internal/domain/mail"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+// defaultEmailLimit is how many emails are listed when no limit is given
+const defaultEmailLimit = 50
+
+type ListEmailsQuery struct {
+	repo mail.EmailRepository
+}
+
+func NewListEmailsQuery(repo mail.EmailRepository) *ListEmailsQuery {
+	return &ListEmailsQuery{repo: repo}
+}
+
+// Execute returns queued emails newest first, e.g. the failed ones to retry
+func (q *ListEmailsQuery) Execute(req dto.ListEmailsRequest) (*dto.EmailListResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultEmailLimit
+	}
+
+	// Find emails
+	emails, err := q.repo.FindRecent(mail.Status(req.Status), shared.OrderID(req.OrderID), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	responses := make([]*dto.EmailResponse, 0, len(emails))
+	for _, email := range emails {
+		responses = append(responses, mapEmailToDTO(email))
+	}
+
+	return &dto.EmailListResponse{
+		Emails: responses,
+		Total:  len(responses),
+	}, nil
+}
+
+func mapEmailToDTO(email *mail.Email) *dto.EmailResponse {
+	msg := email.Message()
+	return &dto.EmailResponse{
+		ID:            email.ID().String(),
+		OrderID:       email.OrderID().String(),
+		To:            msg.To,
+		Subject:       msg.Subject,
+		Status:        string(email.Status()),
+		Attempts:      email.Attempts(),
+		LastError:     email.LastError(),
+		NextAttemptAt: email.NextAttemptAt(),
+		SentAt:        email.SentAt(),
+		TextBody:      msg.TextBody,
+		CreatedAt:     email.CreatedAt(),
+		UpdatedAt:     email.UpdatedAt(),
+	}
+}
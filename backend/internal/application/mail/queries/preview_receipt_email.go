@@ -0,0 +1,31 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/mail/dto"
+	"POSFlowBackend/internal/domain/mail"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type PreviewReceiptEmailQuery struct {
+	receiptMailer *mail.ReceiptMailer
+}
+
+func NewPreviewReceiptEmailQuery(receiptMailer *mail.ReceiptMailer) *PreviewReceiptEmailQuery {
+	return &PreviewReceiptEmailQuery{receiptMailer: receiptMailer}
+}
+
+func (q *PreviewReceiptEmailQuery) Execute(orderID string, req dto.PreviewReceiptEmailRequest) (*dto.MessageResponse, error) {
+	// Compose the email
+	msg, err := q.receiptMailer.Preview(shared.OrderID(orderID), req.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return &dto.MessageResponse{
+		To:       msg.To,
+		Subject:  msg.Subject,
+		TextBody: msg.TextBody,
+		HTMLBody: msg.HTMLBody,
+	}, nil
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"POSFlowBackend/internal/application/order/dto"
+	"POSFlowBackend/internal/domain/event"
 	"POSFlowBackend/internal/domain/order"
 	"POSFlowBackend/internal/domain/product"
 	"POSFlowBackend/internal/domain/shared"
@@ -12,15 +13,18 @@ import (
 type CreateOrderCommand struct {
 	orderService *order.OrderService
 	productRepo  product.ProductRepository
+	publisher    event.Publisher
 }
 
 func NewCreateOrderCommand(
 	orderService *order.OrderService,
 	productRepo product.ProductRepository,
+	publisher event.Publisher,
 ) *CreateOrderCommand {
 	return &CreateOrderCommand{
 		orderService: orderService,
 		productRepo:  productRepo,
+		publisher:    publisher,
 	}
 }
 
@@ -31,59 +35,54 @@ func (c *CreateOrderCommand) Execute(req dto.CreateOrderRequest) (*dto.OrderResp
 	// Convert DTO items to domain format
 	var itemRequests []struct {
 		ProductID shared.ProductID
-		Quantity  int
+		Quantity  float64
 	}
 
 	for _, item := range req.Items {
 		itemRequests = append(itemRequests, struct {
 			ProductID shared.ProductID
-			Quantity  int
+			Quantity  float64
 		}{
 			ProductID: shared.ProductID(item.ProductID),
 			Quantity:  item.Quantity,
 		})
 	}
 
-	// Use domain service to create order (handles stock validation)
-	newOrder, err := c.orderService.CreateOrder(
+	// Parse declared allergies
+	allergies, err := product.ParseAllergens(req.Allergies)
+	if err != nil {
+		return nil, err
+	}
+
+	// Orders are dine-in unless placed through another channel
+	channel := order.ChannelDineIn
+	if req.Channel != "" {
+		channel = order.Channel(req.Channel)
+	}
+
+	// Use domain service to create order (handles stock validation).
+	// Allergen warnings are recorded on the order items and mapped below.
+	newOrder, _, err := c.orderService.CreateOrder(
 		orderID,
 		order.TableNumber(req.TableNumber),
+		channel,
+		req.TerminalID,
 		itemRequests,
+		allergies,
+		req.CustomerEmail,
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	// Map to response DTO
-	return c.mapToDTO(newOrder)
-}
-
-func (c *CreateOrderCommand) mapToDTO(o *order.Order) (*dto.OrderResponse, error) {
-	var items []dto.OrderItemResponse
-
-	for _, item := range o.Items() {
-		// Get product details
-		prod, err := c.productRepo.FindByID(item.ProductID())
-		if err != nil {
-			return nil, err
-		}
-
-		items = append(items, dto.OrderItemResponse{
-			ProductID:   item.ProductID().String(),
-			ProductName: prod.Name(),
-			Quantity:    item.Quantity(),
-			UnitPrice:   item.UnitPrice().Amount,
-			Subtotal:    item.Subtotal().Amount,
-		})
+	response, err := dto.MapOrderToDTO(newOrder, c.productRepo)
+	if err != nil {
+		return nil, err
 	}
 
-	return &dto.OrderResponse{
-		ID:          o.ID().String(),
-		TableNumber: o.TableNumber().String(),
-		Status:      string(o.Status()),
-		Items:       items,
-		Total:       o.Total().Amount,
-		CreatedAt:   o.CreatedAt(),
-		UpdatedAt:   o.UpdatedAt(),
-	}, nil
+	// Notify connected clients
+	c.publisher.Publish(event.TopicOrderCreated, response)
+
+	return response, nil
 }
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/order/dto"
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type MergeOrdersCommand struct {
+	orderService *order.OrderService
+	productRepo  product.ProductRepository
+	publisher    event.Publisher
+}
+
+func NewMergeOrdersCommand(
+	orderService *order.OrderService,
+	productRepo product.ProductRepository,
+	publisher event.Publisher,
+) *MergeOrdersCommand {
+	return &MergeOrdersCommand{
+		orderService: orderService,
+		productRepo:  productRepo,
+		publisher:    publisher,
+	}
+}
+
+func (c *MergeOrdersCommand) Execute(id string, req dto.MergeOrdersRequest) (*dto.OrderGroupResponse, error) {
+	var ids []shared.OrderID
+	for _, orderID := range req.OrderIDs {
+		ids = append(ids, shared.OrderID(orderID))
+	}
+
+	// Merge the orders; their items move with their price snapshots
+	target, merged, err := c.orderService.MergeOrders(
+		shared.OrderID(id),
+		ids,
+		order.ChangeNote{StaffMember: req.StaffMember, Note: req.Note},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	response := &dto.OrderGroupResponse{}
+	if response.Order, err = dto.MapOrderToDTO(target, c.productRepo); err != nil {
+		return nil, err
+	}
+	for _, ord := range merged {
+		mapped, err := dto.MapOrderToDTO(ord, c.productRepo)
+		if err != nil {
+			return nil, err
+		}
+		response.Merged = append(response.Merged, mapped)
+	}
+
+	// Notify connected clients
+	c.publisher.Publish(event.TopicOrdersMerged, response)
+
+	return response, nil
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/order/dto"
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type MoveOrderCommand struct {
+	orderService *order.OrderService
+	productRepo  product.ProductRepository
+	publisher    event.Publisher
+}
+
+func NewMoveOrderCommand(
+	orderService *order.OrderService,
+	productRepo product.ProductRepository,
+	publisher event.Publisher,
+) *MoveOrderCommand {
+	return &MoveOrderCommand{
+		orderService: orderService,
+		productRepo:  productRepo,
+		publisher:    publisher,
+	}
+}
+
+func (c *MoveOrderCommand) Execute(id string, req dto.MoveOrderRequest) (*dto.OrderResponse, error) {
+	// Move the order; the new table must be on the floor plan
+	ord, err := c.orderService.MoveOrder(
+		shared.OrderID(id),
+		order.TableNumber(req.TableNumber),
+		order.ChangeNote{StaffMember: req.StaffMember, Note: req.Note},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	response, err := dto.MapOrderToDTO(ord, c.productRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Notify connected clients
+	c.publisher.Publish(event.TopicOrderMoved, response)
+
+	return response, nil
+}
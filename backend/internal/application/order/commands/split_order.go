@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/order/dto"
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type SplitOrderCommand struct {
+	orderService *order.OrderService
+	productRepo  product.ProductRepository
+	publisher    event.Publisher
+}
+
+func NewSplitOrderCommand(
+	orderService *order.OrderService,
+	productRepo product.ProductRepository,
+	publisher event.Publisher,
+) *SplitOrderCommand {
+	return &SplitOrderCommand{
+		orderService: orderService,
+		productRepo:  productRepo,
+		publisher:    publisher,
+	}
+}
+
+func (c *SplitOrderCommand) Execute(id string, req dto.SplitOrderRequest) (*dto.OrderGroupResponse, error) {
+	note := order.ChangeNote{StaffMember: req.StaffMember, Note: req.Note}
+
+	var ord *order.Order
+	var parts []*order.Order
+	switch order.SplitMode(req.Mode) {
+	case order.SplitByItems:
+		if req.Parts != 0 {
+			return nil, fmt.Errorf("%w: parts can only be given when splitting evenly", shared.ErrInvalidInput)
+		}
+
+		var lines []order.SplitLine
+		for _, line := range req.Lines {
+			lines = append(lines, order.SplitLine{Line: line.Line, Quantity: line.Quantity})
+		}
+
+		// Split the lines off into a new order
+		source, split, err := c.orderService.SplitByItems(
+			shared.OrderID(id),
+			shared.OrderID(uuid.New().String()),
+			order.TableNumber(req.TableNumber),
+			lines,
+			note,
+		)
+		if err != nil {
+			return nil, err
+		}
+		ord, parts = source, []*order.Order{split}
+
+	default:
+		if len(req.Lines) > 0 || req.TableNumber != "" {
+			return nil, fmt.Errorf("%w: lines and table can only be given when splitting by items", shared.ErrInvalidInput)
+		}
+
+		// The order keeps one part; generate an ID for each of the others
+		var ids []shared.OrderID
+		for i := 1; i < req.Parts; i++ {
+			ids = append(ids, shared.OrderID(uuid.New().String()))
+		}
+
+		source, splits, err := c.orderService.SplitEvenly(shared.OrderID(id), ids, note)
+		if err != nil {
+			return nil, err
+		}
+		ord, parts = source, splits
+	}
+
+	// Map to response DTOs
+	response := &dto.OrderGroupResponse{}
+	var err error
+	if response.Order, err = dto.MapOrderToDTO(ord, c.productRepo); err != nil {
+		return nil, err
+	}
+	for _, part := range parts {
+		mapped, err := dto.MapOrderToDTO(part, c.productRepo)
+		if err != nil {
+			return nil, err
+		}
+		response.Parts = append(response.Parts, mapped)
+	}
+
+	// Notify connected clients
+	c.publisher.Publish(event.TopicOrderSplit, response)
+
+	return response, nil
+}
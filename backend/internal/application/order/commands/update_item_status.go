@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/order/dto"
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type UpdateItemStatusCommand struct {
+	orderRepo   order.OrderRepository
+	productRepo product.ProductRepository
+	publisher   event.Publisher
+}
+
+func NewUpdateItemStatusCommand(
+	orderRepo order.OrderRepository,
+	productRepo product.ProductRepository,
+	publisher event.Publisher,
+) *UpdateItemStatusCommand {
+	return &UpdateItemStatusCommand{
+		orderRepo:   orderRepo,
+		productRepo: productRepo,
+		publisher:   publisher,
+	}
+}
+
+func (c *UpdateItemStatusCommand) Execute(id string, line int, req dto.UpdateItemStatusRequest) (*dto.OrderResponse, error) {
+	// Find order
+	ord, err := c.orderRepo.FindByID(shared.OrderID(id))
+	if err != nil {
+		return nil, err
+	}
+	previousStatus := ord.Status()
+
+	// Update the item; the order status follows its items
+	newStatus := order.ItemStatus(req.Status)
+	if err := ord.UpdateItemStatus(line, newStatus); err != nil {
+		return nil, err
+	}
+
+	// Save changes
+	if err := c.orderRepo.Save(ord); err != nil {
+		return nil, err
+	}
+
+	// Notify connected clients
+	c.publisher.Publish(event.TopicOrderStatusChanged, mapToStatusChangedEvent(ord, previousStatus))
+
+	// Map to response DTO
+	return dto.MapOrderToDTO(ord, c.productRepo)
+}
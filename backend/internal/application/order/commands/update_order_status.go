@@ -2,23 +2,28 @@ package commands
 
 import (
 	"POSFlowBackend/internal/application/order/dto"
+	"POSFlowBackend/internal/domain/event"
 	"POSFlowBackend/internal/domain/order"
 	"POSFlowBackend/internal/domain/product"
 	"POSFlowBackend/internal/domain/shared"
+	"fmt"
 )
 
 type UpdateOrderStatusCommand struct {
 	orderRepo   order.OrderRepository
 	productRepo product.ProductRepository
+	publisher   event.Publisher
 }
 
 func NewUpdateOrderStatusCommand(
 	orderRepo order.OrderRepository,
 	productRepo product.ProductRepository,
+	publisher event.Publisher,
 ) *UpdateOrderStatusCommand {
 	return &UpdateOrderStatusCommand{
 		orderRepo:   orderRepo,
 		productRepo: productRepo,
+		publisher:   publisher,
 	}
 }
 
@@ -28,10 +33,18 @@ func (c *UpdateOrderStatusCommand) Execute(id string, req dto.UpdateOrderStatusR
 	if err != nil {
 		return nil, err
 	}
+	previousStatus := ord.Status()
 
 	// Update status using domain method
 	newStatus := order.OrderStatus(req.Status)
-	if err := ord.UpdateStatus(newStatus); err != nil {
+	if req.PaymentMethod != "" {
+		if newStatus != order.StatusCompleted {
+			return nil, fmt.Errorf("%w: payment method can only be given when completing an order", shared.ErrInvalidInput)
+		}
+		if err := ord.CompleteWithPayment(order.PaymentMethod(req.PaymentMethod)); err != nil {
+			return nil, err
+		}
+	} else if err := ord.UpdateStatus(newStatus); err != nil {
 		return nil, err
 	}
 
@@ -40,35 +53,32 @@ func (c *UpdateOrderStatusCommand) Execute(id string, req dto.UpdateOrderStatusR
 		return nil, err
 	}
 
+	// Notify connected clients
+	c.publisher.Publish(event.TopicOrderStatusChanged, mapToStatusChangedEvent(ord, previousStatus))
+
 	// Map to response DTO
-	return c.mapToDTO(ord)
+	return dto.MapOrderToDTO(ord, c.productRepo)
 }
 
-func (c *UpdateOrderStatusCommand) mapToDTO(o *order.Order) (*dto.OrderResponse, error) {
-	var items []dto.OrderItemResponse
-
+// mapToStatusChangedEvent builds the event payload for an order status change
+func mapToStatusChangedEvent(o *order.Order, previousStatus order.OrderStatus) dto.OrderStatusChangedEvent {
+	items := make([]dto.ItemStatusEventEntry, 0, len(o.Items()))
 	for _, item := range o.Items() {
-		prod, err := c.productRepo.FindByID(item.ProductID())
-		if err != nil {
-			return nil, err
-		}
-
-		items = append(items, dto.OrderItemResponse{
-			ProductID:   item.ProductID().String(),
-			ProductName: prod.Name(),
-			Quantity:    item.Quantity(),
-			UnitPrice:   item.UnitPrice().Amount,
-			Subtotal:    item.Subtotal().Amount,
+		items = append(items, dto.ItemStatusEventEntry{
+			Line:      item.Line(),
+			ProductID: item.ProductID().String(),
+			StationID: item.StationID().String(),
+			Status:    string(item.Status()),
 		})
 	}
 
-	return &dto.OrderResponse{
-		ID:          o.ID().String(),
-		TableNumber: o.TableNumber().String(),
-		Status:      string(o.Status()),
-		Items:       items,
-		Total:       o.Total().Amount,
-		CreatedAt:   o.CreatedAt(),
-		UpdatedAt:   o.UpdatedAt(),
-	}, nil
+	return dto.OrderStatusChangedEvent{
+		OrderID:        o.ID().String(),
+		TicketNumber:   o.TicketNumber().String(),
+		TableNumber:    o.TableNumber().String(),
+		PreviousStatus: string(previousStatus),
+		Status:         string(o.Status()),
+		Items:          items,
+		UpdatedAt:      o.UpdatedAt(),
+	}
 }
@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/product"
+	"fmt"
+	"strings"
+)
+
+// MapOrderToDTO maps an order to its response, naming its products and
+// warning about the items that conflict with the declared allergies
+func MapOrderToDTO(o *order.Order, productRepo product.ProductRepository) (*OrderResponse, error) {
+	var items []OrderItemResponse
+	var warnings []AllergenWarningResponse
+
+	for _, item := range o.Items() {
+		// Get product details
+		prod, err := productRepo.FindByIDIncludingArchived(item.ProductID())
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, OrderItemResponse{
+			Line:              item.Line(),
+			ProductID:         item.ProductID().String(),
+			ProductName:       prod.Name(),
+			Quantity:          item.Quantity(),
+			UnitPrice:         item.UnitPrice().Amount,
+			Subtotal:          item.Subtotal().Amount,
+			AllergenConflicts: product.AllergenStrings(item.AllergenConflicts()),
+			LocationID:        item.LocationID().String(),
+			Weighed:           item.Weighed(),
+			StationID:         item.StationID().String(),
+			Status:            string(item.Status()),
+			StartedAt:         item.StartedAt(),
+			DoneAt:            item.DoneAt(),
+			ServedAt:          item.ServedAt(),
+		})
+
+		if item.HasAllergenConflict() {
+			allergens := product.AllergenStrings(item.AllergenConflicts())
+			warnings = append(warnings, AllergenWarningResponse{
+				ProductID:   item.ProductID().String(),
+				ProductName: prod.Name(),
+				Allergens:   allergens,
+				Message:     fmt.Sprintf("ALLERGY: %s contains %s", prod.Name(), strings.Join(allergens, ", ")),
+			})
+		}
+	}
+
+	return &OrderResponse{
+		ID:                 o.ID().String(),
+		TicketNumber:       o.TicketNumber().String(),
+		Channel:            string(o.Channel()),
+		BusinessDay:        uint64(o.BusinessDay()),
+		TableNumber:        o.TableNumber().String(),
+		TerminalID:         o.TerminalID(),
+		Status:             string(o.Status()),
+		Items:              items,
+		Total:              o.Total().Amount,
+		PaymentMethod:      string(o.PaymentMethod()),
+		CustomerEmail:      o.CustomerEmail(),
+		DeclaredAllergies:  product.AllergenStrings(o.DeclaredAllergies()),
+		HasAllergenWarning: len(warnings) > 0,
+		AllergenWarnings:   warnings,
+		CreatedAt:          o.CreatedAt(),
+		UpdatedAt:          o.UpdatedAt(),
+	}, nil
+}
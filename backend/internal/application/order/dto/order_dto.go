@@ -4,37 +4,98 @@ import "time"
 
 // CreateOrderRequest - Input DTO for creating an order
 type CreateOrderRequest struct {
-	TableNumber string      `json:"table_number" binding:"required"`
-	Items       []OrderItem `json:"items" binding:"required,min=1"`
+	TableNumber   string      `json:"table_number"` // a table on the floor plan, required for dine-in
+	Items         []OrderItem `json:"items" binding:"required,min=1"`
+	Allergies     []string    `json:"allergies"`                                                          // allergens declared by the customer
+	TerminalID    string      `json:"terminal_id"`                                                        // selects the stock location, optional
+	CustomerEmail string      `json:"customer_email" binding:"omitempty,email"`                           // the receipt is emailed here once paid
+	Channel       string      `json:"channel" binding:"omitempty,oneof=dine_in takeaway delivery online"` // defaults to dine_in
 }
 
 type OrderItem struct {
-	ProductID string `json:"product_id" binding:"required"`
-	Quantity  int    `json:"quantity" binding:"required,gt=0"`
+	ProductID string  `json:"product_id" binding:"required"`
+	Quantity  float64 `json:"quantity" binding:"required,gt=0"`
 }
 
 // UpdateOrderStatusRequest - Input DTO for updating order status
 type UpdateOrderStatusRequest struct {
-	Status string `json:"status" binding:"required,oneof=pending preparing ready completed cancelled"`
+	Status        string `json:"status" binding:"required,oneof=pending preparing ready completed cancelled"`
+	PaymentMethod string `json:"payment_method" binding:"omitempty,oneof=cash card other"` // only when completing
+}
+
+// UpdateItemStatusRequest - Input DTO for updating one item's preparation status
+type UpdateItemStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=queued cooking done served"`
 }
 
 // OrderResponse - Output DTO
 type OrderResponse struct {
-	ID          string              `json:"id"`
-	TableNumber string              `json:"table_number"`
-	Status      string              `json:"status"`
-	Items       []OrderItemResponse `json:"items"`
-	Total       float64             `json:"total"`
-	CreatedAt   time.Time           `json:"created_at"`
-	UpdatedAt   time.Time           `json:"updated_at"`
+	ID                 string                    `json:"id"`
+	TicketNumber       string                    `json:"ticket_number"`
+	Channel            string                    `json:"channel"`
+	BusinessDay        uint64                    `json:"business_day,omitempty"`
+	TableNumber        string                    `json:"table_number"`
+	TerminalID         string                    `json:"terminal_id,omitempty"`
+	Status             string                    `json:"status"`
+	Items              []OrderItemResponse       `json:"items"`
+	Total              float64                   `json:"total"`
+	PaymentMethod      string                    `json:"payment_method,omitempty"`
+	CustomerEmail      string                    `json:"customer_email,omitempty"`
+	DeclaredAllergies  []string                  `json:"declared_allergies"`
+	HasAllergenWarning bool                      `json:"has_allergen_warning"`
+	AllergenWarnings   []AllergenWarningResponse `json:"allergen_warnings,omitempty"`
+	CreatedAt          time.Time                 `json:"created_at"`
+	UpdatedAt          time.Time                 `json:"updated_at"`
 }
 
 type OrderItemResponse struct {
-	ProductID   string  `json:"product_id"`
-	ProductName string  `json:"product_name"`
-	Quantity    int     `json:"quantity"`
-	UnitPrice   float64 `json:"unit_price"`
-	Subtotal    float64 `json:"subtotal"`
+	Line              int        `json:"line"`
+	ProductID         string     `json:"product_id"`
+	ProductName       string     `json:"product_name"`
+	Quantity          float64    `json:"quantity"`
+	UnitPrice         float64    `json:"unit_price"`
+	Subtotal          float64    `json:"subtotal"`
+	AllergenConflicts []string   `json:"allergen_conflicts,omitempty"`
+	LocationID        string     `json:"location_id,omitempty"`
+	Weighed           bool       `json:"weighed"`
+	StationID         string     `json:"station_id,omitempty"`
+	Status            string     `json:"status"`
+	StartedAt         *time.Time `json:"started_at,omitempty"`
+	DoneAt            *time.Time `json:"done_at,omitempty"`
+	ServedAt          *time.Time `json:"served_at,omitempty"`
+}
+
+// OrderStatusChangedEvent - Event payload sent when an order or one of its items changes status
+type OrderStatusChangedEvent struct {
+	OrderID        string                 `json:"order_id"`
+	TicketNumber   string                 `json:"ticket_number"`
+	TableNumber    string                 `json:"table_number"`
+	PreviousStatus string                 `json:"previous_status"`
+	Status         string                 `json:"status"`
+	Items          []ItemStatusEventEntry `json:"items"`
+	UpdatedAt      time.Time              `json:"updated_at"`
+}
+
+type ItemStatusEventEntry struct {
+	Line      int    `json:"line"`
+	ProductID string `json:"product_id"`
+	StationID string `json:"station_id,omitempty"`
+	Status    string `json:"status"`
+}
+
+// AllergenWarningResponse flags an item that contains a declared allergy
+type AllergenWarningResponse struct {
+	ProductID   string   `json:"product_id"`
+	ProductName string   `json:"product_name"`
+	Allergens   []string `json:"allergens"`
+	Message     string   `json:"message"`
+}
+
+// ListOrdersRequest - Query parameters for listing orders. A ticket is
+// looked up in the open business day unless another day is given.
+type ListOrdersRequest struct {
+	Ticket      string `form:"ticket"`
+	BusinessDay uint64 `form:"business_day"`
 }
 
 // OrderListResponse - Output DTO for list
@@ -42,3 +103,63 @@ type OrderListResponse struct {
 	Orders []*OrderResponse `json:"orders"`
 	Total  int              `json:"total"`
 }
+
+// MoveOrderRequest - Input DTO for moving an order to another table
+type MoveOrderRequest struct {
+	TableNumber string `json:"table_number" binding:"required"`
+	StaffMember string `json:"staff_member"`
+	Note        string `json:"note"`
+}
+
+// MergeOrdersRequest - Input DTO for merging other open orders into an order
+type MergeOrdersRequest struct {
+	OrderIDs    []string `json:"order_ids" binding:"required,min=1,dive,required"`
+	StaffMember string   `json:"staff_member"`
+	Note        string   `json:"note"`
+}
+
+// SplitOrderRequest - Input DTO for splitting an order, either by items
+// into one new order or evenly into a number of parts
+type SplitOrderRequest struct {
+	Mode        string             `json:"mode" binding:"required,oneof=items even"`
+	Lines       []SplitLineRequest `json:"lines" binding:"required_if=Mode items,dive"`                  // lines to split off, by items
+	Parts       int                `json:"parts" binding:"required_if=Mode even,omitempty,min=2,max=20"` // number of parts, evenly
+	TableNumber string             `json:"table_number"`                                                 // the new order's table, by items; defaults to the same table
+	StaffMember string             `json:"staff_member"`
+	Note        string             `json:"note"`
+}
+
+type SplitLineRequest struct {
+	Line     int     `json:"line" binding:"required,gt=0"`
+	Quantity float64 `json:"quantity" binding:"omitempty,gt=0"` // defaults to the whole line
+}
+
+// OrderGroupResponse - Output DTO for a merge or split: the order operated
+// on and the orders merged into it or split off it
+type OrderGroupResponse struct {
+	Order  *OrderResponse   `json:"order"`
+	Merged []*OrderResponse `json:"merged,omitempty"`
+	Parts  []*OrderResponse `json:"parts,omitempty"`
+}
+
+// AuditRecordResponse - Output DTO for a move, merge or split in an order's audit trail
+type AuditRecordResponse struct {
+	Action        string    `json:"action"`
+	RelatedOrders []string  `json:"related_orders,omitempty"`
+	FromTable     string    `json:"from_table,omitempty"`
+	ToTable       string    `json:"to_table,omitempty"`
+	Amount        float64   `json:"amount"`
+	SplitMode     string    `json:"split_mode,omitempty"`
+	StaffMember   string    `json:"staff_member,omitempty"`
+	Note          string    `json:"note,omitempty"`
+	At            time.Time `json:"at"`
+}
+
+// OrderAuditResponse - Output DTO for the audit trail of an order
+type OrderAuditResponse struct {
+	OrderID      string                 `json:"order_id"`
+	TicketNumber string                 `json:"ticket_number"`
+	TableNumber  string                 `json:"table_number"`
+	Status       string                 `json:"status"`
+	History      []*AuditRecordResponse `json:"history"`
+}
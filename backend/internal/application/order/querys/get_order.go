@@ -30,34 +30,5 @@ func (q *GetOrderQuery) Execute(id string) (*dto.OrderResponse, error) {
 	}
 
 	// Map to response DTO
-	return q.mapToDTO(ord)
-}
-
-func (q *GetOrderQuery) mapToDTO(o *order.Order) (*dto.OrderResponse, error) {
-	var items []dto.OrderItemResponse
-
-	for _, item := range o.Items() {
-		prod, err := q.productRepo.FindByID(item.ProductID())
-		if err != nil {
-			return nil, err
-		}
-
-		items = append(items, dto.OrderItemResponse{
-			ProductID:   item.ProductID().String(),
-			ProductName: prod.Name(),
-			Quantity:    item.Quantity(),
-			UnitPrice:   item.UnitPrice().Amount,
-			Subtotal:    item.Subtotal().Amount,
-		})
-	}
-
-	return &dto.OrderResponse{
-		ID:          o.ID().String(),
-		TableNumber: o.TableNumber().String(),
-		Status:      string(o.Status()),
-		Items:       items,
-		Total:       o.Total().Amount,
-		CreatedAt:   o.CreatedAt(),
-		UpdatedAt:   o.UpdatedAt(),
-	}, nil
+	return dto.MapOrderToDTO(ord, q.productRepo)
 }
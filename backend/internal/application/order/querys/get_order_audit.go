@@ -0,0 +1,58 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/order/dto"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type GetOrderAuditQuery struct {
+	orderRepo order.OrderRepository
+}
+
+func NewGetOrderAuditQuery(orderRepo order.OrderRepository) *GetOrderAuditQuery {
+	return &GetOrderAuditQuery{orderRepo: orderRepo}
+}
+
+func (q *GetOrderAuditQuery) Execute(id string) (*dto.OrderAuditResponse, error) {
+	// Find order
+	ord, err := q.orderRepo.FindByID(shared.OrderID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Find its moves, merges and splits
+	records, err := q.orderRepo.FindAudit(ord.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	history := []*dto.AuditRecordResponse{}
+	for _, record := range records {
+		var related []string
+		for _, orderID := range record.RelatedOrders {
+			related = append(related, orderID.String())
+		}
+
+		history = append(history, &dto.AuditRecordResponse{
+			Action:        string(record.Action),
+			RelatedOrders: related,
+			FromTable:     record.FromTable.String(),
+			ToTable:       record.ToTable.String(),
+			Amount:        record.Amount.Amount,
+			SplitMode:     string(record.SplitMode),
+			StaffMember:   record.StaffMember,
+			Note:          record.Note,
+			At:            record.At,
+		})
+	}
+
+	return &dto.OrderAuditResponse{
+		OrderID:      ord.ID().String(),
+		TicketNumber: ord.TicketNumber().String(),
+		TableNumber:  ord.TableNumber().String(),
+		Status:       string(ord.Status()),
+		History:      history,
+	}, nil
+}
@@ -31,7 +31,7 @@ func (q *GetPendingOrdersQuery) Execute() (*dto.OrderListResponse, error) {
 	// Map to response DTOs
 	var orderResponses []*dto.OrderResponse
 	for _, ord := range orders {
-		orderDTO, err := q.mapToDTO(ord)
+		orderDTO, err := dto.MapOrderToDTO(ord, q.productRepo)
 		if err != nil {
 			return nil, err
 		}
@@ -43,32 +43,3 @@ func (q *GetPendingOrdersQuery) Execute() (*dto.OrderListResponse, error) {
 		Total:  len(orderResponses),
 	}, nil
 }
-
-func (q *GetPendingOrdersQuery) mapToDTO(o *order.Order) (*dto.OrderResponse, error) {
-	var items []dto.OrderItemResponse
-
-	for _, item := range o.Items() {
-		prod, err := q.productRepo.FindByID(item.ProductID())
-		if err != nil {
-			return nil, err
-		}
-
-		items = append(items, dto.OrderItemResponse{
-			ProductID:   item.ProductID().String(),
-			ProductName: prod.Name(),
-			Quantity:    item.Quantity(),
-			UnitPrice:   item.UnitPrice().Amount,
-			Subtotal:    item.Subtotal().Amount,
-		})
-	}
-
-	return &dto.OrderResponse{
-		ID:          o.ID().String(),
-		TableNumber: o.TableNumber().String(),
-		Status:      string(o.Status()),
-		Items:       items,
-		Total:       o.Total().Amount,
-		CreatedAt:   o.CreatedAt(),
-		UpdatedAt:   o.UpdatedAt(),
-	}, nil
-}
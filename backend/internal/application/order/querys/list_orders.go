@@ -7,23 +7,28 @@ import (
 )
 
 type ListOrdersQuery struct {
-	orderRepo   order.OrderRepository
-	productRepo product.ProductRepository
+	orderRepo     order.OrderRepository
+	productRepo   product.ProductRepository
+	ticketService *order.TicketService
 }
 
 func NewListOrdersQuery(
 	orderRepo order.OrderRepository,
 	productRepo product.ProductRepository,
+	ticketService *order.TicketService,
 ) *ListOrdersQuery {
 	return &ListOrdersQuery{
-		orderRepo:   orderRepo,
-		productRepo: productRepo,
+		orderRepo:     orderRepo,
+		productRepo:   productRepo,
+		ticketService: ticketService,
 	}
 }
 
-func (q *ListOrdersQuery) Execute() (*dto.OrderListResponse, error) {
-	// Find all orders
-	orders, err := q.orderRepo.FindAll()
+// Execute lists all orders, the orders of a business day, or the orders
+// called by a ticket number
+func (q *ListOrdersQuery) Execute(req dto.ListOrdersRequest) (*dto.OrderListResponse, error) {
+	// Find the orders asked for
+	orders, err := q.findOrders(req)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +36,7 @@ func (q *ListOrdersQuery) Execute() (*dto.OrderListResponse, error) {
 	// Map to response DTOs
 	var orderResponses []*dto.OrderResponse
 	for _, ord := range orders {
-		orderDTO, err := q.mapToDTO(ord)
+		orderDTO, err := dto.MapOrderToDTO(ord, q.productRepo)
 		if err != nil {
 			return nil, err
 		}
@@ -44,31 +49,27 @@ func (q *ListOrdersQuery) Execute() (*dto.OrderListResponse, error) {
 	}, nil
 }
 
-func (q *ListOrdersQuery) mapToDTO(o *order.Order) (*dto.OrderResponse, error) {
-	var items []dto.OrderItemResponse
+func (q *ListOrdersQuery) findOrders(req dto.ListOrdersRequest) ([]*order.Order, error) {
+	if req.Ticket == "" && req.BusinessDay == 0 {
+		return q.orderRepo.FindAll()
+	}
 
-	for _, item := range o.Items() {
-		prod, err := q.productRepo.FindByID(item.ProductID())
-		if err != nil {
+	day := order.BusinessDayID(req.BusinessDay)
+	if req.BusinessDay != 0 {
+		if _, err := q.ticketService.FindDay(day); err != nil {
 			return nil, err
 		}
-
-		items = append(items, dto.OrderItemResponse{
-			ProductID:   item.ProductID().String(),
-			ProductName: prod.Name(),
-			Quantity:    item.Quantity(),
-			UnitPrice:   item.UnitPrice().Amount,
-			Subtotal:    item.Subtotal().Amount,
-		})
+	} else {
+		// Tickets are looked up in the open business day by default
+		current, err := q.ticketService.CurrentDay()
+		if err != nil || current == nil {
+			return nil, err
+		}
+		day = current.ID()
 	}
 
-	return &dto.OrderResponse{
-		ID:          o.ID().String(),
-		TableNumber: o.TableNumber().String(),
-		Status:      string(o.Status()),
-		Items:       items,
-		Total:       o.Total().Amount,
-		CreatedAt:   o.CreatedAt(),
-		UpdatedAt:   o.UpdatedAt(),
-	}, nil
+	if req.Ticket == "" {
+		return q.orderRepo.FindByBusinessDay(day)
+	}
+	return q.orderRepo.FindByTicket(day, order.ParseTicketNumber(req.Ticket))
 }
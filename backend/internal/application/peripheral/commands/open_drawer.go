@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/peripheral/dto"
+	"POSFlowBackend/internal/domain/peripheral"
+
+	"github.com/google/uuid"
+)
+
+type OpenDrawerCommand struct {
+	drawerService *peripheral.DrawerService
+}
+
+func NewOpenDrawerCommand(drawerService *peripheral.DrawerService) *OpenDrawerCommand {
+	return &OpenDrawerCommand{drawerService: drawerService}
+}
+
+// Execute opens the cash drawer without a sale. The opening is audited
+// even when the drawer can't be reached; the error is returned afterwards.
+func (c *OpenDrawerCommand) Execute(req dto.OpenDrawerRequest) (*dto.DrawerOpeningResponse, error) {
+	// Generate ID
+	id := peripheral.OpeningID(uuid.New().String())
+
+	// Use domain service to kick the drawer and record it
+	opening, err := c.drawerService.OpenNoSale(id, req.StaffMember, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapOpeningToDTO(opening), nil
+}
+
+func mapOpeningToDTO(opening *peripheral.DrawerOpening) *dto.DrawerOpeningResponse {
+	return &dto.DrawerOpeningResponse{
+		ID:          opening.ID().String(),
+		Reason:      string(opening.Reason()),
+		OrderID:     opening.OrderID().String(),
+		StaffMember: opening.StaffMember(),
+		Note:        opening.Note(),
+		Succeeded:   opening.Succeeded(),
+		Failure:     opening.Failure(),
+		OpenedAt:    opening.OpenedAt(),
+	}
+}
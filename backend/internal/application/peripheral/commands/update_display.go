@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/peripheral/dto"
+	"POSFlowBackend/internal/domain/peripheral"
+)
+
+type UpdateDisplayCommand struct {
+	displayService *peripheral.DisplayService
+}
+
+func NewUpdateDisplayCommand(displayService *peripheral.DisplayService) *UpdateDisplayCommand {
+	return &UpdateDisplayCommand{displayService: displayService}
+}
+
+// Show puts the given lines on the customer pole display
+func (c *UpdateDisplayCommand) Show(req dto.ShowDisplayRequest) error {
+	return c.displayService.Show(req.Top, req.Bottom)
+}
+
+// Clear blanks the customer pole display
+func (c *UpdateDisplayCommand) Clear() error {
+	return c.displayService.Clear()
+}
@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+// OpenDrawerRequest - Input DTO for opening the cash drawer without a sale
+type OpenDrawerRequest struct {
+	StaffMember string `json:"staff_member" binding:"required"`
+	Note        string `json:"note"`
+}
+
+// DrawerOpeningResponse - Output DTO for one entry of the drawer audit log
+type DrawerOpeningResponse struct {
+	ID          string    `json:"id"`
+	Reason      string    `json:"reason"`
+	OrderID     string    `json:"order_id,omitempty"`
+	StaffMember string    `json:"staff_member,omitempty"`
+	Note        string    `json:"note,omitempty"`
+	Succeeded   bool      `json:"succeeded"`
+	Failure     string    `json:"failure,omitempty"`
+	OpenedAt    time.Time `json:"opened_at"`
+}
+
+// DrawerOpeningListResponse - Output DTO for list
+type DrawerOpeningListResponse struct {
+	Openings []*DrawerOpeningResponse `json:"openings"`
+	Total    int                      `json:"total"`
+	NoSales  int                      `json:"no_sales"`
+	Failed   int                      `json:"failed"`
+}
+
+// ShowDisplayRequest - Input DTO putting text on the customer pole display
+type ShowDisplayRequest struct {
+	Top    string `json:"top"`
+	Bottom string `json:"bottom"`
+}
+
+// DisplaySimulatorResponse - Output DTO with what the simulated display shows
+type DisplaySimulatorResponse struct {
+	Top       string     `json:"top"`
+	Bottom    string     `json:"bottom"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// DrawerSimulatorResponse - Output DTO with how often the simulated drawer fired
+type DrawerSimulatorResponse struct {
+	Kicks      int        `json:"kicks"`
+	LastKickAt *time.Time `json:"last_kick_at,omitempty"`
+}
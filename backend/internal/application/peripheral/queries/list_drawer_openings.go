@@ -0,0 +1,54 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/peripheral/dto"
+	"POSFlowBackend/internal/domain/peripheral"
+	"time"
+)
+
+type ListDrawerOpeningsQuery struct {
+	drawerService *peripheral.DrawerService
+}
+
+func NewListDrawerOpeningsQuery(drawerService *peripheral.DrawerService) *ListDrawerOpeningsQuery {
+	return &ListDrawerOpeningsQuery{drawerService: drawerService}
+}
+
+// Execute lists drawer openings between the start and end days, inclusive,
+// optionally only those with the given reason
+func (q *ListDrawerOpeningsQuery) Execute(startDate, endDate time.Time, reason string) (*dto.DrawerOpeningListResponse, error) {
+	openings, err := q.drawerService.FindByDays(startDate, endDate, peripheral.Reason(reason))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	responses := []*dto.DrawerOpeningResponse{}
+	noSales, failed := 0, 0
+
+	for _, opening := range openings {
+		if opening.Reason() == peripheral.ReasonNoSale {
+			noSales++
+		}
+		if !opening.Succeeded() {
+			failed++
+		}
+		responses = append(responses, &dto.DrawerOpeningResponse{
+			ID:          opening.ID().String(),
+			Reason:      string(opening.Reason()),
+			OrderID:     opening.OrderID().String(),
+			StaffMember: opening.StaffMember(),
+			Note:        opening.Note(),
+			Succeeded:   opening.Succeeded(),
+			Failure:     opening.Failure(),
+			OpenedAt:    opening.OpenedAt(),
+		})
+	}
+
+	return &dto.DrawerOpeningListResponse{
+		Openings: responses,
+		Total:    len(responses),
+		NoSales:  noSales,
+		Failed:   failed,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/printing/dto"
+	"POSFlowBackend/internal/domain/kitchen"
+	"POSFlowBackend/internal/domain/printing"
+
+	"github.com/google/uuid"
+)
+
+type CreateKitchenPrinterCommand struct {
+	repo        printing.KitchenPrinterRepository
+	stationRepo kitchen.StationRepository
+}
+
+func NewCreateKitchenPrinterCommand(repo printing.KitchenPrinterRepository, stationRepo kitchen.StationRepository) *CreateKitchenPrinterCommand {
+	return &CreateKitchenPrinterCommand{
+		repo:        repo,
+		stationRepo: stationRepo,
+	}
+}
+
+func (c *CreateKitchenPrinterCommand) Execute(req dto.CreateKitchenPrinterRequest) (*dto.KitchenPrinterResponse, error) {
+	// Check served stations exist
+	stations, err := parseStationIDs(c.stationRepo, req.StationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	paper := printing.Paper80mm
+	if req.Paper != "" {
+		paper = printing.PaperWidth(req.Paper)
+	}
+
+	// Generate ID
+	id := printing.PrinterID(uuid.New().String())
+
+	// Create printer entity using domain factory
+	printer, err := printing.NewKitchenPrinter(id, req.Name, printing.Driver(req.Driver), req.Address, paper, stations)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to repository
+	if err := c.repo.Save(printer); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapKitchenPrinterToDTO(printer), nil
+}
+
+// parseStationIDs converts station ID strings, rejecting unknown stations
+func parseStationIDs(stationRepo kitchen.StationRepository, values []string) ([]kitchen.StationID, error) {
+	stations := make([]kitchen.StationID, 0, len(values))
+	for _, value := range values {
+		station, err := stationRepo.FindByID(kitchen.StationID(value))
+		if err != nil {
+			return nil, err
+		}
+		stations = append(stations, station.ID())
+	}
+	return stations, nil
+}
+
+func mapKitchenPrinterToDTO(printer *printing.KitchenPrinter) *dto.KitchenPrinterResponse {
+	stationIDs := make([]string, 0, len(printer.Stations()))
+	for _, stationID := range printer.Stations() {
+		stationIDs = append(stationIDs, stationID.String())
+	}
+
+	return &dto.KitchenPrinterResponse{
+		ID:         printer.ID().String(),
+		Name:       printer.Name(),
+		Driver:     string(printer.Driver()),
+		Address:    printer.Address(),
+		Paper:      string(printer.Paper()),
+		StationIDs: stationIDs,
+		CreatedAt:  printer.CreatedAt(),
+		UpdatedAt:  printer.UpdatedAt(),
+	}
+}
@@ -0,0 +1,16 @@
+package commands
+
+import "POSFlowBackend/internal/domain/printing"
+
+type DeleteKitchenPrinterCommand struct {
+	repo printing.KitchenPrinterRepository
+}
+
+func NewDeleteKitchenPrinterCommand(repo printing.KitchenPrinterRepository) *DeleteKitchenPrinterCommand {
+	return &DeleteKitchenPrinterCommand{repo: repo}
+}
+
+// Execute removes a kitchen printer; chits still queued for it fail
+func (c *DeleteKitchenPrinterCommand) Execute(id string) error {
+	return c.repo.Delete(printing.PrinterID(id))
+}
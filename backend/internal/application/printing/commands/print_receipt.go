@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/printing/dto"
+	"POSFlowBackend/internal/domain/printing"
+	"POSFlowBackend/internal/domain/shared"
+	"time"
+)
+
+type PrintReceiptCommand struct {
+	receiptService *printing.ReceiptService
+}
+
+func NewPrintReceiptCommand(receiptService *printing.ReceiptService) *PrintReceiptCommand {
+	return &PrintReceiptCommand{receiptService: receiptService}
+}
+
+func (c *PrintReceiptCommand) Execute(orderID string) (*dto.ReceiptResponse, error) {
+	// Print receipt using domain service
+	doc, err := c.receiptService.PrintReceipt(shared.OrderID(orderID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	printedAt := time.Now()
+	return &dto.ReceiptResponse{
+		OrderID:   orderID,
+		Paper:     string(doc.Paper()),
+		Columns:   doc.Paper().Columns(),
+		Text:      doc.PlainText(),
+		PrintedAt: &printedAt,
+	}, nil
+}
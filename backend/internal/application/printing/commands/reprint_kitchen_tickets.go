@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/printing/dto"
+	"POSFlowBackend/internal/domain/kitchen"
+	"POSFlowBackend/internal/domain/printing"
+	"POSFlowBackend/internal/domain/shared"
+
+	"github.com/google/uuid"
+)
+
+type ReprintKitchenTicketsCommand struct {
+	kitchenTicketService *printing.KitchenTicketService
+}
+
+func NewReprintKitchenTicketsCommand(kitchenTicketService *printing.KitchenTicketService) *ReprintKitchenTicketsCommand {
+	return &ReprintKitchenTicketsCommand{kitchenTicketService: kitchenTicketService}
+}
+
+// Execute queues copies of an order's chits; they are printed by the
+// print queue like any other chit
+func (c *ReprintKitchenTicketsCommand) Execute(orderID string, req dto.ReprintRequest) (*dto.PrintJobListResponse, error) {
+	// Queue reprint using domain service
+	batch := "reprint-" + uuid.New().String()
+	jobs, err := c.kitchenTicketService.Reprint(shared.OrderID(orderID), kitchen.StationID(req.StationID), batch)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	responses := make([]*dto.PrintJobResponse, 0, len(jobs))
+	for _, job := range jobs {
+		responses = append(responses, mapPrintJobToDTO(job))
+	}
+
+	return &dto.PrintJobListResponse{
+		Jobs:  responses,
+		Total: len(responses),
+	}, nil
+}
+
+func mapPrintJobToDTO(job *printing.PrintJob) *dto.PrintJobResponse {
+	return &dto.PrintJobResponse{
+		ID:            job.ID().String(),
+		PrinterID:     job.PrinterID().String(),
+		OrderID:       job.OrderID().String(),
+		StationID:     job.StationID().String(),
+		Kind:          string(job.Kind()),
+		Status:        string(job.Status()),
+		Attempts:      job.Attempts(),
+		LastError:     job.LastError(),
+		NextAttemptAt: job.NextAttemptAt(),
+		PrintedAt:     job.PrintedAt(),
+		Text:          job.Document().PlainText(),
+		CreatedAt:     job.CreatedAt(),
+		UpdatedAt:     job.UpdatedAt(),
+	}
+}
@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/printing/dto"
+	"POSFlowBackend/internal/domain/printing"
+)
+
+type RetryPrintJobCommand struct {
+	kitchenTicketService *printing.KitchenTicketService
+}
+
+func NewRetryPrintJobCommand(kitchenTicketService *printing.KitchenTicketService) *RetryPrintJobCommand {
+	return &RetryPrintJobCommand{kitchenTicketService: kitchenTicketService}
+}
+
+// Execute prints a job again right away; the response carries the outcome
+func (c *RetryPrintJobCommand) Execute(id string) (*dto.PrintJobResponse, error) {
+	// Retry using domain service
+	job, err := c.kitchenTicketService.Retry(printing.PrintJobID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapPrintJobToDTO(job), nil
+}
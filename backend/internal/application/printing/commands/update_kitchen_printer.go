@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/printing/dto"
+	"POSFlowBackend/internal/domain/kitchen"
+	"POSFlowBackend/internal/domain/printing"
+)
+
+type UpdateKitchenPrinterCommand struct {
+	repo        printing.KitchenPrinterRepository
+	stationRepo kitchen.StationRepository
+}
+
+func NewUpdateKitchenPrinterCommand(repo printing.KitchenPrinterRepository, stationRepo kitchen.StationRepository) *UpdateKitchenPrinterCommand {
+	return &UpdateKitchenPrinterCommand{
+		repo:        repo,
+		stationRepo: stationRepo,
+	}
+}
+
+func (c *UpdateKitchenPrinterCommand) Execute(id string, req dto.UpdateKitchenPrinterRequest) (*dto.KitchenPrinterResponse, error) {
+	// Find printer
+	printer, err := c.repo.FindByID(printing.PrinterID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Update using domain methods
+	if req.Name != "" {
+		if err := printer.Rename(req.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	driver := printer.Driver()
+	if req.Driver != "" {
+		driver = printing.Driver(req.Driver)
+	}
+	address := printer.Address()
+	if req.Address != nil {
+		address = *req.Address
+	}
+	paper := printer.Paper()
+	if req.Paper != "" {
+		paper = printing.PaperWidth(req.Paper)
+	}
+	if err := printer.Connect(driver, address, paper); err != nil {
+		return nil, err
+	}
+
+	if req.StationIDs != nil {
+		stations, err := parseStationIDs(c.stationRepo, *req.StationIDs)
+		if err != nil {
+			return nil, err
+		}
+		printer.AssignStations(stations)
+	}
+
+	// Save changes
+	if err := c.repo.Save(printer); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapKitchenPrinterToDTO(printer), nil
+}
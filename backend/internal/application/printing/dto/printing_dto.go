@@ -0,0 +1,100 @@
+package dto
+
+import "time"
+
+// ReceiptRequest - Query DTO; the paper width defaults to the receipt printer's
+type ReceiptRequest struct {
+	Paper string `form:"paper" binding:"omitempty,oneof=58mm 80mm"`
+}
+
+// ReceiptResponse - Output DTO for a receipt rendered as plain text
+type ReceiptResponse struct {
+	OrderID   string     `json:"order_id"`
+	Paper     string     `json:"paper"`
+	Columns   int        `json:"columns"`
+	Text      string     `json:"text"`
+	PrintedAt *time.Time `json:"printed_at,omitempty"`
+}
+
+// MemoryJobResponse - Output DTO for a job held by the memory printer
+type MemoryJobResponse struct {
+	Index int    `json:"index"`
+	Bytes int    `json:"bytes"`
+	Text  string `json:"text"` // the job with ESC/POS commands stripped
+}
+
+// MemoryJobListResponse - Output DTO for the memory printer's jobs
+type MemoryJobListResponse struct {
+	Jobs  []*MemoryJobResponse `json:"jobs"`
+	Total int                  `json:"total"`
+}
+
+// CreateKitchenPrinterRequest - Input DTO for adding a kitchen printer
+type CreateKitchenPrinterRequest struct {
+	Name       string   `json:"name" binding:"required"`
+	Driver     string   `json:"driver" binding:"required,oneof=tcp file memory"`
+	Address    string   `json:"address"`                                   // host:port for tcp, a path for file
+	Paper      string   `json:"paper" binding:"omitempty,oneof=58mm 80mm"` // defaults to 80mm
+	StationIDs []string `json:"station_ids"`
+}
+
+// UpdateKitchenPrinterRequest - Input DTO for changing a kitchen printer; omitted fields are kept
+type UpdateKitchenPrinterRequest struct {
+	Name       string    `json:"name"`
+	Driver     string    `json:"driver" binding:"omitempty,oneof=tcp file memory"`
+	Address    *string   `json:"address"`
+	Paper      string    `json:"paper" binding:"omitempty,oneof=58mm 80mm"`
+	StationIDs *[]string `json:"station_ids"`
+}
+
+// KitchenPrinterResponse - Output DTO for a kitchen printer
+type KitchenPrinterResponse struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Driver     string    `json:"driver"`
+	Address    string    `json:"address"`
+	Paper      string    `json:"paper"`
+	StationIDs []string  `json:"station_ids"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// KitchenPrinterListResponse - Output DTO for the list of kitchen printers
+type KitchenPrinterListResponse struct {
+	Printers []*KitchenPrinterResponse `json:"printers"`
+	Total    int                       `json:"total"`
+}
+
+// ReprintRequest - Input DTO for reprinting an order's chits, for one station or all
+type ReprintRequest struct {
+	StationID string `json:"station_id"`
+}
+
+// ListPrintJobsRequest - Query DTO for the print job queue
+type ListPrintJobsRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=pending printed failed"`
+	Limit  int    `form:"limit" binding:"omitempty,gte=1,lte=200"`
+}
+
+// PrintJobResponse - Output DTO for a kitchen chit in the print queue
+type PrintJobResponse struct {
+	ID            string     `json:"id"`
+	PrinterID     string     `json:"printer_id"`
+	OrderID       string     `json:"order_id"`
+	StationID     string     `json:"station_id"`
+	Kind          string     `json:"kind"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"last_error,omitempty"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	PrintedAt     *time.Time `json:"printed_at,omitempty"`
+	Text          string     `json:"text"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// PrintJobListResponse - Output DTO for a list of queued chits
+type PrintJobListResponse struct {
+	Jobs  []*PrintJobResponse `json:"jobs"`
+	Total int                 `json:"total"`
+}
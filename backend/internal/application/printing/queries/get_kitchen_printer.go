@@ -0,0 +1,43 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/printing/dto"
+	"POSFlowBackend/internal/domain/printing"
+)
+
+type GetKitchenPrinterQuery struct {
+	repo printing.KitchenPrinterRepository
+}
+
+func NewGetKitchenPrinterQuery(repo printing.KitchenPrinterRepository) *GetKitchenPrinterQuery {
+	return &GetKitchenPrinterQuery{repo: repo}
+}
+
+func (q *GetKitchenPrinterQuery) Execute(id string) (*dto.KitchenPrinterResponse, error) {
+	// Find printer
+	printer, err := q.repo.FindByID(printing.PrinterID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapKitchenPrinterToDTO(printer), nil
+}
+
+func mapKitchenPrinterToDTO(printer *printing.KitchenPrinter) *dto.KitchenPrinterResponse {
+	stationIDs := make([]string, 0, len(printer.Stations()))
+	for _, stationID := range printer.Stations() {
+		stationIDs = append(stationIDs, stationID.String())
+	}
+
+	return &dto.KitchenPrinterResponse{
+		ID:         printer.ID().String(),
+		Name:       printer.Name(),
+		Driver:     string(printer.Driver()),
+		Address:    printer.Address(),
+		Paper:      string(printer.Paper()),
+		StationIDs: stationIDs,
+		CreatedAt:  printer.CreatedAt(),
+		UpdatedAt:  printer.UpdatedAt(),
+	}
+}
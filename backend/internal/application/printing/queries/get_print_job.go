@@ -0,0 +1,25 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/printing/dto"
+	"POSFlowBackend/internal/domain/printing"
+)
+
+type GetPrintJobQuery struct {
+	repo printing.PrintJobRepository
+}
+
+func NewGetPrintJobQuery(repo printing.PrintJobRepository) *GetPrintJobQuery {
+	return &GetPrintJobQuery{repo: repo}
+}
+
+func (q *GetPrintJobQuery) Execute(id string) (*dto.PrintJobResponse, error) {
+	// Find job
+	job, err := q.repo.FindByID(printing.PrintJobID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapPrintJobToDTO(job), nil
+}
@@ -0,0 +1,33 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/printing/dto"
+	"POSFlowBackend/internal/domain/printing"
+)
+
+type ListKitchenPrintersQuery struct {
+	repo printing.KitchenPrinterRepository
+}
+
+func NewListKitchenPrintersQuery(repo printing.KitchenPrinterRepository) *ListKitchenPrintersQuery {
+	return &ListKitchenPrintersQuery{repo: repo}
+}
+
+func (q *ListKitchenPrintersQuery) Execute() (*dto.KitchenPrinterListResponse, error) {
+	// Find all printers
+	printers, err := q.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	responses := make([]*dto.KitchenPrinterResponse, 0, len(printers))
+	for _, printer := range printers {
+		responses = append(responses, mapKitchenPrinterToDTO(printer))
+	}
+
+	return &dto.KitchenPrinterListResponse{
+		Printers: responses,
+		Total:    len(responses),
+	}, nil
+}
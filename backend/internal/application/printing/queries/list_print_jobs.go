@@ -0,0 +1,60 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/printing/dto"
+	"POSFlowBackend/internal/domain/printing"
+)
+
+// defaultPrintJobLimit is how many jobs are listed when no limit is given
+const defaultPrintJobLimit = 50
+
+type ListPrintJobsQuery struct {
+	repo printing.PrintJobRepository
+}
+
+func NewListPrintJobsQuery(repo printing.PrintJobRepository) *ListPrintJobsQuery {
+	return &ListPrintJobsQuery{repo: repo}
+}
+
+// Execute returns queued chits newest first, e.g. the failed ones to retry
+func (q *ListPrintJobsQuery) Execute(req dto.ListPrintJobsRequest) (*dto.PrintJobListResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultPrintJobLimit
+	}
+
+	// Find jobs
+	jobs, err := q.repo.FindByStatus(printing.JobStatus(req.Status), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	responses := make([]*dto.PrintJobResponse, 0, len(jobs))
+	for _, job := range jobs {
+		responses = append(responses, mapPrintJobToDTO(job))
+	}
+
+	return &dto.PrintJobListResponse{
+		Jobs:  responses,
+		Total: len(responses),
+	}, nil
+}
+
+func mapPrintJobToDTO(job *printing.PrintJob) *dto.PrintJobResponse {
+	return &dto.PrintJobResponse{
+		ID:            job.ID().String(),
+		PrinterID:     job.PrinterID().String(),
+		OrderID:       job.OrderID().String(),
+		StationID:     job.StationID().String(),
+		Kind:          string(job.Kind()),
+		Status:        string(job.Status()),
+		Attempts:      job.Attempts(),
+		LastError:     job.LastError(),
+		NextAttemptAt: job.NextAttemptAt(),
+		PrintedAt:     job.PrintedAt(),
+		Text:          job.Document().PlainText(),
+		CreatedAt:     job.CreatedAt(),
+		UpdatedAt:     job.UpdatedAt(),
+	}
+}
@@ -0,0 +1,36 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/printing/dto"
+	"POSFlowBackend/internal/domain/printing"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type PreviewReceiptQuery struct {
+	receiptService *printing.ReceiptService
+}
+
+func NewPreviewReceiptQuery(receiptService *printing.ReceiptService) *PreviewReceiptQuery {
+	return &PreviewReceiptQuery{receiptService: receiptService}
+}
+
+func (q *PreviewReceiptQuery) Execute(orderID string, req dto.ReceiptRequest) (*dto.ReceiptResponse, error) {
+	paper := q.receiptService.Paper()
+	if req.Paper != "" {
+		paper = printing.PaperWidth(req.Paper)
+	}
+
+	// Lay out receipt
+	doc, err := q.receiptService.Receipt(shared.OrderID(orderID), paper)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return &dto.ReceiptResponse{
+		OrderID: orderID,
+		Paper:   string(doc.Paper()),
+		Columns: doc.Paper().Columns(),
+		Text:    doc.PlainText(),
+	}, nil
+}
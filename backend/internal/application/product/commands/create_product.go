@@ -26,20 +26,75 @@ func (c *CreateProductCommand) Execute(req dto.CreateProductRequest) (*dto.Produ
 		return nil, err
 	}
 
-	// Map category
+	// Map category and unit of measure
 	category := product.Category(req.Category)
+	unit := shared.UnitPiece
+	if req.Unit != "" {
+		unit = shared.UnitOfMeasure(req.Unit)
+	}
 
 	// Create product entity using domain factory
-	prod, err := product.NewProduct(id, req.Name, *price, category, req.Stock)
+	prod, err := product.NewProduct(id, req.Name, *price, category, unit, req.Stock)
 	if err != nil {
 		return nil, err
 	}
 
+	// Set purchase unit if provided
+	if req.PurchaseUnit != "" || req.UnitsPerPurchase > 0 {
+		purchaseUnit := unit
+		if req.PurchaseUnit != "" {
+			purchaseUnit = shared.UnitOfMeasure(req.PurchaseUnit)
+		}
+		if err := prod.UpdateUnits(unit, purchaseUnit, req.UnitsPerPurchase); err != nil {
+			return nil, err
+		}
+	}
+
 	// Add description if provided
 	if req.Description != "" {
 		prod.UpdateInfo(req.Name, req.Description, category)
 	}
 
+	// Add cost price if provided
+	if req.Cost > 0 {
+		cost, err := shared.NewMoney(req.Cost)
+		if err != nil {
+			return nil, err
+		}
+		if err := prod.UpdateCost(*cost); err != nil {
+			return nil, err
+		}
+	}
+
+	// Add SKU if provided
+	if req.SKU != "" {
+		if err := prod.UpdateSKU(req.SKU); err != nil {
+			return nil, err
+		}
+	}
+
+	// Flag as sold by weight if requested
+	if req.Weighed {
+		if err := prod.SetWeighed(true); err != nil {
+			return nil, err
+		}
+	}
+
+	// Add allergens and dietary labels if provided
+	if len(req.Allergens) > 0 || len(req.DietaryLabels) > 0 {
+		allergens, err := product.ParseAllergens(req.Allergens)
+		if err != nil {
+			return nil, err
+		}
+		dietaryLabels, err := product.ParseDietaryLabels(req.DietaryLabels)
+		if err != nil {
+			return nil, err
+		}
+		if err := prod.UpdateTags(allergens, dietaryLabels); err != nil {
+			return nil, err
+		}
+	}
+
 	// Save to repository
 	if err := c.repo.Save(prod); err != nil {
 		return nil, err
@@ -47,15 +102,27 @@ func (c *CreateProductCommand) Execute(req dto.CreateProductRequest) (*dto.Produ
 
 	// Map to response DTO
 	return &dto.ProductResponse{
-		ID:          prod.ID().String(),
-		Name:        prod.Name(),
-		Description: prod.Description(),
-		Price:       prod.Price().Amount,
-		Category:    string(prod.Category()),
-		Stock:       prod.Stock(),
-		Active:      prod.IsActive(),
-		IsLowStock:  prod.IsLowStock(),
-		CreatedAt:   prod.CreatedAt(),
-		UpdatedAt:   prod.UpdatedAt(),
+		ID:               prod.ID().String(),
+		Name:             prod.Name(),
+		Description:      prod.Description(),
+		SKU:              prod.SKU(),
+		Price:            prod.Price().Amount,
+		Cost:             prod.Cost().Amount,
+		MarginPercent:    prod.MarginPercent(),
+		Category:         string(prod.Category()),
+		Stock:            prod.Stock(),
+		Unit:             string(prod.Unit()),
+		PurchaseUnit:     string(prod.PurchaseUnit()),
+		UnitsPerPurchase: prod.UnitsPerPurchase(),
+		Weighed:          prod.IsWeighed(),
+		Allergens:        product.AllergenStrings(prod.Allergens()),
+		DietaryLabels:    product.DietaryLabelStrings(prod.DietaryLabels()),
+		Active:           prod.IsActive(),
+		Available:        prod.IsAvailable(),
+		Archived:         prod.IsArchived(),
+		ArchivedAt:       prod.ArchivedAt(),
+		IsLowStock:       prod.IsLowStock(),
+		CreatedAt:        prod.CreatedAt(),
+		UpdatedAt:        prod.UpdatedAt(),
 	}, nil
 }
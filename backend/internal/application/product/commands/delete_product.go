@@ -20,8 +20,10 @@ func (c *DeleteProductCommand) Execute(id string) error {
 		return err
 	}
 
-	// Soft delete by deactivating
-	prod.Deactivate()
+	// Soft delete by archiving
+	if err := prod.Archive(); err != nil {
+		return err
+	}
 
 	// Save changes
 	if err := c.repo.Save(prod); err != nil {
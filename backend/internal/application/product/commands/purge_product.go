@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/purchasing"
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/waste"
+	"fmt"
+)
+
+type PurgeProductCommand struct {
+	repo              product.ProductRepository
+	orderRepo         order.OrderRepository
+	purchaseOrderRepo purchasing.PurchaseOrderRepository
+	wasteRepo         waste.WasteRepository
+}
+
+func NewPurgeProductCommand(
+	repo product.ProductRepository,
+	orderRepo order.OrderRepository,
+	purchaseOrderRepo purchasing.PurchaseOrderRepository,
+	wasteRepo waste.WasteRepository,
+) *PurgeProductCommand {
+	return &PurgeProductCommand{
+		repo:              repo,
+		orderRepo:         orderRepo,
+		purchaseOrderRepo: purchaseOrderRepo,
+		wasteRepo:         wasteRepo,
+	}
+}
+
+// Execute permanently removes an archived product.
+// Products that appear in any order, purchase order or waste entry are kept
+// so their history stays resolvable.
+func (c *PurgeProductCommand) Execute(id string) error {
+	// Find product, archived ones included
+	prod, err := c.repo.FindByIDIncludingArchived(shared.ProductID(id))
+	if err != nil {
+		return err
+	}
+
+	// Only archived products can be purged
+	if !prod.IsArchived() {
+		return fmt.Errorf("%w: product must be archived before purging", shared.ErrInvalidInput)
+	}
+
+	// Refuse to purge products that have ever been sold
+	sold, err := c.orderRepo.ExistsByProduct(prod.ID())
+	if err != nil {
+		return err
+	}
+	if sold {
+		return shared.ErrProductInUse
+	}
+
+	// Purchase orders show their lines by product too
+	ordered, err := c.purchaseOrderRepo.ExistsByProduct(prod.ID())
+	if err != nil {
+		return err
+	}
+	if ordered {
+		return shared.ErrProductInUse
+	}
+
+	// So does the waste log
+	wasted, err := c.wasteRepo.ExistsByProduct(prod.ID())
+	if err != nil {
+		return err
+	}
+	if wasted {
+		return shared.ErrProductInUse
+	}
+
+	return c.repo.Purge(prod.ID())
+}
@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/product/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReceiveLotCommand struct {
+	repo product.ProductRepository
+}
+
+func NewReceiveLotCommand(repo product.ProductRepository) *ReceiveLotCommand {
+	return &ReceiveLotCommand{repo: repo}
+}
+
+// Execute adds stock received in a lot with an expiry date
+func (c *ReceiveLotCommand) Execute(productID string, req dto.ReceiveLotRequest) (*dto.LotResponse, error) {
+	expiresAt, err := time.Parse("2006-01-02", req.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: expires_at must be YYYY-MM-DD", shared.ErrInvalidInput)
+	}
+
+	// Find product
+	prod, err := c.repo.FindByID(shared.ProductID(productID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate ID and receive the lot
+	lot, err := prod.ReceiveLot(product.LotID(uuid.New().String()), req.LotCode, req.Quantity, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save changes
+	if err := c.repo.Save(prod); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	now := time.Now()
+	return &dto.LotResponse{
+		ID:              lot.ID.String(),
+		ProductID:       prod.ID().String(),
+		ProductName:     prod.Name(),
+		Code:            lot.Code,
+		Quantity:        lot.Quantity,
+		ExpiresAt:       lot.ExpiresAt.Format("2006-01-02"),
+		DaysUntilExpiry: daysUntil(lot.ExpiresAt, now),
+		IsExpired:       lot.IsExpired(now),
+		CostValue:       prod.Cost().Amount * float64(lot.Quantity),
+		ReceivedAt:      lot.ReceivedAt,
+	}, nil
+}
+
+// daysUntil counts calendar days from today to the given date; negative once it has passed
+func daysUntil(date, now time.Time) int {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return int(day.Sub(today).Hours() / 24)
+}
@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/product/dto"
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type RestoreProductCommand struct {
+	repo      product.ProductRepository
+	publisher event.Publisher
+}
+
+func NewRestoreProductCommand(repo product.ProductRepository, publisher event.Publisher) *RestoreProductCommand {
+	return &RestoreProductCommand{repo: repo, publisher: publisher}
+}
+
+func (c *RestoreProductCommand) Execute(id string) (*dto.ProductResponse, error) {
+	// Find product, archived ones included
+	prod, err := c.repo.FindByIDIncludingArchived(shared.ProductID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Bring it back into the catalog
+	if err := prod.Restore(); err != nil {
+		return nil, err
+	}
+
+	// Save changes
+	if err := c.repo.Save(prod); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	response := &dto.ProductResponse{
+		ID:               prod.ID().String(),
+		Name:             prod.Name(),
+		Description:      prod.Description(),
+		SKU:              prod.SKU(),
+		Price:            prod.Price().Amount,
+		Cost:             prod.Cost().Amount,
+		MarginPercent:    prod.MarginPercent(),
+		Category:         string(prod.Category()),
+		Stock:            prod.Stock(),
+		Unit:             string(prod.Unit()),
+		PurchaseUnit:     string(prod.PurchaseUnit()),
+		UnitsPerPurchase: prod.UnitsPerPurchase(),
+		Weighed:          prod.IsWeighed(),
+		Allergens:        product.AllergenStrings(prod.Allergens()),
+		DietaryLabels:    product.DietaryLabelStrings(prod.DietaryLabels()),
+		Active:           prod.IsActive(),
+		Available:        prod.IsAvailable(),
+		Archived:         prod.IsArchived(),
+		ArchivedAt:       prod.ArchivedAt(),
+		IsLowStock:       prod.IsLowStock(),
+		CreatedAt:        prod.CreatedAt(),
+		UpdatedAt:        prod.UpdatedAt(),
+	}
+
+	// Notify connected clients
+	c.publisher.Publish(event.TopicProductUpdated, response)
+
+	return response, nil
+}
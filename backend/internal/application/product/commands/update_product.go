@@ -2,16 +2,18 @@ package commands
 
 import (
 	"POSFlowBackend/internal/application/product/dto"
+	"POSFlowBackend/internal/domain/event"
 	"POSFlowBackend/internal/domain/product"
 	"POSFlowBackend/internal/domain/shared"
 )
 
 type UpdateProductCommand struct {
-	repo product.ProductRepository
+	repo      product.ProductRepository
+	publisher event.Publisher
 }
 
-func NewUpdateProductCommand(repo product.ProductRepository) *UpdateProductCommand {
-	return &UpdateProductCommand{repo: repo}
+func NewUpdateProductCommand(repo product.ProductRepository, publisher event.Publisher) *UpdateProductCommand {
+	return &UpdateProductCommand{repo: repo, publisher: publisher}
 }
 
 func (c *UpdateProductCommand) Execute(id string, req dto.UpdateProductRequest) (*dto.ProductResponse, error) {
@@ -32,6 +34,17 @@ func (c *UpdateProductCommand) Execute(id string, req dto.UpdateProductRequest)
 		}
 	}
 
+	// Update cost price if provided (zero is allowed)
+	if req.Cost != nil {
+		cost, err := shared.NewMoney(*req.Cost)
+		if err != nil {
+			return nil, err
+		}
+		if err := prod.UpdateCost(*cost); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update info if provided
 	if req.Name != "" || req.Description != "" || req.Category != "" {
 		name := req.Name
@@ -54,22 +67,109 @@ func (c *UpdateProductCommand) Execute(id string, req dto.UpdateProductRequest)
 		}
 	}
 
+	// Clear the weighed flag before units change, so a product can stop
+	// being weighed and move to a non-mass unit in one update
+	if req.Weighed != nil && !*req.Weighed {
+		if err := prod.SetWeighed(false); err != nil {
+			return nil, err
+		}
+	}
+
+	// Update units if provided; the conversion factor is re-derived
+	// unless given, so changing a unit does not keep a stale factor
+	if req.Unit != "" || req.PurchaseUnit != "" || req.UnitsPerPurchase != nil {
+		unit := prod.Unit()
+		if req.Unit != "" {
+			unit = shared.UnitOfMeasure(req.Unit)
+		}
+
+		purchaseUnit := prod.PurchaseUnit()
+		if req.PurchaseUnit != "" {
+			purchaseUnit = shared.UnitOfMeasure(req.PurchaseUnit)
+		}
+
+		unitsPerPurchase := 0.0
+		if req.UnitsPerPurchase != nil {
+			unitsPerPurchase = *req.UnitsPerPurchase
+		} else if unit == prod.Unit() && purchaseUnit == prod.PurchaseUnit() {
+			unitsPerPurchase = prod.UnitsPerPurchase()
+		}
+
+		if err := prod.UpdateUnits(unit, purchaseUnit, unitsPerPurchase); err != nil {
+			return nil, err
+		}
+	}
+
+	// Flag as sold by weight once the units are final
+	if req.Weighed != nil && *req.Weighed {
+		if err := prod.SetWeighed(true); err != nil {
+			return nil, err
+		}
+	}
+
+	// Update SKU if provided
+	if req.SKU != "" {
+		if err := prod.UpdateSKU(req.SKU); err != nil {
+			return nil, err
+		}
+	}
+
+	// Update tags if provided (an empty list clears them)
+	if req.Allergens != nil || req.DietaryLabels != nil {
+		allergens := prod.Allergens()
+		if req.Allergens != nil {
+			allergens, err = product.ParseAllergens(req.Allergens)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		dietaryLabels := prod.DietaryLabels()
+		if req.DietaryLabels != nil {
+			dietaryLabels, err = product.ParseDietaryLabels(req.DietaryLabels)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := prod.UpdateTags(allergens, dietaryLabels); err != nil {
+			return nil, err
+		}
+	}
+
 	// Save changes
 	if err := c.repo.Save(prod); err != nil {
 		return nil, err
 	}
 
 	// Map to response DTO
-	return &dto.ProductResponse{
-		ID:          prod.ID().String(),
-		Name:        prod.Name(),
-		Description: prod.Description(),
-		Price:       prod.Price().Amount,
-		Category:    string(prod.Category()),
-		Stock:       prod.Stock(),
-		Active:      prod.IsActive(),
-		IsLowStock:  prod.IsLowStock(),
-		CreatedAt:   prod.CreatedAt(),
-		UpdatedAt:   prod.UpdatedAt(),
-	}, nil
+	response := &dto.ProductResponse{
+		ID:               prod.ID().String(),
+		Name:             prod.Name(),
+		Description:      prod.Description(),
+		SKU:              prod.SKU(),
+		Price:            prod.Price().Amount,
+		Cost:             prod.Cost().Amount,
+		MarginPercent:    prod.MarginPercent(),
+		Category:         string(prod.Category()),
+		Stock:            prod.Stock(),
+		Unit:             string(prod.Unit()),
+		PurchaseUnit:     string(prod.PurchaseUnit()),
+		UnitsPerPurchase: prod.UnitsPerPurchase(),
+		Weighed:          prod.IsWeighed(),
+		Allergens:        product.AllergenStrings(prod.Allergens()),
+		DietaryLabels:    product.DietaryLabelStrings(prod.DietaryLabels()),
+		Active:           prod.IsActive(),
+		Available:        prod.IsAvailable(),
+		Archived:         prod.IsArchived(),
+		ArchivedAt:       prod.ArchivedAt(),
+		IsLowStock:       prod.IsLowStock(),
+		CreatedAt:        prod.CreatedAt(),
+		UpdatedAt:        prod.UpdatedAt(),
+	}
+
+	// Notify connected clients
+	c.publisher.Publish(event.TopicProductUpdated, response)
+
+	return response, nil
 }
@@ -2,53 +2,106 @@ package commands
 
 import (
 	"POSFlowBackend/internal/application/product/dto"
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/location"
 	"POSFlowBackend/internal/domain/product"
 	"POSFlowBackend/internal/domain/shared"
 )
 
 type UpdateStockCommand struct {
-	repo product.ProductRepository
+	uow       location.UnitOfWork
+	publisher event.Publisher
 }
 
-func NewUpdateStockCommand(repo product.ProductRepository) *UpdateStockCommand {
-	return &UpdateStockCommand{repo: repo}
+func NewUpdateStockCommand(
+	uow location.UnitOfWork,
+	publisher event.Publisher,
+) *UpdateStockCommand {
+	return &UpdateStockCommand{uow: uow, publisher: publisher}
 }
 
+// Execute adds stock to or removes it from a location, the default one when
+// none is given. The product and the location's stock level are saved in
+// one transaction.
 func (c *UpdateStockCommand) Execute(id string, req dto.UpdateStockRequest) (*dto.ProductResponse, error) {
-	// Find product
-	prod, err := c.repo.FindByID(shared.ProductID(id))
-	if err != nil {
-		return nil, err
-	}
+	var prod *product.Product
+	err := c.uow.Do(func(stores location.Stores) error {
+		// Find product
+		var err error
+		prod, err = stores.Products.FindByID(shared.ProductID(id))
+		if err != nil {
+			return err
+		}
 
-	// Update stock based on type
-	switch req.Type {
-	case "add":
-		if err := prod.IncreaseStock(req.Quantity); err != nil {
-			return nil, err
+		stock := location.NewStockService(stores.Locations, stores.StockLevels, stores.Transfers, stores.Products)
+		loc, err := stock.LocationOrDefault(location.LocationID(req.LocationID))
+		if err != nil {
+			return err
 		}
-	case "remove":
-		if err := prod.DecreaseStock(req.Quantity); err != nil {
-			return nil, err
+		allocations := []location.Allocation{{LocationID: loc.ID(), ProductID: prod.ID(), Quantity: req.Quantity}}
+
+		// Update stock based on type
+		switch req.Type {
+		case "add":
+			if err := prod.IncreaseStock(req.Quantity); err != nil {
+				return err
+			}
+		case "remove":
+			// Only what the location holds can be taken out of it
+			if err := stock.CheckAvailability(allocations); err != nil {
+				return err
+			}
+			if err := prod.DecreaseStock(req.Quantity); err != nil {
+				return err
+			}
 		}
-	}
 
-	// Save changes
-	if err := c.repo.Save(prod); err != nil {
+		// Save changes
+		if err := stores.Products.Save(prod); err != nil {
+			return err
+		}
+
+		// Keep the location's stock level in step
+		switch req.Type {
+		case "add":
+			return stock.Deposit(allocations)
+		case "remove":
+			return stock.Withdraw(allocations)
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	// Map to response DTO
-	return &dto.ProductResponse{
-		ID:          prod.ID().String(),
-		Name:        prod.Name(),
-		Description: prod.Description(),
-		Price:       prod.Price().Amount,
-		Category:    string(prod.Category()),
-		Stock:       prod.Stock(),
-		Active:      prod.IsActive(),
-		IsLowStock:  prod.IsLowStock(),
-		CreatedAt:   prod.CreatedAt(),
-		UpdatedAt:   prod.UpdatedAt(),
-	}, nil
+	response := &dto.ProductResponse{
+		ID:               prod.ID().String(),
+		Name:             prod.Name(),
+		Description:      prod.Description(),
+		SKU:              prod.SKU(),
+		Price:            prod.Price().Amount,
+		Cost:             prod.Cost().Amount,
+		MarginPercent:    prod.MarginPercent(),
+		Category:         string(prod.Category()),
+		Stock:            prod.Stock(),
+		Unit:             string(prod.Unit()),
+		PurchaseUnit:     string(prod.PurchaseUnit()),
+		UnitsPerPurchase: prod.UnitsPerPurchase(),
+		Weighed:          prod.IsWeighed(),
+		Allergens:        product.AllergenStrings(prod.Allergens()),
+		DietaryLabels:    product.DietaryLabelStrings(prod.DietaryLabels()),
+		Active:           prod.IsActive(),
+		Available:        prod.IsAvailable(),
+		Archived:         prod.IsArchived(),
+		ArchivedAt:       prod.ArchivedAt(),
+		IsLowStock:       prod.IsLowStock(),
+		CreatedAt:        prod.CreatedAt(),
+		UpdatedAt:        prod.UpdatedAt(),
+	}
+
+	// Notify connected clients
+	c.publisher.Publish(event.TopicProductUpdated, response)
+
+	return response, nil
 }
@@ -6,43 +6,141 @@ import (
 
 // CreateProductRequest - Input DTO for creating a product
 type CreateProductRequest struct {
-	Name        string  `json:"name" binding:"required"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price" binding:"required,gt=0"`
-	Category    string  `json:"category" binding:"required"`
-	Stock       int     `json:"stock" binding:"gte=0"`
+	Name             string   `json:"name" binding:"required"`
+	Description      string   `json:"description"`
+	SKU              string   `json:"sku"`
+	Price            float64  `json:"price" binding:"required,gt=0"`
+	Cost             float64  `json:"cost" binding:"gte=0"`
+	Category         string   `json:"category" binding:"required"`
+	Stock            float64  `json:"stock" binding:"gte=0"`
+	Unit             string   `json:"unit" binding:"omitempty,oneof=unit g kg ml l"`          // sale and stock unit, defaults to unit
+	PurchaseUnit     string   `json:"purchase_unit" binding:"omitempty,oneof=unit g kg ml l"` // defaults to the sale unit
+	UnitsPerPurchase float64  `json:"units_per_purchase" binding:"gte=0"`                     // sale units in one purchase unit
+	Weighed          bool     `json:"weighed"`                                                // priced per kg or g from the scale
+	Allergens        []string `json:"allergens"`
+	DietaryLabels    []string `json:"dietary_labels"`
 }
 
 // UpdateProductRequest - Input DTO for updating a product
 type UpdateProductRequest struct {
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price" binding:"gt=0"`
-	Category    string  `json:"category"`
+	Name             string   `json:"name"`
+	Description      string   `json:"description"`
+	SKU              string   `json:"sku"`
+	Price            float64  `json:"price" binding:"gt=0"`
+	Cost             *float64 `json:"cost" binding:"omitempty,gte=0"`
+	Category         string   `json:"category"`
+	Unit             string   `json:"unit" binding:"omitempty,oneof=unit g kg ml l"`
+	PurchaseUnit     string   `json:"purchase_unit" binding:"omitempty,oneof=unit g kg ml l"`
+	UnitsPerPurchase *float64 `json:"units_per_purchase" binding:"omitempty,gte=0"`
+	Weighed          *bool    `json:"weighed"`
+	Allergens        []string `json:"allergens"`
+	DietaryLabels    []string `json:"dietary_labels"`
 }
 
 // UpdateStockRequest - Input DTO for updating stock
 type UpdateStockRequest struct {
-	Quantity int    `json:"quantity" binding:"required,gt=0"`
-	Type     string `json:"type" binding:"required,oneof=add remove"`
+	Quantity   float64 `json:"quantity" binding:"required,gt=0"`
+	Type       string  `json:"type" binding:"required,oneof=add remove"`
+	LocationID string  `json:"location_id"` // defaults to the default location
+}
+
+// ListProductsRequest - Query DTO for searching and filtering the product list
+type ListProductsRequest struct {
+	Q            string `form:"q"`             // prefix-matched against name, description, SKU and tags
+	Category     string `form:"category"`      // exact category
+	Tags         string `form:"tags"`          // comma-separated allergens or dietary labels
+	AllergenFree string `form:"allergen_free"` // comma-separated allergens to exclude
+	Page         int    `form:"page" binding:"omitempty,gte=1"`
+	PageSize     int    `form:"page_size" binding:"omitempty,gte=1,lte=200"`
 }
 
 // ProductResponse - Output DTO
 type ProductResponse struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Price       float64   `json:"price"`
-	Category    string    `json:"category"`
-	Stock       int       `json:"stock"`
-	Active      bool      `json:"active"`
-	IsLowStock  bool      `json:"is_low_stock"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID               string     `json:"id"`
+	Name             string     `json:"name"`
+	Description      string     `json:"description"`
+	SKU              string     `json:"sku"`
+	Price            float64    `json:"price"`
+	Cost             float64    `json:"cost"`
+	MarginPercent    float64    `json:"margin_percent"`
+	Category         string     `json:"category"`
+	Stock            float64    `json:"stock"`
+	Unit             string     `json:"unit"`
+	PurchaseUnit     string     `json:"purchase_unit"`
+	UnitsPerPurchase float64    `json:"units_per_purchase"`
+	Weighed          bool       `json:"weighed"`
+	Allergens        []string   `json:"allergens"`
+	DietaryLabels    []string   `json:"dietary_labels"`
+	Active           bool       `json:"active"`
+	Available        bool       `json:"available"`
+	Archived         bool       `json:"archived"`
+	ArchivedAt       *time.Time `json:"archived_at,omitempty"`
+	IsLowStock       bool       `json:"is_low_stock"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
 }
 
 // ProductListResponse - Output DTO for list
 type ProductListResponse struct {
 	Products []*ProductResponse `json:"products"`
 	Total    int                `json:"total"`
+	Page     int                `json:"page,omitempty"`
+	PageSize int                `json:"page_size,omitempty"`
+}
+
+// CostRecordResponse - Output DTO for a historical cost price
+type CostRecordResponse struct {
+	Cost          float64   `json:"cost"`
+	EffectiveFrom time.Time `json:"effective_from"`
+}
+
+// CostHistoryResponse - Output DTO for the cost price history of a product
+type CostHistoryResponse struct {
+	ProductID   string                `json:"product_id"`
+	CurrentCost float64               `json:"current_cost"`
+	History     []*CostRecordResponse `json:"history"`
+}
+
+// ReceiveLotRequest - Input DTO for receiving stock in a lot with an expiry date
+type ReceiveLotRequest struct {
+	LotCode   string  `json:"lot_code"`
+	Quantity  float64 `json:"quantity" binding:"required,gt=0"`
+	ExpiresAt string  `json:"expires_at" binding:"required"` // YYYY-MM-DD, last day the lot may be sold
+}
+
+// ExpiringLotsRequest - Query DTO for lots expiring soon
+type ExpiringLotsRequest struct {
+	Days *int `form:"days" binding:"omitempty,gte=0"`
+}
+
+// LotResponse - Output DTO
+type LotResponse struct {
+	ID              string    `json:"id"`
+	ProductID       string    `json:"product_id"`
+	ProductName     string    `json:"product_name"`
+	Code            string    `json:"code,omitempty"`
+	Quantity        float64   `json:"quantity"`
+	ExpiresAt       string    `json:"expires_at"`
+	DaysUntilExpiry int       `json:"days_until_expiry"`
+	IsExpired       bool      `json:"is_expired"`
+	CostValue       float64   `json:"cost_value"`
+	ReceivedAt      time.Time `json:"received_at"`
+}
+
+// ProductLotsResponse - Output DTO for the lots of a product
+type ProductLotsResponse struct {
+	ProductID         string         `json:"product_id"`
+	ProductName       string         `json:"product_name"`
+	Stock             float64        `json:"stock"`
+	UntrackedQuantity float64        `json:"untracked_quantity"` // stock held outside any lot
+	Lots              []*LotResponse `json:"lots"`
+}
+
+// ExpiringLotsResponse - Output DTO for lots expiring within a number of days
+type ExpiringLotsResponse struct {
+	Days           int            `json:"days"`
+	Lots           []*LotResponse `json:"lots"`
+	Total          int            `json:"total"`
+	TotalQuantity  float64        `json:"total_quantity"`
+	TotalCostValue float64        `json:"total_cost_value"`
 }
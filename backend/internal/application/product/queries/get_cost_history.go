@@ -0,0 +1,44 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/product/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type GetCostHistoryQuery struct {
+	repo product.ProductRepository
+}
+
+func NewGetCostHistoryQuery(repo product.ProductRepository) *GetCostHistoryQuery {
+	return &GetCostHistoryQuery{repo: repo}
+}
+
+func (q *GetCostHistoryQuery) Execute(id string) (*dto.CostHistoryResponse, error) {
+	// Find product, archived ones included
+	prod, err := q.repo.FindByIDIncludingArchived(shared.ProductID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Find recorded cost prices
+	history, err := q.repo.FindCostHistory(prod.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	records := []*dto.CostRecordResponse{}
+	for _, record := range history {
+		records = append(records, &dto.CostRecordResponse{
+			Cost:          record.Cost.Amount,
+			EffectiveFrom: record.EffectiveFrom,
+		})
+	}
+
+	return &dto.CostHistoryResponse{
+		ProductID:   prod.ID().String(),
+		CurrentCost: prod.Cost().Amount,
+		History:     records,
+	}, nil
+}
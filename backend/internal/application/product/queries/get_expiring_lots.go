@@ -0,0 +1,59 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/product/dto"
+	"POSFlowBackend/internal/domain/product"
+	"sort"
+	"time"
+)
+
+type GetExpiringLotsQuery struct {
+	repo        product.ProductRepository
+	defaultDays int
+}
+
+func NewGetExpiringLotsQuery(repo product.ProductRepository, defaultDays int) *GetExpiringLotsQuery {
+	return &GetExpiringLotsQuery{
+		repo:        repo,
+		defaultDays: defaultDays,
+	}
+}
+
+// Execute lists lots whose expiry day falls within the next N days, expired lots included
+func (q *GetExpiringLotsQuery) Execute(req dto.ExpiringLotsRequest) (*dto.ExpiringLotsResponse, error) {
+	days := q.defaultDays
+	if req.Days != nil {
+		days = *req.Days
+	}
+
+	// Lots expiring up to and including the last day of the window
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	before := today.AddDate(0, 0, days+1)
+
+	products, err := q.repo.FindWithLotsExpiringBefore(before)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	response := &dto.ExpiringLotsResponse{
+		Days: days,
+		Lots: []*dto.LotResponse{},
+	}
+	for _, prod := range products {
+		for _, lot := range prod.LotsExpiringBefore(before) {
+			lotResponse := mapLotToDTO(prod, lot, now)
+			response.Lots = append(response.Lots, lotResponse)
+			response.TotalQuantity += lotResponse.Quantity
+			response.TotalCostValue += lotResponse.CostValue
+		}
+	}
+
+	sort.SliceStable(response.Lots, func(i, j int) bool {
+		return response.Lots[i].ExpiresAt < response.Lots[j].ExpiresAt
+	})
+	response.Total = len(response.Lots)
+
+	return response, nil
+}
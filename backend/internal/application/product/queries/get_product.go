@@ -23,15 +23,27 @@ func (q *GetProductQuery) Execute(id string) (*dto.ProductResponse, error) {
 
 	// Map to response DTO
 	return &dto.ProductResponse{
-		ID:          prod.ID().String(),
-		Name:        prod.Name(),
-		Description: prod.Description(),
-		Price:       prod.Price().Amount,
-		Category:    string(prod.Category()),
-		Stock:       prod.Stock(),
-		Active:      prod.IsActive(),
-		IsLowStock:  prod.IsLowStock(),
-		CreatedAt:   prod.CreatedAt(),
-		UpdatedAt:   prod.UpdatedAt(),
+		ID:               prod.ID().String(),
+		Name:             prod.Name(),
+		Description:      prod.Description(),
+		SKU:              prod.SKU(),
+		Price:            prod.Price().Amount,
+		Cost:             prod.Cost().Amount,
+		MarginPercent:    prod.MarginPercent(),
+		Category:         string(prod.Category()),
+		Stock:            prod.Stock(),
+		Unit:             string(prod.Unit()),
+		PurchaseUnit:     string(prod.PurchaseUnit()),
+		UnitsPerPurchase: prod.UnitsPerPurchase(),
+		Weighed:          prod.IsWeighed(),
+		Allergens:        product.AllergenStrings(prod.Allergens()),
+		DietaryLabels:    product.DietaryLabelStrings(prod.DietaryLabels()),
+		Active:           prod.IsActive(),
+		Available:        prod.IsAvailable(),
+		Archived:         prod.IsArchived(),
+		ArchivedAt:       prod.ArchivedAt(),
+		IsLowStock:       prod.IsLowStock(),
+		CreatedAt:        prod.CreatedAt(),
+		UpdatedAt:        prod.UpdatedAt(),
 	}, nil
 }
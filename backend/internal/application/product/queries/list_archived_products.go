@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/product/dto"
+	"POSFlowBackend/internal/domain/product"
+)
+
+type ListArchivedProductsQuery struct {
+	repo product.ProductRepository
+}
+
+func NewListArchivedProductsQuery(repo product.ProductRepository) *ListArchivedProductsQuery {
+	return &ListArchivedProductsQuery{repo: repo}
+}
+
+func (q *ListArchivedProductsQuery) Execute() (*dto.ProductListResponse, error) {
+	// Find archived products
+	products, err := q.repo.FindArchived()
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	var productResponses []*dto.ProductResponse
+	for _, prod := range products {
+		productResponses = append(productResponses, &dto.ProductResponse{
+			ID:               prod.ID().String(),
+			Name:             prod.Name(),
+			Description:      prod.Description(),
+			SKU:              prod.SKU(),
+			Price:            prod.Price().Amount,
+			Cost:             prod.Cost().Amount,
+			MarginPercent:    prod.MarginPercent(),
+			Category:         string(prod.Category()),
+			Stock:            prod.Stock(),
+			Unit:             string(prod.Unit()),
+			PurchaseUnit:     string(prod.PurchaseUnit()),
+			UnitsPerPurchase: prod.UnitsPerPurchase(),
+			Weighed:          prod.IsWeighed(),
+			Allergens:        product.AllergenStrings(prod.Allergens()),
+			DietaryLabels:    product.DietaryLabelStrings(prod.DietaryLabels()),
+			Active:           prod.IsActive(),
+			Available:        prod.IsAvailable(),
+			Archived:         prod.IsArchived(),
+			ArchivedAt:       prod.ArchivedAt(),
+			IsLowStock:       prod.IsLowStock(),
+			CreatedAt:        prod.CreatedAt(),
+			UpdatedAt:        prod.UpdatedAt(),
+		})
+	}
+
+	return &dto.ProductListResponse{
+		Products: productResponses,
+		Total:    len(productResponses),
+	}, nil
+}
@@ -0,0 +1,63 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/product/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"time"
+)
+
+type ListLotsQuery struct {
+	repo product.ProductRepository
+}
+
+func NewListLotsQuery(repo product.ProductRepository) *ListLotsQuery {
+	return &ListLotsQuery{repo: repo}
+}
+
+func (q *ListLotsQuery) Execute(productID string) (*dto.ProductLotsResponse, error) {
+	// Find product
+	prod, err := q.repo.FindByID(shared.ProductID(productID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO, earliest expiry first
+	now := time.Now()
+	lots := []*dto.LotResponse{}
+	lotted := 0.0
+	for _, lot := range prod.Lots() {
+		lots = append(lots, mapLotToDTO(prod, lot, now))
+		lotted = shared.RoundQuantity(lotted + lot.Quantity)
+	}
+
+	return &dto.ProductLotsResponse{
+		ProductID:         prod.ID().String(),
+		ProductName:       prod.Name(),
+		Stock:             prod.Stock(),
+		UntrackedQuantity: shared.RoundQuantity(prod.Stock() - lotted),
+		Lots:              lots,
+	}, nil
+}
+
+func mapLotToDTO(prod *product.Product, lot product.Lot, now time.Time) *dto.LotResponse {
+	return &dto.LotResponse{
+		ID:              lot.ID.String(),
+		ProductID:       prod.ID().String(),
+		ProductName:     prod.Name(),
+		Code:            lot.Code,
+		Quantity:        lot.Quantity,
+		ExpiresAt:       lot.ExpiresAt.Format("2006-01-02"),
+		DaysUntilExpiry: daysUntil(lot.ExpiresAt, now),
+		IsExpired:       lot.IsExpired(now),
+		CostValue:       prod.Cost().Amount * float64(lot.Quantity),
+		ReceivedAt:      lot.ReceivedAt,
+	}
+}
+
+// daysUntil counts calendar days from today to the given date; negative once it has passed
+func daysUntil(date, now time.Time) int {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return int(day.Sub(today).Hours() / 24)
+}
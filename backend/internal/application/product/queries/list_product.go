@@ -3,6 +3,8 @@ package queries
 import (
 	"POSFlowBackend/internal/application/product/dto"
 	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"strings"
 )
 
 type ListProductsQuery struct {
@@ -13,9 +15,38 @@ func NewListProductsQuery(repo product.ProductRepository) *ListProductsQuery {
 	return &ListProductsQuery{repo: repo}
 }
 
-func (q *ListProductsQuery) Execute() (*dto.ProductListResponse, error) {
-	// Find all products
-	products, err := q.repo.FindAll()
+func (q *ListProductsQuery) Execute(req dto.ListProductsRequest) (*dto.ProductListResponse, error) {
+	tags := splitList(req.Tags)
+	allergenFree, err := product.ParseAllergens(splitList(req.AllergenFree))
+	if err != nil {
+		return nil, err
+	}
+
+	category := product.Category(req.Category)
+	if category != "" && !category.IsValid() {
+		return nil, shared.ErrInvalidInput
+	}
+
+	filter := product.ProductFilter{
+		Query:            req.Q,
+		Category:         category,
+		Tags:             tags,
+		ExcludeAllergens: allergenFree,
+	}
+
+	// Paginate only when a page size is requested
+	page := 0
+	if req.PageSize > 0 {
+		page = req.Page
+		if page == 0 {
+			page = 1
+		}
+		filter.Limit = req.PageSize
+		filter.Offset = (page - 1) * req.PageSize
+	}
+
+	// Search products, best matches first
+	products, total, err := q.repo.Search(filter)
 	if err != nil {
 		return nil, err
 	}
@@ -24,21 +55,46 @@ func (q *ListProductsQuery) Execute() (*dto.ProductListResponse, error) {
 	var productResponses []*dto.ProductResponse
 	for _, prod := range products {
 		productResponses = append(productResponses, &dto.ProductResponse{
-			ID:          prod.ID().String(),
-			Name:        prod.Name(),
-			Description: prod.Description(),
-			Price:       prod.Price().Amount,
-			Category:    string(prod.Category()),
-			Stock:       prod.Stock(),
-			Active:      prod.IsActive(),
-			IsLowStock:  prod.IsLowStock(),
-			CreatedAt:   prod.CreatedAt(),
-			UpdatedAt:   prod.UpdatedAt(),
+			ID:               prod.ID().String(),
+			Name:             prod.Name(),
+			Description:      prod.Description(),
+			SKU:              prod.SKU(),
+			Price:            prod.Price().Amount,
+			Cost:             prod.Cost().Amount,
+			MarginPercent:    prod.MarginPercent(),
+			Category:         string(prod.Category()),
+			Stock:            prod.Stock(),
+			Unit:             string(prod.Unit()),
+			PurchaseUnit:     string(prod.PurchaseUnit()),
+			UnitsPerPurchase: prod.UnitsPerPurchase(),
+			Weighed:          prod.IsWeighed(),
+			Allergens:        product.AllergenStrings(prod.Allergens()),
+			DietaryLabels:    product.DietaryLabelStrings(prod.DietaryLabels()),
+			Active:           prod.IsActive(),
+			Available:        prod.IsAvailable(),
+			Archived:         prod.IsArchived(),
+			ArchivedAt:       prod.ArchivedAt(),
+			IsLowStock:       prod.IsLowStock(),
+			CreatedAt:        prod.CreatedAt(),
+			UpdatedAt:        prod.UpdatedAt(),
 		})
 	}
 
 	return &dto.ProductListResponse{
 		Products: productResponses,
-		Total:    len(productResponses),
+		Total:    total,
+		Page:     page,
+		PageSize: req.PageSize,
 	}, nil
 }
+
+// splitList splits a comma-separated query value, ignoring blanks
+func splitList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
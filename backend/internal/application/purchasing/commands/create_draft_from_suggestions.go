@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/purchasing/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/purchasing"
+	"POSFlowBackend/internal/domain/replenishment"
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateDraftFromSuggestionsCommand struct {
+	replenishmentService *replenishment.ReplenishmentService
+	defaultPolicy        replenishment.Policy
+	purchaseOrderRepo    purchasing.PurchaseOrderRepository
+	supplierRepo         purchasing.SupplierRepository
+	productRepo          product.ProductRepository
+}
+
+func NewCreateDraftFromSuggestionsCommand(
+	replenishmentService *replenishment.ReplenishmentService,
+	defaultPolicy replenishment.Policy,
+	purchaseOrderRepo purchasing.PurchaseOrderRepository,
+	supplierRepo purchasing.SupplierRepository,
+	productRepo product.ProductRepository,
+) *CreateDraftFromSuggestionsCommand {
+	return &CreateDraftFromSuggestionsCommand{
+		replenishmentService: replenishmentService,
+		defaultPolicy:        defaultPolicy,
+		purchaseOrderRepo:    purchaseOrderRepo,
+		supplierRepo:         supplierRepo,
+		productRepo:          productRepo,
+	}
+}
+
+// Execute turns the current reorder suggestions into a draft purchase order
+func (c *CreateDraftFromSuggestionsCommand) Execute(req dto.CreateDraftFromSuggestionsRequest) (*dto.PurchaseOrderResponse, error) {
+	// Ensure supplier exists
+	supplier, err := c.supplierRepo.FindByID(purchasing.SupplierID(req.SupplierID))
+	if err != nil {
+		return nil, err
+	}
+
+	expectedDate, err := parseExpectedDate(req.ExpectedDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Override the configured policy with request values
+	windowDays := c.defaultPolicy.WindowDays
+	if req.WindowDays > 0 {
+		windowDays = req.WindowDays
+	}
+	coverDays := c.defaultPolicy.CoverDays
+	if req.CoverDays > 0 {
+		coverDays = req.CoverDays
+	}
+
+	policy, err := replenishment.NewPolicy(windowDays, coverDays)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions, err := c.replenishmentService.Suggest(policy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// Restrict to the selected products if any
+	selected := make(map[string]bool)
+	for _, id := range req.ProductIDs {
+		selected[id] = true
+	}
+
+	var lines []purchasing.PurchaseOrderLineRequest
+	for _, suggestion := range suggestions {
+		if len(selected) > 0 && !selected[suggestion.Product.ID().String()] {
+			continue
+		}
+
+		lines = append(lines, purchasing.PurchaseOrderLineRequest{
+			ProductID: suggestion.Product.ID(),
+			Quantity:  suggestion.SuggestedQuantity,
+			UnitCost:  suggestion.Product.PurchaseUnitCost(),
+		})
+	}
+
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("%w: nothing to reorder", shared.ErrInvalidInput)
+	}
+
+	// Generate ID
+	id := purchasing.PurchaseOrderID(uuid.New().String())
+
+	// Create purchase order entity using domain factory
+	po, err := purchasing.NewPurchaseOrder(id, supplier.ID(), lines, expectedDate, req.Notes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to repository
+	if err := c.purchaseOrderRepo.Save(po); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapPurchaseOrderToDTO(po, c.supplierRepo, c.productRepo)
+}
@@ -0,0 +1,168 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/purchasing/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/purchasing"
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreatePurchaseOrderCommand struct {
+	purchaseOrderRepo purchasing.PurchaseOrderRepository
+	supplierRepo      purchasing.SupplierRepository
+	productRepo       product.ProductRepository
+}
+
+func NewCreatePurchaseOrderCommand(
+	purchaseOrderRepo purchasing.PurchaseOrderRepository,
+	supplierRepo purchasing.SupplierRepository,
+	productRepo product.ProductRepository,
+) *CreatePurchaseOrderCommand {
+	return &CreatePurchaseOrderCommand{
+		purchaseOrderRepo: purchaseOrderRepo,
+		supplierRepo:      supplierRepo,
+		productRepo:       productRepo,
+	}
+}
+
+// Execute creates a draft purchase order
+func (c *CreatePurchaseOrderCommand) Execute(req dto.CreatePurchaseOrderRequest) (*dto.PurchaseOrderResponse, error) {
+	// Ensure supplier exists
+	supplier, err := c.supplierRepo.FindByID(purchasing.SupplierID(req.SupplierID))
+	if err != nil {
+		return nil, err
+	}
+
+	expectedDate, err := parseExpectedDate(req.ExpectedDate)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := buildPurchaseOrderLines(c.productRepo, req.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate ID
+	id := purchasing.PurchaseOrderID(uuid.New().String())
+
+	// Create purchase order entity using domain factory
+	po, err := purchasing.NewPurchaseOrder(id, supplier.ID(), lines, expectedDate, req.Notes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to repository
+	if err := c.purchaseOrderRepo.Save(po); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapPurchaseOrderToDTO(po, c.supplierRepo, c.productRepo)
+}
+
+// buildPurchaseOrderLines checks every product exists and defaults
+// the unit cost to the product's current cost price
+func buildPurchaseOrderLines(
+	productRepo product.ProductRepository,
+	reqLines []dto.PurchaseOrderLineRequest,
+) ([]purchasing.PurchaseOrderLineRequest, error) {
+	var lines []purchasing.PurchaseOrderLineRequest
+
+	for _, line := range reqLines {
+		prod, err := productRepo.FindByID(shared.ProductID(line.ProductID))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := prod.PurchaseUnit().ValidateQuantity(line.Quantity); err != nil {
+			return nil, err
+		}
+
+		// Lines are ordered and costed in the product's purchase unit
+		unitCost := prod.PurchaseUnitCost()
+		if line.UnitCost != nil {
+			cost, err := shared.NewMoney(*line.UnitCost)
+			if err != nil {
+				return nil, err
+			}
+			unitCost = *cost
+		}
+
+		lines = append(lines, purchasing.PurchaseOrderLineRequest{
+			ProductID: prod.ID(),
+			Quantity:  line.Quantity,
+			UnitCost:  unitCost,
+		})
+	}
+
+	return lines, nil
+}
+
+func parseExpectedDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: expected_date must be YYYY-MM-DD", shared.ErrInvalidInput)
+	}
+	return &date, nil
+}
+
+func mapPurchaseOrderToDTO(
+	po *purchasing.PurchaseOrder,
+	supplierRepo purchasing.SupplierRepository,
+	productRepo product.ProductRepository,
+) (*dto.PurchaseOrderResponse, error) {
+	supplier, err := supplierRepo.FindByID(po.SupplierID())
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []dto.PurchaseOrderLineResponse
+	for _, line := range po.Lines() {
+		prod, err := productRepo.FindByIDIncludingArchived(line.ProductID())
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, dto.PurchaseOrderLineResponse{
+			ProductID:           line.ProductID().String(),
+			ProductName:         prod.Name(),
+			QuantityOrdered:     line.QuantityOrdered(),
+			QuantityReceived:    line.QuantityReceived(),
+			QuantityOutstanding: line.QuantityOutstanding(),
+			Unit:                string(prod.PurchaseUnit()),
+			UnitCost:            line.UnitCost().Amount,
+			Total:               line.Total().Amount,
+		})
+	}
+
+	var expectedDate string
+	if po.ExpectedDate() != nil {
+		expectedDate = po.ExpectedDate().Format("2006-01-02")
+	}
+
+	return &dto.PurchaseOrderResponse{
+		ID:               po.ID().String(),
+		SupplierID:       supplier.ID().String(),
+		SupplierName:     supplier.Name(),
+		Status:           string(po.Status()),
+		ExpectedDate:     expectedDate,
+		IsOverdue:        po.IsOverdue(time.Now()),
+		Notes:            po.Notes(),
+		Lines:            lines,
+		Total:            po.Total().Amount,
+		OutstandingValue: po.OutstandingValue().Amount,
+		SentAt:           po.SentAt(),
+		ReceivedAt:       po.ReceivedAt(),
+		CreatedAt:        po.CreatedAt(),
+		UpdatedAt:        po.UpdatedAt(),
+	}, nil
+}
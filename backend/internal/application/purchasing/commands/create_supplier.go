@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/purchasing/dto"
+	"POSFlowBackend/internal/domain/purchasing"
+
+	"github.com/google/uuid"
+)
+
+type CreateSupplierCommand struct {
+	repo purchasing.SupplierRepository
+}
+
+func NewCreateSupplierCommand(repo purchasing.SupplierRepository) *CreateSupplierCommand {
+	return &CreateSupplierCommand{repo: repo}
+}
+
+func (c *CreateSupplierCommand) Execute(req dto.CreateSupplierRequest) (*dto.SupplierResponse, error) {
+	// Generate ID
+	id := purchasing.SupplierID(uuid.New().String())
+
+	// Create supplier entity using domain factory
+	supplier, err := purchasing.NewSupplier(
+		id,
+		req.Name,
+		req.ContactName,
+		req.Email,
+		req.Phone,
+		req.Notes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to repository
+	if err := c.repo.Save(supplier); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return &dto.SupplierResponse{
+		ID:          supplier.ID().String(),
+		Name:        supplier.Name(),
+		ContactName: supplier.ContactName(),
+		Email:       supplier.Email(),
+		Phone:       supplier.Phone(),
+		Notes:       supplier.Notes(),
+		CreatedAt:   supplier.CreatedAt(),
+		UpdatedAt:   supplier.UpdatedAt(),
+	}, nil
+}
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/purchasing/dto"
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/purchasing"
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReceivePurchaseOrderCommand struct {
+	receivingService *purchasing.ReceivingService
+	supplierRepo     purchasing.SupplierRepository
+	productRepo      product.ProductRepository
+}
+
+func NewReceivePurchaseOrderCommand(
+	receivingService *purchasing.ReceivingService,
+	supplierRepo purchasing.SupplierRepository,
+	productRepo product.ProductRepository,
+) *ReceivePurchaseOrderCommand {
+	return &ReceivePurchaseOrderCommand{
+		receivingService: receivingService,
+		supplierRepo:     supplierRepo,
+		productRepo:      productRepo,
+	}
+}
+
+// Execute books a delivery into stock and updates product cost prices
+func (c *ReceivePurchaseOrderCommand) Execute(id string, req dto.ReceivePurchaseOrderRequest) (*dto.PurchaseOrderResponse, error) {
+	// Convert request lines to receipts
+	var receipts []purchasing.Receipt
+	for _, line := range req.Lines {
+		receipt := purchasing.Receipt{
+			ProductID: shared.ProductID(line.ProductID),
+			Quantity:  line.Quantity,
+		}
+
+		if line.UnitCost != nil {
+			cost, err := shared.NewMoney(*line.UnitCost)
+			if err != nil {
+				return nil, err
+			}
+			receipt.UnitCost = cost
+		}
+
+		if line.ExpiresAt != "" {
+			expiresAt, err := time.Parse("2006-01-02", line.ExpiresAt)
+			if err != nil {
+				return nil, fmt.Errorf("%w: expires_at must be YYYY-MM-DD", shared.ErrInvalidInput)
+			}
+			receipt.Lot = &purchasing.LotReceipt{
+				ID:        product.LotID(uuid.New().String()),
+				Code:      line.LotCode,
+				ExpiresAt: expiresAt,
+			}
+		}
+
+		receipts = append(receipts, receipt)
+	}
+
+	// Use domain service to receive goods
+	po, err := c.receivingService.Receive(purchasing.PurchaseOrderID(id), location.LocationID(req.LocationID), receipts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapPurchaseOrderToDTO(po, c.supplierRepo, c.productRepo)
+}
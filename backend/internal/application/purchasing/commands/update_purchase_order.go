@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/purchasing/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/purchasing"
+)
+
+type UpdatePurchaseOrderCommand struct {
+	purchaseOrderRepo purchasing.PurchaseOrderRepository
+	supplierRepo      purchasing.SupplierRepository
+	productRepo       product.ProductRepository
+}
+
+func NewUpdatePurchaseOrderCommand(
+	purchaseOrderRepo purchasing.PurchaseOrderRepository,
+	supplierRepo purchasing.SupplierRepository,
+	productRepo product.ProductRepository,
+) *UpdatePurchaseOrderCommand {
+	return &UpdatePurchaseOrderCommand{
+		purchaseOrderRepo: purchaseOrderRepo,
+		supplierRepo:      supplierRepo,
+		productRepo:       productRepo,
+	}
+}
+
+// Execute replaces the lines, expected date and notes of a draft purchase order
+func (c *UpdatePurchaseOrderCommand) Execute(id string, req dto.UpdatePurchaseOrderRequest) (*dto.PurchaseOrderResponse, error) {
+	// Find purchase order
+	po, err := c.purchaseOrderRepo.FindByID(purchasing.PurchaseOrderID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	expectedDate, err := parseExpectedDate(req.ExpectedDate)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := buildPurchaseOrderLines(c.productRepo, req.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only drafts can be edited
+	if err := po.UpdateDraft(lines, expectedDate, req.Notes); err != nil {
+		return nil, err
+	}
+
+	// Save changes
+	if err := c.purchaseOrderRepo.Save(po); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapPurchaseOrderToDTO(po, c.supplierRepo, c.productRepo)
+}
@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/purchasing/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/purchasing"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type UpdatePurchaseOrderStatusCommand struct {
+	purchaseOrderRepo purchasing.PurchaseOrderRepository
+	supplierRepo      purchasing.SupplierRepository
+	productRepo       product.ProductRepository
+}
+
+func NewUpdatePurchaseOrderStatusCommand(
+	purchaseOrderRepo purchasing.PurchaseOrderRepository,
+	supplierRepo purchasing.SupplierRepository,
+	productRepo product.ProductRepository,
+) *UpdatePurchaseOrderStatusCommand {
+	return &UpdatePurchaseOrderStatusCommand{
+		purchaseOrderRepo: purchaseOrderRepo,
+		supplierRepo:      supplierRepo,
+		productRepo:       productRepo,
+	}
+}
+
+// Execute sends or cancels a purchase order. Received statuses are
+// only reached through the receiving flow.
+func (c *UpdatePurchaseOrderStatusCommand) Execute(id string, req dto.UpdatePurchaseOrderStatusRequest) (*dto.PurchaseOrderResponse, error) {
+	// Find purchase order
+	po, err := c.purchaseOrderRepo.FindByID(purchasing.PurchaseOrderID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Update status using domain method
+	switch purchasing.PurchaseOrderStatus(req.Status) {
+	case purchasing.StatusSent:
+		err = po.MarkSent()
+	case purchasing.StatusCancelled:
+		err = po.Cancel()
+	default:
+		err = shared.ErrInvalidInput
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Save changes
+	if err := c.purchaseOrderRepo.Save(po); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapPurchaseOrderToDTO(po, c.supplierRepo, c.productRepo)
+}
@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/purchasing/dto"
+	"POSFlowBackend/internal/domain/purchasing"
+)
+
+type UpdateSupplierCommand struct {
+	repo purchasing.SupplierRepository
+}
+
+func NewUpdateSupplierCommand(repo purchasing.SupplierRepository) *UpdateSupplierCommand {
+	return &UpdateSupplierCommand{repo: repo}
+}
+
+func (c *UpdateSupplierCommand) Execute(id string, req dto.UpdateSupplierRequest) (*dto.SupplierResponse, error) {
+	// Find supplier
+	supplier, err := c.repo.FindByID(purchasing.SupplierID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep current values for fields not provided
+	name := valueOr(req.Name, supplier.Name())
+	contactName := valueOr(req.ContactName, supplier.ContactName())
+	email := valueOr(req.Email, supplier.Email())
+	phone := valueOr(req.Phone, supplier.Phone())
+	notes := valueOr(req.Notes, supplier.Notes())
+
+	if err := supplier.UpdateInfo(name, contactName, email, phone, notes); err != nil {
+		return nil, err
+	}
+
+	// Save changes
+	if err := c.repo.Save(supplier); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return &dto.SupplierResponse{
+		ID:          supplier.ID().String(),
+		Name:        supplier.Name(),
+		ContactName: supplier.ContactName(),
+		Email:       supplier.Email(),
+		Phone:       supplier.Phone(),
+		Notes:       supplier.Notes(),
+		CreatedAt:   supplier.CreatedAt(),
+		UpdatedAt:   supplier.UpdatedAt(),
+	}, nil
+}
+
+func valueOr(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
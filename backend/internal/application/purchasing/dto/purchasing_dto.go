@@ -0,0 +1,149 @@
+package dto
+
+import "time"
+
+// CreateSupplierRequest - Input DTO for creating a supplier
+type CreateSupplierRequest struct {
+	Name        string `json:"name" binding:"required"`
+	ContactName string `json:"contact_name"`
+	Email       string `json:"email" binding:"omitempty,email"`
+	Phone       string `json:"phone"`
+	Notes       string `json:"notes"`
+}
+
+// UpdateSupplierRequest - Input DTO for updating a supplier; empty fields are left unchanged
+type UpdateSupplierRequest struct {
+	Name        string `json:"name"`
+	ContactName string `json:"contact_name"`
+	Email       string `json:"email" binding:"omitempty,email"`
+	Phone       string `json:"phone"`
+	Notes       string `json:"notes"`
+}
+
+// SupplierResponse - Output DTO
+type SupplierResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	ContactName string    `json:"contact_name"`
+	Email       string    `json:"email"`
+	Phone       string    `json:"phone"`
+	Notes       string    `json:"notes"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// SupplierListResponse - Output DTO for list
+type SupplierListResponse struct {
+	Suppliers []*SupplierResponse `json:"suppliers"`
+	Total     int                 `json:"total"`
+}
+
+// CreatePurchaseOrderRequest - Input DTO for creating a draft purchase order
+type CreatePurchaseOrderRequest struct {
+	SupplierID   string                     `json:"supplier_id" binding:"required"`
+	ExpectedDate string                     `json:"expected_date" binding:"omitempty,datetime=2006-01-02"`
+	Notes        string                     `json:"notes"`
+	Lines        []PurchaseOrderLineRequest `json:"lines" binding:"required,min=1,dive"`
+}
+
+// UpdatePurchaseOrderRequest - Input DTO for replacing the contents of a draft purchase order
+type UpdatePurchaseOrderRequest struct {
+	ExpectedDate string                     `json:"expected_date" binding:"omitempty,datetime=2006-01-02"`
+	Notes        string                     `json:"notes"`
+	Lines        []PurchaseOrderLineRequest `json:"lines" binding:"required,min=1,dive"`
+}
+
+// PurchaseOrderLineRequest - ordered product; the unit cost defaults to the product's current cost price
+type PurchaseOrderLineRequest struct {
+	ProductID string   `json:"product_id" binding:"required"`
+	Quantity  float64  `json:"quantity" binding:"required,gt=0"`
+	UnitCost  *float64 `json:"unit_cost" binding:"omitempty,gte=0"`
+}
+
+// UpdatePurchaseOrderStatusRequest - Input DTO for sending or cancelling a purchase order
+type UpdatePurchaseOrderStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=sent cancelled"`
+}
+
+// ReceivePurchaseOrderRequest - Input DTO for booking a delivery against a purchase order
+type ReceivePurchaseOrderRequest struct {
+	LocationID string               `json:"location_id"` // where the delivery is stocked, defaults to the default location
+	Lines      []ReceiptLineRequest `json:"lines" binding:"required,min=1,dive"`
+}
+
+// ReceiptLineRequest - delivered quantity; unit_cost is the invoiced cost when it differs from the order.
+// Perishables are received into a lot by giving its expiry date (YYYY-MM-DD).
+type ReceiptLineRequest struct {
+	ProductID string   `json:"product_id" binding:"required"`
+	Quantity  float64  `json:"quantity" binding:"required,gt=0"`
+	UnitCost  *float64 `json:"unit_cost" binding:"omitempty,gte=0"`
+	LotCode   string   `json:"lot_code"`
+	ExpiresAt string   `json:"expires_at"`
+}
+
+// ListPurchaseOrdersRequest - Query DTO for filtering purchase orders
+type ListPurchaseOrdersRequest struct {
+	SupplierID string `form:"supplier_id"`
+	Status     string `form:"status" binding:"omitempty,oneof=draft sent partially_received received cancelled"`
+}
+
+// PurchaseOrderResponse - Output DTO
+type PurchaseOrderResponse struct {
+	ID               string                      `json:"id"`
+	SupplierID       string                      `json:"supplier_id"`
+	SupplierName     string                      `json:"supplier_name"`
+	Status           string                      `json:"status"`
+	ExpectedDate     string                      `json:"expected_date,omitempty"`
+	IsOverdue        bool                        `json:"is_overdue"`
+	Notes            string                      `json:"notes"`
+	Lines            []PurchaseOrderLineResponse `json:"lines"`
+	Total            float64                     `json:"total"`
+	OutstandingValue float64                     `json:"outstanding_value"`
+	SentAt           *time.Time                  `json:"sent_at,omitempty"`
+	ReceivedAt       *time.Time                  `json:"received_at,omitempty"`
+	CreatedAt        time.Time                   `json:"created_at"`
+	UpdatedAt        time.Time                   `json:"updated_at"`
+}
+
+type PurchaseOrderLineResponse struct {
+	ProductID           string  `json:"product_id"`
+	ProductName         string  `json:"product_name"`
+	QuantityOrdered     float64 `json:"quantity_ordered"`
+	QuantityReceived    float64 `json:"quantity_received"`
+	QuantityOutstanding float64 `json:"quantity_outstanding"`
+	Unit                string  `json:"unit"` // purchase unit of quantities and unit cost
+	UnitCost            float64 `json:"unit_cost"`
+	Total               float64 `json:"total"`
+}
+
+// PurchaseOrderListResponse - Output DTO for list
+type PurchaseOrderListResponse struct {
+	PurchaseOrders []*PurchaseOrderResponse `json:"purchase_orders"`
+	Total          int                      `json:"total"`
+}
+
+// OpenPurchaseOrdersReportResponse - open purchase orders grouped by supplier
+type OpenPurchaseOrdersReportResponse struct {
+	Suppliers        []SupplierBacklogResponse `json:"suppliers"`
+	TotalOpenOrders  int                       `json:"total_open_orders"`
+	TotalOutstanding float64                   `json:"total_outstanding"`
+}
+
+type SupplierBacklogResponse struct {
+	SupplierID       string                   `json:"supplier_id"`
+	SupplierName     string                   `json:"supplier_name"`
+	OpenOrders       int                      `json:"open_orders"`
+	OverdueOrders    int                      `json:"overdue_orders"`
+	OutstandingValue float64                  `json:"outstanding_value"`
+	PurchaseOrders   []*PurchaseOrderResponse `json:"purchase_orders"`
+}
+
+// CreateDraftFromSuggestionsRequest - Input DTO for exporting reorder suggestions as a draft purchase order
+type CreateDraftFromSuggestionsRequest struct {
+	SupplierID   string   `json:"supplier_id" binding:"required"`
+	ProductIDs   []string `json:"product_ids"` // optional subset of the suggested products
+	WindowDays   int      `json:"window_days" binding:"omitempty,gte=1,lte=365"`
+	CoverDays    int      `json:"cover_days" binding:"omitempty,gte=1,lte=365"`
+	ExpectedDate string   `json:"expected_date" binding:"omitempty,datetime=2006-01-02"`
+	Notes        string   `json:"notes"`
+}
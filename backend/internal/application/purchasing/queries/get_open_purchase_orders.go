@@ -0,0 +1,65 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/purchasing/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/purchasing"
+	"time"
+)
+
+type GetOpenPurchaseOrdersQuery struct {
+	receivingService *purchasing.ReceivingService
+	supplierRepo     purchasing.SupplierRepository
+	productRepo      product.ProductRepository
+}
+
+func NewGetOpenPurchaseOrdersQuery(
+	receivingService *purchasing.ReceivingService,
+	supplierRepo purchasing.SupplierRepository,
+	productRepo product.ProductRepository,
+) *GetOpenPurchaseOrdersQuery {
+	return &GetOpenPurchaseOrdersQuery{
+		receivingService: receivingService,
+		supplierRepo:     supplierRepo,
+		productRepo:      productRepo,
+	}
+}
+
+// Execute reports open purchase orders grouped per supplier
+func (q *GetOpenPurchaseOrdersQuery) Execute() (*dto.OpenPurchaseOrdersReportResponse, error) {
+	// Use domain service to group open purchase orders
+	backlogs, err := q.receivingService.GetOpenBySupplier()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	report := &dto.OpenPurchaseOrdersReportResponse{
+		Suppliers: []dto.SupplierBacklogResponse{},
+	}
+
+	for _, backlog := range backlogs {
+		var orders []*dto.PurchaseOrderResponse
+		for _, po := range backlog.PurchaseOrders {
+			response, err := mapPurchaseOrderToDTO(po, q.supplierRepo, q.productRepo)
+			if err != nil {
+				return nil, err
+			}
+			orders = append(orders, response)
+		}
+
+		outstanding := backlog.OutstandingValue().Amount
+		report.Suppliers = append(report.Suppliers, dto.SupplierBacklogResponse{
+			SupplierID:       backlog.Supplier.ID().String(),
+			SupplierName:     backlog.Supplier.Name(),
+			OpenOrders:       len(orders),
+			OverdueOrders:    backlog.OverdueCount(now),
+			OutstandingValue: outstanding,
+			PurchaseOrders:   orders,
+		})
+		report.TotalOpenOrders += len(orders)
+		report.TotalOutstanding += outstanding
+	}
+
+	return report, nil
+}
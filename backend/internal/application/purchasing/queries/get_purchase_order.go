@@ -0,0 +1,89 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/purchasing/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/purchasing"
+	"time"
+)
+
+type GetPurchaseOrderQuery struct {
+	purchaseOrderRepo purchasing.PurchaseOrderRepository
+	supplierRepo      purchasing.SupplierRepository
+	productRepo       product.ProductRepository
+}
+
+func NewGetPurchaseOrderQuery(
+	purchaseOrderRepo purchasing.PurchaseOrderRepository,
+	supplierRepo purchasing.SupplierRepository,
+	productRepo product.ProductRepository,
+) *GetPurchaseOrderQuery {
+	return &GetPurchaseOrderQuery{
+		purchaseOrderRepo: purchaseOrderRepo,
+		supplierRepo:      supplierRepo,
+		productRepo:       productRepo,
+	}
+}
+
+func (q *GetPurchaseOrderQuery) Execute(id string) (*dto.PurchaseOrderResponse, error) {
+	// Find purchase order
+	po, err := q.purchaseOrderRepo.FindByID(purchasing.PurchaseOrderID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapPurchaseOrderToDTO(po, q.supplierRepo, q.productRepo)
+}
+
+func mapPurchaseOrderToDTO(
+	po *purchasing.PurchaseOrder,
+	supplierRepo purchasing.SupplierRepository,
+	productRepo product.ProductRepository,
+) (*dto.PurchaseOrderResponse, error) {
+	supplier, err := supplierRepo.FindByID(po.SupplierID())
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []dto.PurchaseOrderLineResponse
+	for _, line := range po.Lines() {
+		prod, err := productRepo.FindByIDIncludingArchived(line.ProductID())
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, dto.PurchaseOrderLineResponse{
+			ProductID:           line.ProductID().String(),
+			ProductName:         prod.Name(),
+			QuantityOrdered:     line.QuantityOrdered(),
+			QuantityReceived:    line.QuantityReceived(),
+			QuantityOutstanding: line.QuantityOutstanding(),
+			Unit:                string(prod.PurchaseUnit()),
+			UnitCost:            line.UnitCost().Amount,
+			Total:               line.Total().Amount,
+		})
+	}
+
+	var expectedDate string
+	if po.ExpectedDate() != nil {
+		expectedDate = po.ExpectedDate().Format("2006-01-02")
+	}
+
+	return &dto.PurchaseOrderResponse{
+		ID:               po.ID().String(),
+		SupplierID:       supplier.ID().String(),
+		SupplierName:     supplier.Name(),
+		Status:           string(po.Status()),
+		ExpectedDate:     expectedDate,
+		IsOverdue:        po.IsOverdue(time.Now()),
+		Notes:            po.Notes(),
+		Lines:            lines,
+		Total:            po.Total().Amount,
+		OutstandingValue: po.OutstandingValue().Amount,
+		SentAt:           po.SentAt(),
+		ReceivedAt:       po.ReceivedAt(),
+		CreatedAt:        po.CreatedAt(),
+		UpdatedAt:        po.UpdatedAt(),
+	}, nil
+}
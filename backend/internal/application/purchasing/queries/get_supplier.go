@@ -0,0 +1,34 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/purchasing/dto"
+	"POSFlowBackend/internal/domain/purchasing"
+)
+
+type GetSupplierQuery struct {
+	repo purchasing.SupplierRepository
+}
+
+func NewGetSupplierQuery(repo purchasing.SupplierRepository) *GetSupplierQuery {
+	return &GetSupplierQuery{repo: repo}
+}
+
+func (q *GetSupplierQuery) Execute(id string) (*dto.SupplierResponse, error) {
+	// Find supplier
+	supplier, err := q.repo.FindByID(purchasing.SupplierID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return &dto.SupplierResponse{
+		ID:          supplier.ID().String(),
+		Name:        supplier.Name(),
+		ContactName: supplier.ContactName(),
+		Email:       supplier.Email(),
+		Phone:       supplier.Phone(),
+		Notes:       supplier.Notes(),
+		CreatedAt:   supplier.CreatedAt(),
+		UpdatedAt:   supplier.UpdatedAt(),
+	}, nil
+}
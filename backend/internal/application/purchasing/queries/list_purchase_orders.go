@@ -0,0 +1,62 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/purchasing/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/purchasing"
+)
+
+type ListPurchaseOrdersQuery struct {
+	purchaseOrderRepo purchasing.PurchaseOrderRepository
+	supplierRepo      purchasing.SupplierRepository
+	productRepo       product.ProductRepository
+}
+
+func NewListPurchaseOrdersQuery(
+	purchaseOrderRepo purchasing.PurchaseOrderRepository,
+	supplierRepo purchasing.SupplierRepository,
+	productRepo product.ProductRepository,
+) *ListPurchaseOrdersQuery {
+	return &ListPurchaseOrdersQuery{
+		purchaseOrderRepo: purchaseOrderRepo,
+		supplierRepo:      supplierRepo,
+		productRepo:       productRepo,
+	}
+}
+
+func (q *ListPurchaseOrdersQuery) Execute(req dto.ListPurchaseOrdersRequest) (*dto.PurchaseOrderListResponse, error) {
+	var orders []*purchasing.PurchaseOrder
+	var err error
+
+	// Filter by supplier or status if provided
+	switch {
+	case req.SupplierID != "":
+		orders, err = q.purchaseOrderRepo.FindBySupplier(purchasing.SupplierID(req.SupplierID))
+	case req.Status != "":
+		orders, err = q.purchaseOrderRepo.FindByStatus(purchasing.PurchaseOrderStatus(req.Status))
+	default:
+		orders, err = q.purchaseOrderRepo.FindAll()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	var responses []*dto.PurchaseOrderResponse
+	for _, po := range orders {
+		if req.Status != "" && string(po.Status()) != req.Status {
+			continue
+		}
+
+		response, err := mapPurchaseOrderToDTO(po, q.supplierRepo, q.productRepo)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, response)
+	}
+
+	return &dto.PurchaseOrderListResponse{
+		PurchaseOrders: responses,
+		Total:          len(responses),
+	}, nil
+}
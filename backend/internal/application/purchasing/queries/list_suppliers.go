@@ -0,0 +1,42 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/purchasing/dto"
+	"POSFlowBackend/internal/domain/purchasing"
+)
+
+type ListSuppliersQuery struct {
+	repo purchasing.SupplierRepository
+}
+
+func NewListSuppliersQuery(repo purchasing.SupplierRepository) *ListSuppliersQuery {
+	return &ListSuppliersQuery{repo: repo}
+}
+
+func (q *ListSuppliersQuery) Execute() (*dto.SupplierListResponse, error) {
+	// Find all suppliers
+	suppliers, err := q.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	var supplierResponses []*dto.SupplierResponse
+	for _, supplier := range suppliers {
+		supplierResponses = append(supplierResponses, &dto.SupplierResponse{
+			ID:          supplier.ID().String(),
+			Name:        supplier.Name(),
+			ContactName: supplier.ContactName(),
+			Email:       supplier.Email(),
+			Phone:       supplier.Phone(),
+			Notes:       supplier.Notes(),
+			CreatedAt:   supplier.CreatedAt(),
+			UpdatedAt:   supplier.UpdatedAt(),
+		})
+	}
+
+	return &dto.SupplierListResponse{
+		Suppliers: supplierResponses,
+		Total:     len(supplierResponses),
+	}, nil
+}
@@ -0,0 +1,48 @@
+package dto
+
+// ReorderSuggestionsRequest - Query DTO; zero values fall back to the configured policy
+type ReorderSuggestionsRequest struct {
+	WindowDays int `form:"window_days" binding:"omitempty,gte=1,lte=365"` // days of sales history
+	CoverDays  int `form:"cover_days" binding:"omitempty,gte=1,lte=365"`  // days of stock to order up to
+}
+
+// ReorderSuggestionResponse - Output DTO
+type ReorderSuggestionResponse struct {
+	ProductID         string   `json:"product_id"`
+	ProductName       string   `json:"product_name"`
+	Category          string   `json:"category"`
+	Stock             float64  `json:"stock"`
+	Unit              string   `json:"unit"`
+	IsLowStock        bool     `json:"is_low_stock"`
+	SoldQuantity      float64  `json:"sold_quantity"`
+	AverageDailySales float64  `json:"average_daily_sales"`
+	DaysOfCover       *float64 `json:"days_of_cover"`
+	OnOrder           float64  `json:"on_order"`           // in purchase units
+	SuggestedQuantity float64  `json:"suggested_quantity"` // in purchase units
+	PurchaseUnit      string   `json:"purchase_unit"`
+	UnitCost          float64  `json:"unit_cost"` // per purchase unit
+	EstimatedCost     float64  `json:"estimated_cost"`
+}
+
+// IngredientSuggestionResponse - Output DTO
+type IngredientSuggestionResponse struct {
+	IngredientID      string   `json:"ingredient_id"`
+	IngredientName    string   `json:"ingredient_name"`
+	Stock             float64  `json:"stock"`
+	Unit              string   `json:"unit"`
+	IsLowStock        bool     `json:"is_low_stock"`
+	UsedQuantity      float64  `json:"used_quantity"`
+	AverageDailyUsage float64  `json:"average_daily_usage"`
+	DaysOfCover       *float64 `json:"days_of_cover"`
+	SuggestedQuantity float64  `json:"suggested_quantity"`
+}
+
+// ReorderSuggestionListResponse - Output DTO for list
+type ReorderSuggestionListResponse struct {
+	WindowDays         int                             `json:"window_days"`
+	CoverDays          int                             `json:"cover_days"`
+	Suggestions        []*ReorderSuggestionResponse    `json:"suggestions"`
+	Ingredients        []*IngredientSuggestionResponse `json:"ingredients"` // for recipe products
+	Total              int                             `json:"total"`
+	TotalEstimatedCost float64                         `json:"total_estimated_cost"`
+}
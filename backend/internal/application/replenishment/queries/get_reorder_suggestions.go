@@ -0,0 +1,110 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/replenishment/dto"
+	"POSFlowBackend/internal/domain/replenishment"
+	"time"
+)
+
+type GetReorderSuggestionsQuery struct {
+	replenishmentService *replenishment.ReplenishmentService
+	defaultPolicy        replenishment.Policy
+}
+
+func NewGetReorderSuggestionsQuery(
+	replenishmentService *replenishment.ReplenishmentService,
+	defaultPolicy replenishment.Policy,
+) *GetReorderSuggestionsQuery {
+	return &GetReorderSuggestionsQuery{
+		replenishmentService: replenishmentService,
+		defaultPolicy:        defaultPolicy,
+	}
+}
+
+func (q *GetReorderSuggestionsQuery) Execute(req dto.ReorderSuggestionsRequest) (*dto.ReorderSuggestionListResponse, error) {
+	// Override the configured policy with request values
+	windowDays := q.defaultPolicy.WindowDays
+	if req.WindowDays > 0 {
+		windowDays = req.WindowDays
+	}
+	coverDays := q.defaultPolicy.CoverDays
+	if req.CoverDays > 0 {
+		coverDays = req.CoverDays
+	}
+
+	policy, err := replenishment.NewPolicy(windowDays, coverDays)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use domain service to compute suggestions
+	suggestions, err := q.replenishmentService.Suggest(policy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	var totalCost float64
+	responses := []*dto.ReorderSuggestionResponse{}
+	for _, suggestion := range suggestions {
+		var daysOfCover *float64
+		if days, ok := suggestion.DaysOfCover(); ok {
+			daysOfCover = &days
+		}
+
+		prod := suggestion.Product
+		totalCost += suggestion.EstimatedCost().Amount
+		responses = append(responses, &dto.ReorderSuggestionResponse{
+			ProductID:         prod.ID().String(),
+			ProductName:       prod.Name(),
+			Category:          string(prod.Category()),
+			Stock:             prod.Stock(),
+			Unit:              string(prod.Unit()),
+			IsLowStock:        prod.IsLowStock(),
+			SoldQuantity:      suggestion.SoldQuantity,
+			AverageDailySales: suggestion.AverageDailySales,
+			DaysOfCover:       daysOfCover,
+			OnOrder:           suggestion.OnOrder,
+			SuggestedQuantity: suggestion.SuggestedQuantity,
+			PurchaseUnit:      string(prod.PurchaseUnit()),
+			UnitCost:          prod.PurchaseUnitCost().Amount,
+			EstimatedCost:     suggestion.EstimatedCost().Amount,
+		})
+	}
+
+	// Recipe products are restocked through their ingredients
+	ingredientSuggestions, err := q.replenishmentService.SuggestIngredients(policy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	ingredients := []*dto.IngredientSuggestionResponse{}
+	for _, suggestion := range ingredientSuggestions {
+		var daysOfCover *float64
+		if days, ok := suggestion.DaysOfCover(); ok {
+			daysOfCover = &days
+		}
+
+		ing := suggestion.Ingredient
+		ingredients = append(ingredients, &dto.IngredientSuggestionResponse{
+			IngredientID:      ing.ID().String(),
+			IngredientName:    ing.Name(),
+			Stock:             ing.Stock(),
+			Unit:              string(ing.Unit()),
+			IsLowStock:        ing.IsLowStock(),
+			UsedQuantity:      suggestion.UsedQuantity,
+			AverageDailyUsage: suggestion.AverageDailyUsage,
+			DaysOfCover:       daysOfCover,
+			SuggestedQuantity: suggestion.SuggestedQuantity,
+		})
+	}
+
+	return &dto.ReorderSuggestionListResponse{
+		WindowDays:         policy.WindowDays,
+		CoverDays:          policy.CoverDays,
+		Suggestions:        responses,
+		Ingredients:        ingredients,
+		Total:              len(responses),
+		TotalEstimatedCost: totalCost,
+	}, nil
+}
@@ -2,17 +2,20 @@ package commands
 
 import (
 	"POSFlowBackend/internal/application/sales/dto"
+	"POSFlowBackend/internal/domain/order"
 	"POSFlowBackend/internal/domain/sales"
 	"time"
 )
 
 type CloseDayCommand struct {
-	salesService *sales.SalesService
+	salesService  *sales.SalesService
+	ticketService *order.TicketService
 }
 
-func NewCloseDayCommand(salesService *sales.SalesService) *CloseDayCommand {
+func NewCloseDayCommand(salesService *sales.SalesService, ticketService *order.TicketService) *CloseDayCommand {
 	return &CloseDayCommand{
-		salesService: salesService,
+		salesService:  salesService,
+		ticketService: ticketService,
 	}
 }
 
@@ -34,22 +37,39 @@ func (c *CloseDayCommand) Execute(date *time.Time) (*dto.CloseDayResponse, error
 		}, err
 	}
 
+	// End the business day so ticket numbers restart
+	businessDay, err := c.ticketService.CloseDay(targetDate)
+	if err != nil {
+		return &dto.CloseDayResponse{
+			Success: false,
+			Message: "Failed to reset ticket numbers: " + err.Error(),
+		}, err
+	}
+
 	// Map to DTO
 	salesResponse := &dto.DailySalesResponse{
-		ID:          dailySales.ID().String(),
-		Date:        dailySales.Date().Format("2006-01-02"),
-		TotalSales:  dailySales.TotalSales().Amount,
-		TotalOrders: dailySales.TotalOrders(),
-		AverageSale: dailySales.AverageSale(),
-		IsClosed:    dailySales.IsClosed(),
-		ClosedAt:    dailySales.ClosedAt(),
-		CreatedAt:   dailySales.CreatedAt(),
-		UpdatedAt:   dailySales.UpdatedAt(),
+		ID:              dailySales.ID().String(),
+		Date:            dailySales.Date().Format("2006-01-02"),
+		TotalSales:      dailySales.TotalSales().Amount,
+		TotalOrders:     dailySales.TotalOrders(),
+		AverageSale:     dailySales.AverageSale(),
+		CostOfGoodsSold: dailySales.TotalCost().Amount,
+		GrossProfit:     dailySales.GrossProfit().Amount,
+		MarginPercent:   dailySales.MarginPercent(),
+		IsClosed:        dailySales.IsClosed(),
+		ClosedAt:        dailySales.ClosedAt(),
+		CreatedAt:       dailySales.CreatedAt(),
+		UpdatedAt:       dailySales.UpdatedAt(),
 	}
 
-	return &dto.CloseDayResponse{
+	response := &dto.CloseDayResponse{
 		Success:    true,
 		Message:    "Day closed successfully",
 		DailySales: salesResponse,
-	}, nil
+	}
+	if businessDay != nil {
+		response.ClosedBusinessDay = uint64(businessDay.ID())
+	}
+
+	return response, nil
 }
@@ -3,28 +3,61 @@ package dto
 import "time"
 
 type DailySalesResponse struct {
-	ID          string     `json:"id"`
-	Date        string     `json:"date"`
-	TotalSales  float64    `json:"total_sales"`
-	TotalOrders int        `json:"total_orders"`
-	AverageSale float64    `json:"average_sale"`
-	IsClosed    bool       `json:"is_closed"`
-	ClosedAt    *time.Time `json:"closed_at,omitempty"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
+	ID              string                `json:"id"`
+	Date            string                `json:"date"`
+	TotalSales      float64               `json:"total_sales"`
+	TotalOrders     int                   `json:"total_orders"`
+	AverageSale     float64               `json:"average_sale"`
+	CostOfGoodsSold float64               `json:"cost_of_goods_sold"`
+	GrossProfit     float64               `json:"gross_profit"`
+	MarginPercent   float64               `json:"margin_percent"`
+	Margin          *MarginReportResponse `json:"margin,omitempty"`
+	IsClosed        bool                  `json:"is_closed"`
+	ClosedAt        *time.Time            `json:"closed_at,omitempty"`
+	CreatedAt       time.Time             `json:"created_at"`
+	UpdatedAt       time.Time             `json:"updated_at"`
 }
 
 type SalesReportResponse struct {
-	StartDate   string                `json:"start_date"`
-	EndDate     string                `json:"end_date"`
-	TotalSales  float64               `json:"total_sales"`
-	TotalOrders int                   `json:"total_orders"`
-	DailySales  []*DailySalesResponse `json:"daily_sales"`
-	AverageSale float64               `json:"average_sale"`
+	StartDate       string                `json:"start_date"`
+	EndDate         string                `json:"end_date"`
+	TotalSales      float64               `json:"total_sales"`
+	TotalOrders     int                   `json:"total_orders"`
+	CostOfGoodsSold float64               `json:"cost_of_goods_sold"`
+	GrossProfit     float64               `json:"gross_profit"`
+	MarginPercent   float64               `json:"margin_percent"`
+	DailySales      []*DailySalesResponse `json:"daily_sales"`
+	Margin          *MarginReportResponse `json:"margin"`
+	AverageSale     float64               `json:"average_sale"`
+}
+
+// MarginLineResponse - cost of goods sold and gross profit for one product, category or day
+type MarginLineResponse struct {
+	Key             string  `json:"key"`
+	Label           string  `json:"label"`
+	Quantity        float64 `json:"quantity"`
+	Revenue         float64 `json:"revenue"`
+	CostOfGoodsSold float64 `json:"cost_of_goods_sold"`
+	GrossProfit     float64 `json:"gross_profit"`
+	MarginPercent   float64 `json:"margin_percent"`
+}
+
+// MarginReportResponse - margin breakdown of completed orders
+type MarginReportResponse struct {
+	Revenue         float64               `json:"revenue"`
+	CostOfGoodsSold float64               `json:"cost_of_goods_sold"`
+	GrossProfit     float64               `json:"gross_profit"`
+	MarginPercent   float64               `json:"margin_percent"`
+	ByProduct       []*MarginLineResponse `json:"by_product"`
+	ByCategory      []*MarginLineResponse `json:"by_category"`
+	ByDay           []*MarginLineResponse `json:"by_day"`
 }
 
 type CloseDayResponse struct {
 	Success    bool                `json:"success"`
 	Message    string              `json:"message"`
 	DailySales *DailySalesResponse `json:"daily_sales"`
+	// ClosedBusinessDay is the business day ended by the close; ticket
+	// numbers restart with the next order. Zero when no day was open.
+	ClosedBusinessDay uint64 `json:"closed_business_day,omitempty"`
 }
@@ -27,19 +27,59 @@ func (q *GetDailySalesQuery) Execute(date *time.Time) (*dto.DailySalesResponse,
 		return nil, err
 	}
 
-	return q.mapToDTO(dailySales), nil
+	// Break down margins per product and category
+	margin, err := q.salesService.GetMarginReport(targetDate, targetDate)
+	if err != nil {
+		return nil, err
+	}
+
+	response := q.mapToDTO(dailySales)
+	response.Margin = mapMarginToDTO(margin)
+	return response, nil
 }
 
 func (q *GetDailySalesQuery) mapToDTO(ds *sales.DailySales) *dto.DailySalesResponse {
 	return &dto.DailySalesResponse{
-		ID:          ds.ID().String(),
-		Date:        ds.Date().Format("2006-01-02"),
-		TotalSales:  ds.TotalSales().Amount,
-		TotalOrders: ds.TotalOrders(),
-		AverageSale: ds.AverageSale(),
-		IsClosed:    ds.IsClosed(),
-		ClosedAt:    ds.ClosedAt(),
-		CreatedAt:   ds.CreatedAt(),
-		UpdatedAt:   ds.UpdatedAt(),
+		ID:              ds.ID().String(),
+		Date:            ds.Date().Format("2006-01-02"),
+		TotalSales:      ds.TotalSales().Amount,
+		TotalOrders:     ds.TotalOrders(),
+		AverageSale:     ds.AverageSale(),
+		CostOfGoodsSold: ds.TotalCost().Amount,
+		GrossProfit:     ds.GrossProfit().Amount,
+		MarginPercent:   ds.MarginPercent(),
+		IsClosed:        ds.IsClosed(),
+		ClosedAt:        ds.ClosedAt(),
+		CreatedAt:       ds.CreatedAt(),
+		UpdatedAt:       ds.UpdatedAt(),
+	}
+}
+
+// mapMarginToDTO maps a margin report to its response DTO
+func mapMarginToDTO(report *sales.MarginReport) *dto.MarginReportResponse {
+	mapLines := func(lines []*sales.MarginLine) []*dto.MarginLineResponse {
+		responses := []*dto.MarginLineResponse{}
+		for _, line := range lines {
+			responses = append(responses, &dto.MarginLineResponse{
+				Key:             line.Key,
+				Label:           line.Label,
+				Quantity:        line.Quantity,
+				Revenue:         line.Revenue.Amount,
+				CostOfGoodsSold: line.Cost.Amount,
+				GrossProfit:     line.GrossProfit().Amount,
+				MarginPercent:   line.MarginPercent(),
+			})
+		}
+		return responses
+	}
+
+	return &dto.MarginReportResponse{
+		Revenue:         report.Total.Revenue.Amount,
+		CostOfGoodsSold: report.Total.Cost.Amount,
+		GrossProfit:     report.Total.GrossProfit().Amount,
+		MarginPercent:   report.Total.MarginPercent(),
+		ByProduct:       mapLines(report.ByProduct),
+		ByCategory:      mapLines(report.ByCategory),
+		ByDay:           mapLines(report.ByDay),
 	}
 }
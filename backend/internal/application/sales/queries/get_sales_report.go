@@ -18,46 +18,55 @@ func NewGetSalesReportQuery(salesService *sales.SalesService) *GetSalesReportQue
 
 // Execute obtains the sales report for a given date range
 func (q *GetSalesReportQuery) Execute(startDate, endDate time.Time) (*dto.SalesReportResponse, error) {
-	// get daily sales within the date range
-	dailySalesList, err := q.salesService.GetSalesReport(startDate, endDate)
+	// Get daily sales within the date range and their margins per
+	// product, category and day, counted from the same orders
+	dailySalesList, margin, err := q.salesService.GetSalesReport(startDate, endDate)
 	if err != nil {
 		return nil, err
 	}
 
 	// Map to DTO and calculate totals
-	var totalSales float64
 	var totalOrders int
 	var dailySalesResponses []*dto.DailySalesResponse
 
 	for _, ds := range dailySalesList {
-		totalSales += ds.TotalSales().Amount
 		totalOrders += ds.TotalOrders()
 
 		dailySalesResponses = append(dailySalesResponses, &dto.DailySalesResponse{
-			ID:          ds.ID().String(),
-			Date:        ds.Date().Format("2006-01-02"),
-			TotalSales:  ds.TotalSales().Amount,
-			TotalOrders: ds.TotalOrders(),
-			AverageSale: ds.AverageSale(),
-			IsClosed:    ds.IsClosed(),
-			ClosedAt:    ds.ClosedAt(),
-			CreatedAt:   ds.CreatedAt(),
-			UpdatedAt:   ds.UpdatedAt(),
+			ID:              ds.ID().String(),
+			Date:            ds.Date().Format("2006-01-02"),
+			TotalSales:      ds.TotalSales().Amount,
+			TotalOrders:     ds.TotalOrders(),
+			AverageSale:     ds.AverageSale(),
+			CostOfGoodsSold: ds.TotalCost().Amount,
+			GrossProfit:     ds.GrossProfit().Amount,
+			MarginPercent:   ds.MarginPercent(),
+			IsClosed:        ds.IsClosed(),
+			ClosedAt:        ds.ClosedAt(),
+			CreatedAt:       ds.CreatedAt(),
+			UpdatedAt:       ds.UpdatedAt(),
 		})
 	}
 
+	// The margin total covers the same orders as the days
+	total := margin.Total
+
 	// Calculate average sale
 	averageSale := 0.0
 	if totalOrders > 0 {
-		averageSale = totalSales / float64(totalOrders)
+		averageSale = total.Revenue.Amount / float64(totalOrders)
 	}
 
 	return &dto.SalesReportResponse{
-		StartDate:   startDate.Format("2006-01-02"),
-		EndDate:     endDate.Format("2006-01-02"),
-		TotalSales:  totalSales,
-		TotalOrders: totalOrders,
-		DailySales:  dailySalesResponses,
-		AverageSale: averageSale,
+		StartDate:       startDate.Format("2006-01-02"),
+		EndDate:         endDate.Format("2006-01-02"),
+		TotalSales:      total.Revenue.Amount,
+		TotalOrders:     totalOrders,
+		CostOfGoodsSold: total.Cost.Amount,
+		GrossProfit:     total.GrossProfit().Amount,
+		MarginPercent:   total.MarginPercent(),
+		DailySales:      dailySalesResponses,
+		Margin:          mapMarginToDTO(margin),
+		AverageSale:     averageSale,
 	}, nil
 }
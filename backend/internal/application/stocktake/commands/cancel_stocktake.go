@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/stocktake/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/stocktake"
+)
+
+type CancelStocktakeCommand struct {
+	stocktakeRepo stocktake.StocktakeRepository
+	productRepo   product.ProductRepository
+}
+
+func NewCancelStocktakeCommand(
+	stocktakeRepo stocktake.StocktakeRepository,
+	productRepo product.ProductRepository,
+) *CancelStocktakeCommand {
+	return &CancelStocktakeCommand{
+		stocktakeRepo: stocktakeRepo,
+		productRepo:   productRepo,
+	}
+}
+
+// Execute discards an open stocktake without adjusting stock
+func (c *CancelStocktakeCommand) Execute(id string) (*dto.StocktakeResponse, error) {
+	// Find stocktake
+	st, err := c.stocktakeRepo.FindByID(stocktake.StocktakeID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Cancel using domain method
+	if err := st.Cancel(); err != nil {
+		return nil, err
+	}
+
+	// Save changes
+	if err := c.stocktakeRepo.Save(st); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapStocktakeToDTO(st, c.productRepo)
+}
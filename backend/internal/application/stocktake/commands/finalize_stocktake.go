@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/stocktake/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/stocktake"
+)
+
+type FinalizeStocktakeCommand struct {
+	stocktakeService *stocktake.StocktakeService
+	productRepo      product.ProductRepository
+}
+
+func NewFinalizeStocktakeCommand(
+	stocktakeService *stocktake.StocktakeService,
+	productRepo product.ProductRepository,
+) *FinalizeStocktakeCommand {
+	return &FinalizeStocktakeCommand{
+		stocktakeService: stocktakeService,
+		productRepo:      productRepo,
+	}
+}
+
+// Execute applies the stocktake adjustments and returns the final variance report
+func (c *FinalizeStocktakeCommand) Execute(id string) (*dto.VarianceReportResponse, error) {
+	// Use domain service to finalize the stocktake
+	st, report, err := c.stocktakeService.Finalize(stocktake.StocktakeID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapVarianceReportToDTO(st, report, c.productRepo)
+}
+
+func mapVarianceReportToDTO(
+	st *stocktake.Stocktake,
+	report stocktake.VarianceReport,
+	productRepo product.ProductRepository,
+) (*dto.VarianceReportResponse, error) {
+	lines := []dto.VarianceLineResponse{}
+	for _, line := range report.Lines {
+		prod, err := productRepo.FindByIDIncludingArchived(line.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, dto.VarianceLineResponse{
+			ProductID:     line.ProductID.String(),
+			ProductName:   prod.Name(),
+			Expected:      line.Expected,
+			Counted:       line.Counted,
+			Variance:      line.Variance(),
+			UnitCost:      line.UnitCost.Amount,
+			VarianceValue: line.VarianceValue().Amount,
+		})
+	}
+
+	return &dto.VarianceReportResponse{
+		StocktakeID:    st.ID().String(),
+		Status:         string(st.Status()),
+		Lines:          lines,
+		ShrinkageValue: report.ShrinkageValue.Amount,
+		SurplusValue:   report.SurplusValue.Amount,
+		NetValue:       report.NetValue.Amount,
+		FinalizedAt:    st.FinalizedAt(),
+	}, nil
+}
@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/stocktake/dto"
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/stocktake"
+
+	"github.com/google/uuid"
+)
+
+type OpenStocktakeCommand struct {
+	stocktakeService *stocktake.StocktakeService
+	productRepo      product.ProductRepository
+}
+
+func NewOpenStocktakeCommand(
+	stocktakeService *stocktake.StocktakeService,
+	productRepo product.ProductRepository,
+) *OpenStocktakeCommand {
+	return &OpenStocktakeCommand{
+		stocktakeService: stocktakeService,
+		productRepo:      productRepo,
+	}
+}
+
+func (c *OpenStocktakeCommand) Execute(req dto.OpenStocktakeRequest) (*dto.StocktakeResponse, error) {
+	// Generate ID
+	id := stocktake.StocktakeID(uuid.New().String())
+
+	// Use domain service to open the stocktake
+	st, err := c.stocktakeService.Open(id, req.Name, location.LocationID(req.LocationID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapStocktakeToDTO(st, c.productRepo)
+}
+
+func mapStocktakeToDTO(st *stocktake.Stocktake, productRepo product.ProductRepository) (*dto.StocktakeResponse, error) {
+	names := make(map[string]string)
+
+	var counts []dto.CountResponse
+	for _, count := range st.Counts() {
+		name, ok := names[count.ProductID.String()]
+		if !ok {
+			prod, err := productRepo.FindByIDIncludingArchived(count.ProductID)
+			if err != nil {
+				return nil, err
+			}
+			name = prod.Name()
+			names[count.ProductID.String()] = name
+		}
+
+		counts = append(counts, dto.CountResponse{
+			ProductID:   count.ProductID.String(),
+			ProductName: name,
+			TerminalID:  count.TerminalID,
+			Counted:     count.Counted,
+			Expected:    count.Expected,
+			CountedAt:   count.CountedAt,
+		})
+	}
+
+	return &dto.StocktakeResponse{
+		ID:              st.ID().String(),
+		Name:            st.Name(),
+		LocationID:      st.LocationID().String(),
+		Status:          string(st.Status()),
+		CountedProducts: len(st.CountedProducts()),
+		Counts:          counts,
+		OpenedAt:        st.OpenedAt(),
+		FinalizedAt:     st.FinalizedAt(),
+		UpdatedAt:       st.UpdatedAt(),
+	}, nil
+}
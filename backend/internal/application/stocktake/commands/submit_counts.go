@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/stocktake/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/stocktake"
+)
+
+type SubmitCountsCommand struct {
+	stocktakeService *stocktake.StocktakeService
+	productRepo      product.ProductRepository
+}
+
+func NewSubmitCountsCommand(
+	stocktakeService *stocktake.StocktakeService,
+	productRepo product.ProductRepository,
+) *SubmitCountsCommand {
+	return &SubmitCountsCommand{
+		stocktakeService: stocktakeService,
+		productRepo:      productRepo,
+	}
+}
+
+// Execute records the quantities counted by a terminal
+func (c *SubmitCountsCommand) Execute(id string, req dto.SubmitCountsRequest) (*dto.StocktakeResponse, error) {
+	// Convert request lines to count requests
+	var counts []stocktake.CountRequest
+	for _, line := range req.Counts {
+		counts = append(counts, stocktake.CountRequest{
+			ProductID: shared.ProductID(line.ProductID),
+			Counted:   *line.Counted,
+		})
+	}
+
+	// Use domain service to record the counts
+	st, err := c.stocktakeService.SubmitCounts(stocktake.StocktakeID(id), req.TerminalID, counts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapStocktakeToDTO(st, c.productRepo)
+}
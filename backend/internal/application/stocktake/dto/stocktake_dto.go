@@ -0,0 +1,70 @@
+package dto
+
+import "time"
+
+// OpenStocktakeRequest - Input DTO for opening a stocktake
+type OpenStocktakeRequest struct {
+	Name       string `json:"name"`
+	LocationID string `json:"location_id"` // the location counted, defaults to the default location
+}
+
+// SubmitCountsRequest - Input DTO for counts submitted by one terminal
+type SubmitCountsRequest struct {
+	TerminalID string      `json:"terminal_id" binding:"required"`
+	Counts     []CountLine `json:"counts" binding:"required,min=1,dive"`
+}
+
+// CountLine - counted quantity of a product; a repeated count from the same terminal replaces the previous one
+type CountLine struct {
+	ProductID string   `json:"product_id" binding:"required"`
+	Counted   *float64 `json:"counted" binding:"required,gte=0"`
+}
+
+// StocktakeResponse - Output DTO
+type StocktakeResponse struct {
+	ID              string          `json:"id"`
+	Name            string          `json:"name"`
+	LocationID      string          `json:"location_id"`
+	Status          string          `json:"status"`
+	CountedProducts int             `json:"counted_products"`
+	Counts          []CountResponse `json:"counts"`
+	OpenedAt        time.Time       `json:"opened_at"`
+	FinalizedAt     *time.Time      `json:"finalized_at,omitempty"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+type CountResponse struct {
+	ProductID   string    `json:"product_id"`
+	ProductName string    `json:"product_name"`
+	TerminalID  string    `json:"terminal_id"`
+	Counted     float64   `json:"counted"`
+	Expected    float64   `json:"expected"`
+	CountedAt   time.Time `json:"counted_at"`
+}
+
+// StocktakeListResponse - Output DTO for list
+type StocktakeListResponse struct {
+	Stocktakes []*StocktakeResponse `json:"stocktakes"`
+	Total      int                  `json:"total"`
+}
+
+// VarianceReportResponse - expected vs counted stock, valued at cost
+type VarianceReportResponse struct {
+	StocktakeID    string                 `json:"stocktake_id"`
+	Status         string                 `json:"status"`
+	Lines          []VarianceLineResponse `json:"lines"`
+	ShrinkageValue float64                `json:"shrinkage_value"`
+	SurplusValue   float64                `json:"surplus_value"`
+	NetValue       float64                `json:"net_value"`
+	FinalizedAt    *time.Time             `json:"finalized_at,omitempty"`
+}
+
+type VarianceLineResponse struct {
+	ProductID     string  `json:"product_id"`
+	ProductName   string  `json:"product_name"`
+	Expected      float64 `json:"expected"`
+	Counted       float64 `json:"counted"`
+	Variance      float64 `json:"variance"`
+	UnitCost      float64 `json:"unit_cost"`
+	VarianceValue float64 `json:"variance_value"`
+}
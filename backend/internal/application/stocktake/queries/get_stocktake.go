@@ -0,0 +1,71 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/stocktake/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/stocktake"
+)
+
+type GetStocktakeQuery struct {
+	stocktakeRepo stocktake.StocktakeRepository
+	productRepo   product.ProductRepository
+}
+
+func NewGetStocktakeQuery(
+	stocktakeRepo stocktake.StocktakeRepository,
+	productRepo product.ProductRepository,
+) *GetStocktakeQuery {
+	return &GetStocktakeQuery{
+		stocktakeRepo: stocktakeRepo,
+		productRepo:   productRepo,
+	}
+}
+
+func (q *GetStocktakeQuery) Execute(id string) (*dto.StocktakeResponse, error) {
+	// Find stocktake
+	st, err := q.stocktakeRepo.FindByID(stocktake.StocktakeID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapStocktakeToDTO(st, q.productRepo)
+}
+
+func mapStocktakeToDTO(st *stocktake.Stocktake, productRepo product.ProductRepository) (*dto.StocktakeResponse, error) {
+	names := make(map[string]string)
+
+	var counts []dto.CountResponse
+	for _, count := range st.Counts() {
+		name, ok := names[count.ProductID.String()]
+		if !ok {
+			prod, err := productRepo.FindByIDIncludingArchived(count.ProductID)
+			if err != nil {
+				return nil, err
+			}
+			name = prod.Name()
+			names[count.ProductID.String()] = name
+		}
+
+		counts = append(counts, dto.CountResponse{
+			ProductID:   count.ProductID.String(),
+			ProductName: name,
+			TerminalID:  count.TerminalID,
+			Counted:     count.Counted,
+			Expected:    count.Expected,
+			CountedAt:   count.CountedAt,
+		})
+	}
+
+	return &dto.StocktakeResponse{
+		ID:              st.ID().String(),
+		Name:            st.Name(),
+		LocationID:      st.LocationID().String(),
+		Status:          string(st.Status()),
+		CountedProducts: len(st.CountedProducts()),
+		Counts:          counts,
+		OpenedAt:        st.OpenedAt(),
+		FinalizedAt:     st.FinalizedAt(),
+		UpdatedAt:       st.UpdatedAt(),
+	}, nil
+}
@@ -0,0 +1,60 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/stocktake/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/stocktake"
+)
+
+type GetVarianceReportQuery struct {
+	stocktakeService *stocktake.StocktakeService
+	productRepo      product.ProductRepository
+}
+
+func NewGetVarianceReportQuery(
+	stocktakeService *stocktake.StocktakeService,
+	productRepo product.ProductRepository,
+) *GetVarianceReportQuery {
+	return &GetVarianceReportQuery{
+		stocktakeService: stocktakeService,
+		productRepo:      productRepo,
+	}
+}
+
+// Execute reviews expected vs counted stock; for an open stocktake this is a preview
+func (q *GetVarianceReportQuery) Execute(id string) (*dto.VarianceReportResponse, error) {
+	// Use domain service to build the report
+	st, report, err := q.stocktakeService.VarianceReport(stocktake.StocktakeID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	lines := []dto.VarianceLineResponse{}
+	for _, line := range report.Lines {
+		prod, err := q.productRepo.FindByIDIncludingArchived(line.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, dto.VarianceLineResponse{
+			ProductID:     line.ProductID.String(),
+			ProductName:   prod.Name(),
+			Expected:      line.Expected,
+			Counted:       line.Counted,
+			Variance:      line.Variance(),
+			UnitCost:      line.UnitCost.Amount,
+			VarianceValue: line.VarianceValue().Amount,
+		})
+	}
+
+	return &dto.VarianceReportResponse{
+		StocktakeID:    st.ID().String(),
+		Status:         string(st.Status()),
+		Lines:          lines,
+		ShrinkageValue: report.ShrinkageValue.Amount,
+		SurplusValue:   report.SurplusValue.Amount,
+		NetValue:       report.NetValue.Amount,
+		FinalizedAt:    st.FinalizedAt(),
+	}, nil
+}
@@ -0,0 +1,45 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/stocktake/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/stocktake"
+)
+
+type ListStocktakesQuery struct {
+	stocktakeRepo stocktake.StocktakeRepository
+	productRepo   product.ProductRepository
+}
+
+func NewListStocktakesQuery(
+	stocktakeRepo stocktake.StocktakeRepository,
+	productRepo product.ProductRepository,
+) *ListStocktakesQuery {
+	return &ListStocktakesQuery{
+		stocktakeRepo: stocktakeRepo,
+		productRepo:   productRepo,
+	}
+}
+
+func (q *ListStocktakesQuery) Execute() (*dto.StocktakeListResponse, error) {
+	// Find all stocktakes
+	stocktakes, err := q.stocktakeRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	var responses []*dto.StocktakeResponse
+	for _, st := range stocktakes {
+		response, err := mapStocktakeToDTO(st, q.productRepo)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, response)
+	}
+
+	return &dto.StocktakeListResponse{
+		Stocktakes: responses,
+		Total:      len(responses),
+	}, nil
+}
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/table/dto"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/table"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateTableCommand struct {
+	tableRepo table.TableRepository
+	orderRepo order.OrderRepository
+}
+
+func NewCreateTableCommand(tableRepo table.TableRepository, orderRepo order.OrderRepository) *CreateTableCommand {
+	return &CreateTableCommand{
+		tableRepo: tableRepo,
+		orderRepo: orderRepo,
+	}
+}
+
+func (c *CreateTableCommand) Execute(req dto.CreateTableRequest) (*dto.TableResponse, error) {
+	// Table numbers are unique on the floor plan
+	number := strings.TrimSpace(req.Number)
+	if _, err := c.tableRepo.FindByNumber(number); err == nil {
+		return nil, fmt.Errorf("%w: table %s already exists", shared.ErrInvalidInput, number)
+	} else if !errors.Is(err, shared.ErrNotFound) {
+		return nil, err
+	}
+
+	// Generate ID
+	id := table.TableID(uuid.New().String())
+
+	// Create table entity using domain factory
+	t, err := table.NewTable(id, number, req.Area, req.Capacity, table.Position{X: req.X, Y: req.Y})
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to repository
+	if err := c.tableRepo.Save(t); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO; orders may already carry the number
+	orders, err := openOrdersAt(c.orderRepo, t)
+	if err != nil {
+		return nil, err
+	}
+	return mapTableToDTO(t, orders), nil
+}
+
+// openOrdersAt returns the orders still to be paid at a table, oldest first
+func openOrdersAt(orderRepo order.OrderRepository, t *table.Table) ([]*order.Order, error) {
+	orders, err := orderRepo.FindOpen()
+	if err != nil {
+		return nil, err
+	}
+
+	var open []*order.Order
+	for _, ord := range orders {
+		if ord.TableNumber().String() == t.Number() {
+			open = append(open, ord)
+		}
+	}
+	return open, nil
+}
+
+func mapTableToDTO(t *table.Table, orders []*order.Order) *dto.TableResponse {
+	openOrders := make([]dto.TableOrderResponse, 0, len(orders))
+	runningTotal := shared.Money{Amount: 0, Currency: "USD"}
+	var lastOrderAt time.Time
+	for _, ord := range orders {
+		openOrders = append(openOrders, dto.TableOrderResponse{
+			ID:           ord.ID().String(),
+			TicketNumber: ord.TicketNumber().String(),
+			Status:       string(ord.Status()),
+			Total:        ord.Total().Amount,
+			ItemCount:    len(ord.Items()),
+			CreatedAt:    ord.CreatedAt(),
+		})
+		runningTotal = runningTotal.Add(ord.Total())
+		if ord.CreatedAt().After(lastOrderAt) {
+			lastOrderAt = ord.CreatedAt()
+		}
+	}
+
+	return &dto.TableResponse{
+		ID:              t.ID().String(),
+		Number:          t.Number(),
+		Area:            t.Area(),
+		Capacity:        t.Capacity(),
+		X:               t.Position().X,
+		Y:               t.Position().Y,
+		Status:          string(t.Status(len(orders), lastOrderAt)),
+		BillRequestedAt: t.BillRequestedAt(),
+		OpenOrders:      openOrders,
+		RunningTotal:    runningTotal.Amount,
+		CreatedAt:       t.CreatedAt(),
+		UpdatedAt:       t.UpdatedAt(),
+	}
+}
@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/table"
+)
+
+type DeleteTableCommand struct {
+	tableRepo table.TableRepository
+	orderRepo order.OrderRepository
+}
+
+func NewDeleteTableCommand(tableRepo table.TableRepository, orderRepo order.OrderRepository) *DeleteTableCommand {
+	return &DeleteTableCommand{
+		tableRepo: tableRepo,
+		orderRepo: orderRepo,
+	}
+}
+
+// Execute removes a table from the floor plan once its orders are paid.
+// Past orders keep the table number.
+func (c *DeleteTableCommand) Execute(id string) error {
+	// Find table
+	t, err := c.tableRepo.FindByID(table.TableID(id))
+	if err != nil {
+		return err
+	}
+
+	// Refuse while guests are seated
+	orders, err := openOrdersAt(c.orderRepo, t)
+	if err != nil {
+		return err
+	}
+	if len(orders) > 0 {
+		return shared.ErrTableInUse
+	}
+
+	return c.tableRepo.Delete(t.ID())
+}
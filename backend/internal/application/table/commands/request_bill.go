@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/table/dto"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/table"
+	"fmt"
+	"time"
+)
+
+type RequestBillCommand struct {
+	tableRepo table.TableRepository
+	orderRepo order.OrderRepository
+}
+
+func NewRequestBillCommand(tableRepo table.TableRepository, orderRepo order.OrderRepository) *RequestBillCommand {
+	return &RequestBillCommand{
+		tableRepo: tableRepo,
+		orderRepo: orderRepo,
+	}
+}
+
+// Execute marks that a table asked for the bill, or withdraws the request
+func (c *RequestBillCommand) Execute(id string, requested bool) (*dto.TableResponse, error) {
+	// Find table
+	t, err := c.tableRepo.FindByID(table.TableID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := openOrdersAt(c.orderRepo, t)
+	if err != nil {
+		return nil, err
+	}
+
+	if requested {
+		// Only a table with something to pay can ask for the bill
+		if len(orders) == 0 {
+			return nil, fmt.Errorf("%w: table %s has no open orders", shared.ErrInvalidInput, t.Number())
+		}
+		t.RequestBill(time.Now())
+	} else {
+		t.CancelBillRequest()
+	}
+
+	// Save changes
+	if err := c.tableRepo.Save(t); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapTableToDTO(t, orders), nil
+}
@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/table/dto"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/table"
+)
+
+type UpdateTableCommand struct {
+	tableRepo table.TableRepository
+	orderRepo order.OrderRepository
+}
+
+func NewUpdateTableCommand(tableRepo table.TableRepository, orderRepo order.OrderRepository) *UpdateTableCommand {
+	return &UpdateTableCommand{
+		tableRepo: tableRepo,
+		orderRepo: orderRepo,
+	}
+}
+
+func (c *UpdateTableCommand) Execute(id string, req dto.UpdateTableRequest) (*dto.TableResponse, error) {
+	// Find table
+	t, err := c.tableRepo.FindByID(table.TableID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep current arrangement for fields not provided
+	area := t.Area()
+	if req.Area != nil {
+		area = *req.Area
+	}
+	capacity := t.Capacity()
+	if req.Capacity != nil {
+		capacity = *req.Capacity
+	}
+	position := t.Position()
+	if req.X != nil {
+		position.X = *req.X
+	}
+	if req.Y != nil {
+		position.Y = *req.Y
+	}
+
+	if err := t.Arrange(area, capacity, position); err != nil {
+		return nil, err
+	}
+
+	// Save changes
+	if err := c.tableRepo.Save(t); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	orders, err := openOrdersAt(c.orderRepo, t)
+	if err != nil {
+		return nil, err
+	}
+	return mapTableToDTO(t, orders), nil
+}
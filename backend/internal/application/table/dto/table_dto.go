@@ -0,0 +1,59 @@
+package dto
+
+import "time"
+
+// CreateTableRequest - Input DTO for adding a table to the floor plan
+type CreateTableRequest struct {
+	Number   string  `json:"number" binding:"required"` // what orders refer to the table by; cannot be changed
+	Area     string  `json:"area"`                      // section of the floor, such as the terrace
+	Capacity int     `json:"capacity" binding:"required,gt=0"`
+	X        float64 `json:"x" binding:"gte=0"`
+	Y        float64 `json:"y" binding:"gte=0"`
+}
+
+// UpdateTableRequest - Input DTO for rearranging a table; omitted fields are kept
+type UpdateTableRequest struct {
+	Area     *string  `json:"area"`
+	Capacity *int     `json:"capacity" binding:"omitempty,gt=0"`
+	X        *float64 `json:"x" binding:"omitempty,gte=0"`
+	Y        *float64 `json:"y" binding:"omitempty,gte=0"`
+}
+
+// ListTablesRequest - Query parameters for the floor plan
+type ListTablesRequest struct {
+	Area   string `form:"area"`
+	Status string `form:"status" binding:"omitempty,oneof=free occupied needs_bill"`
+}
+
+// TableResponse - Output DTO with the table's live status and open orders
+type TableResponse struct {
+	ID              string               `json:"id"`
+	Number          string               `json:"number"`
+	Area            string               `json:"area"`
+	Capacity        int                  `json:"capacity"`
+	X               float64              `json:"x"`
+	Y               float64              `json:"y"`
+	Status          string               `json:"status"`
+	BillRequestedAt *time.Time           `json:"bill_requested_at,omitempty"`
+	OpenOrders      []TableOrderResponse `json:"open_orders"`
+	RunningTotal    float64              `json:"running_total"` // total of the open orders
+	CreatedAt       time.Time            `json:"created_at"`
+	UpdatedAt       time.Time            `json:"updated_at"`
+}
+
+// TableOrderResponse - an open order at a table
+type TableOrderResponse struct {
+	ID           string    `json:"id"`
+	TicketNumber string    `json:"ticket_number"`
+	Status       string    `json:"status"`
+	Total        float64   `json:"total"`
+	ItemCount    int       `json:"item_count"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// TableListResponse - Output DTO for the floor plan
+type TableListResponse struct {
+	Tables []*TableResponse `json:"tables"`
+	Areas  []string         `json:"areas"`
+	Total  int              `json:"total"`
+}
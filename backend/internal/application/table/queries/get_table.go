@@ -0,0 +1,83 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/table/dto"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/table"
+	"time"
+)
+
+type GetTableQuery struct {
+	tableRepo table.TableRepository
+	orderRepo order.OrderRepository
+}
+
+func NewGetTableQuery(tableRepo table.TableRepository, orderRepo order.OrderRepository) *GetTableQuery {
+	return &GetTableQuery{
+		tableRepo: tableRepo,
+		orderRepo: orderRepo,
+	}
+}
+
+func (q *GetTableQuery) Execute(id string) (*dto.TableResponse, error) {
+	// Find table
+	t, err := q.tableRepo.FindByID(table.TableID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Find its open orders
+	orders, err := q.orderRepo.FindOpen()
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapTableToDTO(t, groupByTable(orders)[t.Number()]), nil
+}
+
+// groupByTable groups open orders by table number, keeping their order
+func groupByTable(orders []*order.Order) map[string][]*order.Order {
+	byTable := map[string][]*order.Order{}
+	for _, ord := range orders {
+		number := ord.TableNumber().String()
+		byTable[number] = append(byTable[number], ord)
+	}
+	return byTable
+}
+
+func mapTableToDTO(t *table.Table, orders []*order.Order) *dto.TableResponse {
+	openOrders := make([]dto.TableOrderResponse, 0, len(orders))
+	runningTotal := shared.Money{Amount: 0, Currency: "USD"}
+	var lastOrderAt time.Time
+	for _, ord := range orders {
+		openOrders = append(openOrders, dto.TableOrderResponse{
+			ID:           ord.ID().String(),
+			TicketNumber: ord.TicketNumber().String(),
+			Status:       string(ord.Status()),
+			Total:        ord.Total().Amount,
+			ItemCount:    len(ord.Items()),
+			CreatedAt:    ord.CreatedAt(),
+		})
+		runningTotal = runningTotal.Add(ord.Total())
+		if ord.CreatedAt().After(lastOrderAt) {
+			lastOrderAt = ord.CreatedAt()
+		}
+	}
+
+	return &dto.TableResponse{
+		ID:              t.ID().String(),
+		Number:          t.Number(),
+		Area:            t.Area(),
+		Capacity:        t.Capacity(),
+		X:               t.Position().X,
+		Y:               t.Position().Y,
+		Status:          string(t.Status(len(orders), lastOrderAt)),
+		BillRequestedAt: t.BillRequestedAt(),
+		OpenOrders:      openOrders,
+		RunningTotal:    runningTotal.Amount,
+		CreatedAt:       t.CreatedAt(),
+		UpdatedAt:       t.UpdatedAt(),
+	}
+}
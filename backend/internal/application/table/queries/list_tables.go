@@ -0,0 +1,75 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/table/dto"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/table"
+	"sort"
+	"strconv"
+)
+
+type ListTablesQuery struct {
+	tableRepo table.TableRepository
+	orderRepo order.OrderRepository
+}
+
+func NewListTablesQuery(tableRepo table.TableRepository, orderRepo order.OrderRepository) *ListTablesQuery {
+	return &ListTablesQuery{
+		tableRepo: tableRepo,
+		orderRepo: orderRepo,
+	}
+}
+
+// Execute returns the floor plan with each table's live status, open
+// orders and running total, optionally for one area or status
+func (q *ListTablesQuery) Execute(req dto.ListTablesRequest) (*dto.TableListResponse, error) {
+	// Find tables and the orders still open at them
+	tables, err := q.tableRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	orders, err := q.orderRepo.FindOpen()
+	if err != nil {
+		return nil, err
+	}
+	byTable := groupByTable(orders)
+
+	// Table 2 comes before table 10
+	sort.SliceStable(tables, func(i, j int) bool {
+		if tables[i].Area() != tables[j].Area() {
+			return tables[i].Area() < tables[j].Area()
+		}
+		a, errA := strconv.Atoi(tables[i].Number())
+		b, errB := strconv.Atoi(tables[j].Number())
+		if errA == nil && errB == nil {
+			return a < b
+		}
+		return tables[i].Number() < tables[j].Number()
+	})
+
+	// Map to response DTOs, filtering by area and status
+	responses := []*dto.TableResponse{}
+	areas := []string{}
+	seen := map[string]bool{}
+	for _, t := range tables {
+		if !seen[t.Area()] {
+			seen[t.Area()] = true
+			areas = append(areas, t.Area())
+		}
+
+		if req.Area != "" && t.Area() != req.Area {
+			continue
+		}
+		response := mapTableToDTO(t, byTable[t.Number()])
+		if req.Status != "" && response.Status != req.Status {
+			continue
+		}
+		responses = append(responses, response)
+	}
+
+	return &dto.TableListResponse{
+		Tables: responses,
+		Areas:  areas,
+		Total:  len(responses),
+	}, nil
+}
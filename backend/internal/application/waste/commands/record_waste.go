@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/waste/dto"
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/waste"
+
+	"github.com/google/uuid"
+)
+
+type RecordWasteCommand struct {
+	wasteService *waste.WasteService
+	productRepo  product.ProductRepository
+}
+
+func NewRecordWasteCommand(wasteService *waste.WasteService, productRepo product.ProductRepository) *RecordWasteCommand {
+	return &RecordWasteCommand{
+		wasteService: wasteService,
+		productRepo:  productRepo,
+	}
+}
+
+// Execute logs wasted stock and removes it from inventory
+func (c *RecordWasteCommand) Execute(req dto.RecordWasteRequest) (*dto.WasteEntryResponse, error) {
+	// Generate ID
+	id := waste.WasteID(uuid.New().String())
+
+	// Use domain service to record the waste
+	entry, err := c.wasteService.Record(
+		id,
+		shared.ProductID(req.ProductID),
+		location.LocationID(req.LocationID),
+		req.Quantity,
+		waste.Reason(req.Reason),
+		req.StaffMember,
+		req.Notes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapWasteEntryToDTO(entry, c.productRepo)
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/waste/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/waste"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WriteOffExpiredLotsCommand struct {
+	wasteService *waste.WasteService
+	productRepo  product.ProductRepository
+}
+
+func NewWriteOffExpiredLotsCommand(wasteService *waste.WasteService, productRepo product.ProductRepository) *WriteOffExpiredLotsCommand {
+	return &WriteOffExpiredLotsCommand{
+		wasteService: wasteService,
+		productRepo:  productRepo,
+	}
+}
+
+// Execute writes off every expired lot, logging a waste entry per lot
+func (c *WriteOffExpiredLotsCommand) Execute(req dto.WriteOffExpiredLotsRequest) (*dto.WasteListResponse, error) {
+	now := time.Now()
+
+	// Find products holding lots whose expiry day has passed
+	products, err := c.productRepo.FindWithLotsExpiringBefore(now.AddDate(0, 0, -1))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.WasteListResponse{Entries: []*dto.WasteEntryResponse{}}
+	for _, prod := range products {
+		for _, lot := range prod.Lots() {
+			if !lot.IsExpired(now) {
+				continue
+			}
+
+			// Use domain service to write off the lot
+			entry, err := c.wasteService.WriteOffLot(
+				waste.WasteID(uuid.New().String()),
+				prod.ID(),
+				"", // expired lots are written off at the default location
+				lot.ID,
+				waste.ReasonExpired,
+				req.StaffMember,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			entryResponse, err := mapWasteEntryToDTO(entry, c.productRepo)
+			if err != nil {
+				return nil, err
+			}
+			response.Entries = append(response.Entries, entryResponse)
+			response.TotalCost += entryResponse.CostValue
+		}
+	}
+	response.Total = len(response.Entries)
+
+	return response, nil
+}
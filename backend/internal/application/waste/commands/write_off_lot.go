@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/waste/dto"
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/waste"
+
+	"github.com/google/uuid"
+)
+
+type WriteOffLotCommand struct {
+	wasteService *waste.WasteService
+	productRepo  product.ProductRepository
+}
+
+func NewWriteOffLotCommand(wasteService *waste.WasteService, productRepo product.ProductRepository) *WriteOffLotCommand {
+	return &WriteOffLotCommand{
+		wasteService: wasteService,
+		productRepo:  productRepo,
+	}
+}
+
+// Execute writes off the remaining stock of a lot and logs it as waste
+func (c *WriteOffLotCommand) Execute(req dto.WriteOffLotRequest) (*dto.WasteEntryResponse, error) {
+	reason := waste.ReasonExpired
+	if req.Reason != "" {
+		reason = waste.Reason(req.Reason)
+	}
+
+	// Use domain service to write off the lot
+	entry, err := c.wasteService.WriteOffLot(
+		waste.WasteID(uuid.New().String()),
+		shared.ProductID(req.ProductID),
+		location.LocationID(req.LocationID),
+		product.LotID(req.LotID),
+		reason,
+		req.StaffMember,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapWasteEntryToDTO(entry, c.productRepo)
+}
+
+func mapWasteEntryToDTO(entry *waste.WasteEntry, productRepo product.ProductRepository) (*dto.WasteEntryResponse, error) {
+	prod, err := productRepo.FindByIDIncludingArchived(entry.ProductID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.WasteEntryResponse{
+		ID:          entry.ID().String(),
+		ProductID:   entry.ProductID().String(),
+		ProductName: prod.Name(),
+		Quantity:    entry.Quantity(),
+		Reason:      string(entry.Reason()),
+		StaffMember: entry.StaffMember(),
+		Notes:       entry.Notes(),
+		UnitCost:    entry.UnitCost().Amount,
+		CostValue:   entry.CostValue().Amount,
+		RecordedAt:  entry.RecordedAt(),
+	}, nil
+}
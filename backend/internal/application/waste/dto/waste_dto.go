@@ -0,0 +1,81 @@
+package dto
+
+import "time"
+
+// RecordWasteRequest - Input DTO for logging wasted stock
+type RecordWasteRequest struct {
+	ProductID   string  `json:"product_id" binding:"required"`
+	LocationID  string  `json:"location_id"` // where the stock was wasted, defaults to the default location
+	Quantity    float64 `json:"quantity" binding:"required,gt=0"`
+	Reason      string  `json:"reason" binding:"required,oneof=spoiled expired damaged overproduction preparation_error customer_return other"`
+	StaffMember string  `json:"staff_member" binding:"required"`
+	Notes       string  `json:"notes"`
+}
+
+// WasteEntryResponse - Output DTO
+type WasteEntryResponse struct {
+	ID          string    `json:"id"`
+	ProductID   string    `json:"product_id"`
+	ProductName string    `json:"product_name"`
+	Quantity    float64   `json:"quantity"`
+	Reason      string    `json:"reason"`
+	StaffMember string    `json:"staff_member"`
+	Notes       string    `json:"notes,omitempty"`
+	UnitCost    float64   `json:"unit_cost"`
+	CostValue   float64   `json:"cost_value"`
+	RecordedAt  time.Time `json:"recorded_at"`
+}
+
+// WasteListResponse - Output DTO for list
+type WasteListResponse struct {
+	Entries   []*WasteEntryResponse `json:"entries"`
+	Total     int                   `json:"total"`
+	TotalCost float64               `json:"total_cost"`
+}
+
+// WasteReportResponse - waste by reason, product and day compared against sales
+type WasteReportResponse struct {
+	StartDate           string               `json:"start_date"`
+	EndDate             string               `json:"end_date"`
+	TotalEntries        int                  `json:"total_entries"`
+	TotalQuantity       float64              `json:"total_quantity"`
+	TotalWasteCost      float64              `json:"total_waste_cost"`
+	TotalSalesRevenue   float64              `json:"total_sales_revenue"`
+	TotalSalesCost      float64              `json:"total_sales_cost"`
+	WastePercentOfSales float64              `json:"waste_percent_of_sales"`
+	ByReason            []WasteLineResponse  `json:"by_reason"`
+	ByProduct           []WasteLineResponse  `json:"by_product"`
+	ByDay               []DailyWasteResponse `json:"by_day"`
+}
+
+type WasteLineResponse struct {
+	Key      string  `json:"key"`
+	Label    string  `json:"label"`
+	Entries  int     `json:"entries"`
+	Quantity float64 `json:"quantity"`
+	Cost     float64 `json:"cost"`
+}
+
+type DailyWasteResponse struct {
+	Date                string  `json:"date"`
+	Entries             int     `json:"entries"`
+	Quantity            float64 `json:"quantity"`
+	WasteCost           float64 `json:"waste_cost"`
+	SalesRevenue        float64 `json:"sales_revenue"`
+	SalesCost           float64 `json:"sales_cost"`
+	WastePercentOfSales float64 `json:"waste_percent_of_sales"`
+}
+
+// WriteOffLotRequest - Input DTO for writing off the remaining stock of a lot
+type WriteOffLotRequest struct {
+	ProductID   string `json:"product_id" binding:"required"`
+	LocationID  string `json:"location_id"` // where the lot is held, defaults to the default location
+	LotID       string `json:"lot_id" binding:"required"`
+	Reason      string `json:"reason" binding:"omitempty,oneof=spoiled expired damaged overproduction preparation_error customer_return other"` // defaults to expired
+	StaffMember string `json:"staff_member" binding:"required"`
+}
+
+// WriteOffExpiredLotsRequest - Input DTO for writing off every expired lot
+type WriteOffExpiredLotsRequest struct {
+	StaffMember string `json:"staff_member" binding:"required"`
+}
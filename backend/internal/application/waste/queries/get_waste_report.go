@@ -0,0 +1,67 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/waste/dto"
+	"POSFlowBackend/internal/domain/waste"
+	"time"
+)
+
+type GetWasteReportQuery struct {
+	wasteService *waste.WasteService
+}
+
+func NewGetWasteReportQuery(wasteService *waste.WasteService) *GetWasteReportQuery {
+	return &GetWasteReportQuery{
+		wasteService: wasteService,
+	}
+}
+
+// Execute obtains the waste report for a given date range
+func (q *GetWasteReportQuery) Execute(startDate, endDate time.Time) (*dto.WasteReportResponse, error) {
+	report, err := q.wasteService.GetReport(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to DTO
+	mapLines := func(lines []*waste.WasteLine) []dto.WasteLineResponse {
+		responses := []dto.WasteLineResponse{}
+		for _, line := range lines {
+			responses = append(responses, dto.WasteLineResponse{
+				Key:      line.Key,
+				Label:    line.Label,
+				Entries:  line.Entries,
+				Quantity: line.Quantity,
+				Cost:     line.Cost.Amount,
+			})
+		}
+		return responses
+	}
+
+	days := []dto.DailyWasteResponse{}
+	for _, day := range report.ByDay {
+		days = append(days, dto.DailyWasteResponse{
+			Date:                day.Date,
+			Entries:             day.Entries,
+			Quantity:            day.Quantity,
+			WasteCost:           day.WasteCost.Amount,
+			SalesRevenue:        day.SalesRevenue.Amount,
+			SalesCost:           day.SalesCost.Amount,
+			WastePercentOfSales: day.WastePercentOfSales(),
+		})
+	}
+
+	return &dto.WasteReportResponse{
+		StartDate:           startDate.Format("2006-01-02"),
+		EndDate:             endDate.Format("2006-01-02"),
+		TotalEntries:        report.Total.Entries,
+		TotalQuantity:       report.Total.Quantity,
+		TotalWasteCost:      report.Total.WasteCost.Amount,
+		TotalSalesRevenue:   report.Total.SalesRevenue.Amount,
+		TotalSalesCost:      report.Total.SalesCost.Amount,
+		WastePercentOfSales: report.Total.WastePercentOfSales(),
+		ByReason:            mapLines(report.ByReason),
+		ByProduct:           mapLines(report.ByProduct),
+		ByDay:               days,
+	}, nil
+}
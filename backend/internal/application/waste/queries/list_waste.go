@@ -0,0 +1,65 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/waste/dto"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/waste"
+	"time"
+)
+
+type ListWasteQuery struct {
+	wasteService *waste.WasteService
+	productRepo  product.ProductRepository
+}
+
+func NewListWasteQuery(wasteService *waste.WasteService, productRepo product.ProductRepository) *ListWasteQuery {
+	return &ListWasteQuery{
+		wasteService: wasteService,
+		productRepo:  productRepo,
+	}
+}
+
+// Execute lists waste entries recorded between the start and end days, inclusive
+func (q *ListWasteQuery) Execute(startDate, endDate time.Time) (*dto.WasteListResponse, error) {
+	entries, err := q.wasteService.FindByDays(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	var totalCost float64
+	var responses []*dto.WasteEntryResponse
+	names := map[string]string{}
+
+	for _, entry := range entries {
+		name, ok := names[entry.ProductID().String()]
+		if !ok {
+			prod, err := q.productRepo.FindByIDIncludingArchived(entry.ProductID())
+			if err != nil {
+				return nil, err
+			}
+			name = prod.Name()
+			names[entry.ProductID().String()] = name
+		}
+
+		totalCost += entry.CostValue().Amount
+		responses = append(responses, &dto.WasteEntryResponse{
+			ID:          entry.ID().String(),
+			ProductID:   entry.ProductID().String(),
+			ProductName: name,
+			Quantity:    entry.Quantity(),
+			Reason:      string(entry.Reason()),
+			StaffMember: entry.StaffMember(),
+			Notes:       entry.Notes(),
+			UnitCost:    entry.UnitCost().Amount,
+			CostValue:   entry.CostValue().Amount,
+			RecordedAt:  entry.RecordedAt(),
+		})
+	}
+
+	return &dto.WasteListResponse{
+		Entries:   responses,
+		Total:     len(responses),
+		TotalCost: totalCost,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/webhook/dto"
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/webhook"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/google/uuid"
+)
+
+type CreateSubscriptionCommand struct {
+	repo webhook.SubscriptionRepository
+}
+
+func NewCreateSubscriptionCommand(repo webhook.SubscriptionRepository) *CreateSubscriptionCommand {
+	return &CreateSubscriptionCommand{repo: repo}
+}
+
+func (c *CreateSubscriptionCommand) Execute(req dto.CreateSubscriptionRequest) (*dto.SubscriptionResponse, error) {
+	// Generate ID and, unless one was given, the signing secret
+	id := webhook.SubscriptionID(uuid.New().String())
+
+	secret := req.Secret
+	if secret == "" {
+		generated, err := generateSecret()
+		if err != nil {
+			return nil, err
+		}
+		secret = generated
+	}
+
+	// Create subscription entity using domain factory
+	sub, err := webhook.NewSubscription(id, req.URL, secret, parseTopics(req.Topics), req.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to repository
+	if err := c.repo.Save(sub); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO; the secret is shown this once
+	response := mapSubscriptionToDTO(sub)
+	response.Secret = sub.Secret()
+	return response, nil
+}
+
+// generateSecret returns a random signing secret
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// parseTopics converts topic strings; unsupported topics are rejected by the domain
+func parseTopics(values []string) []event.Topic {
+	topics := make([]event.Topic, 0, len(values))
+	for _, value := range values {
+		topics = append(topics, event.Topic(value))
+	}
+	return topics
+}
+
+func mapSubscriptionToDTO(sub *webhook.Subscription) *dto.SubscriptionResponse {
+	topics := make([]string, 0, len(sub.Topics()))
+	for _, topic := range sub.Topics() {
+		topics = append(topics, topic.String())
+	}
+
+	return &dto.SubscriptionResponse{
+		ID:          sub.ID().String(),
+		URL:         sub.URL(),
+		Topics:      topics,
+		Description: sub.Description(),
+		Active:      sub.IsActive(),
+		CreatedAt:   sub.CreatedAt(),
+		UpdatedAt:   sub.UpdatedAt(),
+	}
+}
@@ -0,0 +1,16 @@
+package commands
+
+import "POSFlowBackend/internal/domain/webhook"
+
+type DeleteSubscriptionCommand struct {
+	repo webhook.SubscriptionRepository
+}
+
+func NewDeleteSubscriptionCommand(repo webhook.SubscriptionRepository) *DeleteSubscriptionCommand {
+	return &DeleteSubscriptionCommand{repo: repo}
+}
+
+// Execute removes a subscription and its delivery log
+func (c *DeleteSubscriptionCommand) Execute(id string) error {
+	return c.repo.Delete(webhook.SubscriptionID(id))
+}
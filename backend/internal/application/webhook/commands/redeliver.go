@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/webhook/dto"
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/webhook"
+	"encoding/json"
+)
+
+type RedeliverCommand struct {
+	webhookService *webhook.WebhookService
+	deliveryRepo   webhook.DeliveryRepository
+}
+
+func NewRedeliverCommand(webhookService *webhook.WebhookService, deliveryRepo webhook.DeliveryRepository) *RedeliverCommand {
+	return &RedeliverCommand{
+		webhookService: webhookService,
+		deliveryRepo:   deliveryRepo,
+	}
+}
+
+// Execute sends a delivery of the subscription again right away; the
+// response carries the outcome of that attempt
+func (c *RedeliverCommand) Execute(subscriptionID, deliveryID string) (*dto.DeliveryResponse, error) {
+	// Check the delivery belongs to the subscription
+	delivery, err := c.deliveryRepo.FindByID(webhook.DeliveryID(deliveryID))
+	if err != nil {
+		return nil, err
+	}
+	if delivery.SubscriptionID() != webhook.SubscriptionID(subscriptionID) {
+		return nil, shared.ErrNotFound
+	}
+
+	// Redeliver using domain service
+	delivery, err = c.webhookService.Redeliver(delivery.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapDeliveryToDTO(delivery), nil
+}
+
+func mapDeliveryToDTO(delivery *webhook.Delivery) *dto.DeliveryResponse {
+	attempts := make([]*dto.AttemptResponse, 0, len(delivery.Attempts()))
+	for _, attempt := range delivery.Attempts() {
+		attempts = append(attempts, &dto.AttemptResponse{
+			At:         attempt.At,
+			StatusCode: attempt.StatusCode,
+			Error:      attempt.Error,
+			DurationMs: attempt.Duration.Milliseconds(),
+		})
+	}
+
+	return &dto.DeliveryResponse{
+		ID:             delivery.ID().String(),
+		SubscriptionID: delivery.SubscriptionID().String(),
+		EventID:        delivery.EventID(),
+		Event:          delivery.EventName().String(),
+		Status:         string(delivery.Status()),
+		Tries:          delivery.Tries(),
+		NextAttemptAt:  delivery.NextAttemptAt(),
+		Attempts:       attempts,
+		Payload:        json.RawMessage(delivery.Payload()),
+		CreatedAt:      delivery.CreatedAt(),
+		UpdatedAt:      delivery.UpdatedAt(),
+	}
+}
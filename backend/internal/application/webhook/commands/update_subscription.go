@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"POSFlowBackend/internal/application/webhook/dto"
+	"POSFlowBackend/internal/domain/webhook"
+)
+
+type UpdateSubscriptionCommand struct {
+	repo webhook.SubscriptionRepository
+}
+
+func NewUpdateSubscriptionCommand(repo webhook.SubscriptionRepository) *UpdateSubscriptionCommand {
+	return &UpdateSubscriptionCommand{repo: repo}
+}
+
+func (c *UpdateSubscriptionCommand) Execute(id string, req dto.UpdateSubscriptionRequest) (*dto.SubscriptionResponse, error) {
+	// Find subscription
+	sub, err := c.repo.FindByID(webhook.SubscriptionID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Update URL if provided
+	if req.URL != "" {
+		if err := sub.UpdateEndpoint(req.URL); err != nil {
+			return nil, err
+		}
+	}
+
+	// Update topics if provided
+	if req.Topics != nil {
+		if err := sub.Subscribe(parseTopics(*req.Topics)); err != nil {
+			return nil, err
+		}
+	}
+
+	// Update description if provided
+	if req.Description != nil {
+		sub.Describe(*req.Description)
+	}
+
+	// Pause or resume deliveries if requested
+	if req.Active != nil {
+		if *req.Active {
+			sub.Activate()
+		} else {
+			sub.Deactivate()
+		}
+	}
+
+	// Save changes
+	if err := c.repo.Save(sub); err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapSubscriptionToDTO(sub), nil
+}
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CreateSubscriptionRequest - Input DTO for creating a webhook subscription
+type CreateSubscriptionRequest struct {
+	URL         string   `json:"url" binding:"required"`
+	Topics      []string `json:"topics" binding:"required,min=1"`
+	Secret      string   `json:"secret"` // signing secret, generated when omitted
+	Description string   `json:"description"`
+}
+
+// UpdateSubscriptionRequest - Input DTO for updating a subscription; omitted fields are kept
+type UpdateSubscriptionRequest struct {
+	URL         string    `json:"url"`
+	Topics      *[]string `json:"topics"`
+	Description *string   `json:"description"`
+	Active      *bool     `json:"active"`
+}
+
+// SubscriptionResponse - Output DTO
+type SubscriptionResponse struct {
+	ID          string    `json:"id"`
+	URL         string    `json:"url"`
+	Topics      []string  `json:"topics"`
+	Description string    `json:"description"`
+	Active      bool      `json:"active"`
+	Secret      string    `json:"secret,omitempty"` // only returned when the subscription is created
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// SubscriptionListResponse - Output DTO for list
+type SubscriptionListResponse struct {
+	Subscriptions   []*SubscriptionResponse `json:"subscriptions"`
+	Total           int                     `json:"total"`
+	SupportedTopics []string                `json:"supported_topics"`
+}
+
+// ListDeliveriesRequest - Query parameters for a subscription's delivery log
+type ListDeliveriesRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=pending succeeded failed"`
+	Limit  int    `form:"limit" binding:"omitempty,gte=1,lte=200"`
+}
+
+// DeliveryResponse - Output DTO for a delivery and its attempt log
+type DeliveryResponse struct {
+	ID             string             `json:"id"`
+	SubscriptionID string             `json:"subscription_id"`
+	EventID        uint64             `json:"event_id"`
+	Event          string             `json:"event"`
+	Status         string             `json:"status"`
+	Tries          int                `json:"tries"` // attempts since last (re)scheduled
+	NextAttemptAt  *time.Time         `json:"next_attempt_at,omitempty"`
+	Attempts       []*AttemptResponse `json:"attempts"`
+	Payload        json.RawMessage    `json:"payload"`
+	CreatedAt      time.Time          `json:"created_at"`
+	UpdatedAt      time.Time          `json:"updated_at"`
+}
+
+type AttemptResponse struct {
+	At         time.Time `json:"at"`
+	StatusCode int       `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+}
+
+// DeliveryListResponse - Output DTO for a delivery log
+type DeliveryListResponse struct {
+	Deliveries []*DeliveryResponse `json:"deliveries"`
+	Total      int                 `json:"total"`
+}
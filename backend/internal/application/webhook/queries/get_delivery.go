@@ -0,0 +1,29 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/webhook/dto"
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/webhook"
+)
+
+type GetDeliveryQuery struct {
+	repo webhook.DeliveryRepository
+}
+
+func NewGetDeliveryQuery(repo webhook.DeliveryRepository) *GetDeliveryQuery {
+	return &GetDeliveryQuery{repo: repo}
+}
+
+func (q *GetDeliveryQuery) Execute(subscriptionID, deliveryID string) (*dto.DeliveryResponse, error) {
+	// Find delivery of the subscription
+	delivery, err := q.repo.FindByID(webhook.DeliveryID(deliveryID))
+	if err != nil {
+		return nil, err
+	}
+	if delivery.SubscriptionID() != webhook.SubscriptionID(subscriptionID) {
+		return nil, shared.ErrNotFound
+	}
+
+	// Map to response DTO
+	return mapDeliveryToDTO(delivery), nil
+}
@@ -0,0 +1,42 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/webhook/dto"
+	"POSFlowBackend/internal/domain/webhook"
+)
+
+type GetSubscriptionQuery struct {
+	repo webhook.SubscriptionRepository
+}
+
+func NewGetSubscriptionQuery(repo webhook.SubscriptionRepository) *GetSubscriptionQuery {
+	return &GetSubscriptionQuery{repo: repo}
+}
+
+func (q *GetSubscriptionQuery) Execute(id string) (*dto.SubscriptionResponse, error) {
+	// Find subscription
+	sub, err := q.repo.FindByID(webhook.SubscriptionID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTO
+	return mapSubscriptionToDTO(sub), nil
+}
+
+func mapSubscriptionToDTO(sub *webhook.Subscription) *dto.SubscriptionResponse {
+	topics := make([]string, 0, len(sub.Topics()))
+	for _, topic := range sub.Topics() {
+		topics = append(topics, topic.String())
+	}
+
+	return &dto.SubscriptionResponse{
+		ID:          sub.ID().String(),
+		URL:         sub.URL(),
+		Topics:      topics,
+		Description: sub.Description(),
+		Active:      sub.IsActive(),
+		CreatedAt:   sub.CreatedAt(),
+		UpdatedAt:   sub.UpdatedAt(),
+	}
+}
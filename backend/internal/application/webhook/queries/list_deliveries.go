@@ -0,0 +1,79 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/webhook/dto"
+	"POSFlowBackend/internal/domain/webhook"
+	"encoding/json"
+)
+
+// defaultDeliveryLimit is how many deliveries are listed when no limit is given
+const defaultDeliveryLimit = 50
+
+type ListDeliveriesQuery struct {
+	subscriptionRepo webhook.SubscriptionRepository
+	deliveryRepo     webhook.DeliveryRepository
+}
+
+func NewListDeliveriesQuery(subscriptionRepo webhook.SubscriptionRepository, deliveryRepo webhook.DeliveryRepository) *ListDeliveriesQuery {
+	return &ListDeliveriesQuery{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+	}
+}
+
+// Execute returns the delivery log of a subscription, newest first
+func (q *ListDeliveriesQuery) Execute(subscriptionID string, req dto.ListDeliveriesRequest) (*dto.DeliveryListResponse, error) {
+	// Find subscription
+	sub, err := q.subscriptionRepo.FindByID(webhook.SubscriptionID(subscriptionID))
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultDeliveryLimit
+	}
+
+	// Find deliveries
+	deliveries, err := q.deliveryRepo.FindBySubscription(sub.ID(), webhook.DeliveryStatus(req.Status), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	responses := make([]*dto.DeliveryResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		responses = append(responses, mapDeliveryToDTO(delivery))
+	}
+
+	return &dto.DeliveryListResponse{
+		Deliveries: responses,
+		Total:      len(responses),
+	}, nil
+}
+
+func mapDeliveryToDTO(delivery *webhook.Delivery) *dto.DeliveryResponse {
+	attempts := make([]*dto.AttemptResponse, 0, len(delivery.Attempts()))
+	for _, attempt := range delivery.Attempts() {
+		attempts = append(attempts, &dto.AttemptResponse{
+			At:         attempt.At,
+			StatusCode: attempt.StatusCode,
+			Error:      attempt.Error,
+			DurationMs: attempt.Duration.Milliseconds(),
+		})
+	}
+
+	return &dto.DeliveryResponse{
+		ID:             delivery.ID().String(),
+		SubscriptionID: delivery.SubscriptionID().String(),
+		EventID:        delivery.EventID(),
+		Event:          delivery.EventName().String(),
+		Status:         string(delivery.Status()),
+		Tries:          delivery.Tries(),
+		NextAttemptAt:  delivery.NextAttemptAt(),
+		Attempts:       attempts,
+		Payload:        json.RawMessage(delivery.Payload()),
+		CreatedAt:      delivery.CreatedAt(),
+		UpdatedAt:      delivery.UpdatedAt(),
+	}
+}
@@ -0,0 +1,39 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/webhook/dto"
+	"POSFlowBackend/internal/domain/webhook"
+)
+
+type ListSubscriptionsQuery struct {
+	repo webhook.SubscriptionRepository
+}
+
+func NewListSubscriptionsQuery(repo webhook.SubscriptionRepository) *ListSubscriptionsQuery {
+	return &ListSubscriptionsQuery{repo: repo}
+}
+
+func (q *ListSubscriptionsQuery) Execute() (*dto.SubscriptionListResponse, error) {
+	// Find all subscriptions
+	subs, err := q.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	// Map to response DTOs
+	responses := make([]*dto.SubscriptionResponse, 0, len(subs))
+	for _, sub := range subs {
+		responses = append(responses, mapSubscriptionToDTO(sub))
+	}
+
+	topics := make([]string, 0, len(webhook.SupportedTopics))
+	for _, topic := range webhook.SupportedTopics {
+		topics = append(topics, topic.String())
+	}
+
+	return &dto.SubscriptionListResponse{
+		Subscriptions:   responses,
+		Total:           len(responses),
+		SupportedTopics: topics,
+	}, nil
+}
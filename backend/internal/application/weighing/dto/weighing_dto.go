@@ -0,0 +1,30 @@
+package dto
+
+import "time"
+
+// GetWeightRequest - Query DTO; with a product the weight is priced for an order line
+type GetWeightRequest struct {
+	ProductID string `form:"product_id"`
+}
+
+// WeightResponse - Output DTO for the current stable weight
+type WeightResponse struct {
+	Weight float64   `json:"weight"`
+	Unit   string    `json:"unit"`
+	Stable bool      `json:"stable"`
+	ReadAt time.Time `json:"read_at"`
+
+	// Set when a weighed product was given: the weight in the product's
+	// unit, to be sent as the order item quantity, and the line price
+	ProductID string   `json:"product_id,omitempty"`
+	Quantity  *float64 `json:"quantity,omitempty"`
+	SaleUnit  string   `json:"sale_unit,omitempty"`
+	UnitPrice *float64 `json:"unit_price,omitempty"`
+	LinePrice *float64 `json:"line_price,omitempty"`
+}
+
+// SimulateWeightRequest - Input DTO placing a load on the simulated scale, in kg
+type SimulateWeightRequest struct {
+	Weight *float64 `json:"weight" binding:"required"`
+	Stable *bool    `json:"stable"` // defaults to true
+}
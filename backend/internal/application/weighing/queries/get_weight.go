@@ -0,0 +1,49 @@
+package queries
+
+import (
+	"POSFlowBackend/internal/application/weighing/dto"
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/weighing"
+)
+
+type GetWeightQuery struct {
+	weighingService *weighing.WeighingService
+}
+
+func NewGetWeightQuery(weighingService *weighing.WeighingService) *GetWeightQuery {
+	return &GetWeightQuery{weighingService: weighingService}
+}
+
+func (q *GetWeightQuery) Execute(req dto.GetWeightRequest) (*dto.WeightResponse, error) {
+	// Plain stable weight
+	if req.ProductID == "" {
+		reading, err := q.weighingService.StableWeight()
+		if err != nil {
+			return nil, err
+		}
+		return mapReadingToDTO(reading), nil
+	}
+
+	// Weight priced for a weighed product
+	line, err := q.weighingService.Weigh(shared.ProductID(req.ProductID))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := mapReadingToDTO(line.Reading)
+	resp.ProductID = line.ProductID.String()
+	resp.Quantity = &line.Quantity
+	resp.SaleUnit = string(line.Unit)
+	resp.UnitPrice = &line.UnitPrice.Amount
+	resp.LinePrice = &line.LinePrice.Amount
+	return resp, nil
+}
+
+func mapReadingToDTO(reading weighing.Reading) *dto.WeightResponse {
+	return &dto.WeightResponse{
+		Weight: reading.Weight,
+		Unit:   string(reading.Unit),
+		Stable: reading.Stable,
+		ReadAt: reading.ReadAt,
+	}
+}
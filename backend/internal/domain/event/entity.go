@@ -0,0 +1,47 @@
+package event
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DomainEvent is a fact an aggregate records when its state changes. Events
+// are stored as JSON, so their fields must be exported.
+type DomainEvent interface {
+	EventName() Topic
+	AggregateID() string
+}
+
+// Recorder collects the events raised by an aggregate until its repository
+// saves them. Aggregates embed it.
+type Recorder struct {
+	events []DomainEvent
+}
+
+// Record adds an event to be saved with the aggregate
+func (r *Recorder) Record(e DomainEvent) {
+	r.events = append(r.events, e)
+}
+
+// Events returns the events recorded since the aggregate was last saved
+func (r *Recorder) Events() []DomainEvent { return r.events }
+
+// ClearEvents forgets the recorded events once they are safely stored
+func (r *Recorder) ClearEvents() {
+	r.events = nil
+}
+
+// Message is a domain event stored in the outbox, as delivered to subscribers
+type Message struct {
+	ID          uint64
+	Name        Topic
+	AggregateID string
+	Payload     json.RawMessage
+	OccurredAt  time.Time
+	Attempts    int
+}
+
+// Decode unmarshals the payload into the concrete event type
+func (m Message) Decode(v interface{}) error {
+	return json.Unmarshal(m.Payload, v)
+}
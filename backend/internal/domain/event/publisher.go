@@ -0,0 +1,7 @@
+package event
+
+// Publisher defines the interface for broadcasting events to connected clients
+type Publisher interface {
+	// Publish sends a payload on a topic without waiting for slow clients
+	Publish(topic Topic, payload interface{})
+}
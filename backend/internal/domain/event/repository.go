@@ -0,0 +1,12 @@
+package event
+
+// OutboxRepository defines the interface for reading the outbox. Events are
+// written to it by the aggregates' repositories, in the same transaction as
+// the state change that raised them.
+type OutboxRepository interface {
+	// FindUndispatched returns undelivered messages with fewer than maxAttempts
+	// failed deliveries, oldest first
+	FindUndispatched(maxAttempts, limit int) ([]Message, error)
+	MarkDispatched(id uint64) error
+	RecordFailure(id uint64, reason string) error
+}
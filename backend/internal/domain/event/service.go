@@ -0,0 +1,120 @@
+package event
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// dispatchBatchSize caps how many messages one dispatch pass delivers
+const dispatchBatchSize = 100
+
+// Handler reacts to a delivered event. Delivery is at least once, so
+// handlers must tolerate seeing the same message again after a failure.
+type Handler func(Message) error
+
+type subscription struct {
+	filter  string
+	name    string
+	handler Handler
+}
+
+// Dispatcher delivers the events in the outbox to in-process subscribers
+type Dispatcher struct {
+	outbox      OutboxRepository
+	maxAttempts int
+
+	mu            sync.RWMutex
+	subscriptions []subscription
+}
+
+func NewDispatcher(outbox OutboxRepository, maxAttempts int) *Dispatcher {
+	return &Dispatcher{
+		outbox:      outbox,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Subscribe registers a handler for the events matching a topic filter
+// (see Topic.Matches); name identifies the subscriber in logs
+func (d *Dispatcher) Subscribe(filter, name string, handler Handler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.subscriptions = append(d.subscriptions, subscription{
+		filter:  filter,
+		name:    name,
+		handler: handler,
+	})
+}
+
+// DispatchPending delivers one batch of undispatched events and returns how
+// many were delivered to every subscriber. A message whose delivery fails is
+// retried on later passes until it reaches the attempt limit.
+func (d *Dispatcher) DispatchPending() (int, error) {
+	messages, err := d.outbox.FindUndispatched(d.maxAttempts, dispatchBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	d.mu.RLock()
+	subscriptions := d.subscriptions
+	d.mu.RUnlock()
+
+	delivered := 0
+	for _, msg := range messages {
+		var failures []string
+		for _, sub := range subscriptions {
+			if !msg.Name.Matches(sub.filter) {
+				continue
+			}
+			if err := sub.deliver(msg); err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %v", sub.name, err))
+			}
+		}
+
+		if len(failures) > 0 {
+			reason := strings.Join(failures, "; ")
+			log.Printf("Error dispatching %s event %d (attempt %d): %s", msg.Name, msg.ID, msg.Attempts+1, reason)
+			if err := d.outbox.RecordFailure(msg.ID, reason); err != nil {
+				return delivered, err
+			}
+			continue
+		}
+
+		if err := d.outbox.MarkDispatched(msg.ID); err != nil {
+			return delivered, err
+		}
+		delivered++
+	}
+
+	return delivered, nil
+}
+
+// Run dispatches pending events every interval until stop is closed
+func (d *Dispatcher) Run(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if _, err := d.DispatchPending(); err != nil {
+				log.Printf("Error reading outbox: %v", err)
+			}
+		}
+	}
+}
+
+// deliver calls the handler, turning a panic into a failed delivery
+func (s subscription) deliver(msg Message) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return s.handler(msg)
+}
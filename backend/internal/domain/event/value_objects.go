@@ -0,0 +1,42 @@
+package event
+
+import "strings"
+
+// Topic names a kind of event, both for domain events and for the ones
+// broadcast to connected clients
+type Topic string
+
+const (
+	TopicOrderCreated       Topic = "order.created"
+	TopicOrderStatusChanged Topic = "order.status_changed"
+	TopicItemStatusChanged  Topic = "order.item_status_changed"
+	TopicOrderCompleted     Topic = "order.completed"
+	TopicOrderMoved         Topic = "order.moved"
+	TopicOrdersMerged       Topic = "order.merged"
+	TopicOrderSplit         Topic = "order.split"
+	TopicStockLow           Topic = "stock.low"
+	TopicStockChanged       Topic = "product.stock_changed"
+	TopicProductUpdated     Topic = "product.updated"
+	TopicDayClosed          Topic = "day.closed"
+)
+
+func (t Topic) String() string {
+	return string(t)
+}
+
+// Matches reports whether the topic is selected by a filter, which is either
+// a full topic or its leading segment ("order" selects every order.* topic)
+func (t Topic) Matches(filter string) bool {
+	return string(t) == filter || strings.HasPrefix(string(t), filter+".")
+}
+
+// ParseTopics splits a comma-separated topic filter, dropping blanks
+func ParseTopics(s string) []string {
+	var filters []string
+	for _, f := range strings.Split(s, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			filters = append(filters, f)
+		}
+	}
+	return filters
+}
@@ -0,0 +1,175 @@
+package ingredient
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"time"
+)
+
+// Ingredient is a stocked raw material consumed by product recipes
+type Ingredient struct {
+	id            IngredientID
+	name          string
+	unit          shared.UnitOfMeasure
+	stock         float64
+	lowStockLevel float64
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+func NewIngredient(
+	id IngredientID,
+	name string,
+	unit shared.UnitOfMeasure,
+	initialStock float64,
+	lowStockLevel float64,
+) (*Ingredient, error) {
+	if name == "" || !unit.IsValid() {
+		return nil, shared.ErrInvalidInput
+	}
+
+	if initialStock < 0 || lowStockLevel < 0 {
+		return nil, shared.ErrInvalidQuantity
+	}
+
+	return &Ingredient{
+		id:            id,
+		name:          name,
+		unit:          unit,
+		stock:         initialStock,
+		lowStockLevel: lowStockLevel,
+		createdAt:     time.Now(),
+		updatedAt:     time.Now(),
+	}, nil
+}
+
+// Getters
+func (i *Ingredient) ID() IngredientID           { return i.id }
+func (i *Ingredient) Name() string               { return i.name }
+func (i *Ingredient) Unit() shared.UnitOfMeasure { return i.unit }
+func (i *Ingredient) Stock() float64             { return i.stock }
+func (i *Ingredient) LowStockLevel() float64     { return i.lowStockLevel }
+func (i *Ingredient) CreatedAt() time.Time       { return i.createdAt }
+func (i *Ingredient) UpdatedAt() time.Time       { return i.updatedAt }
+
+// Business methods
+func (i *Ingredient) CanFulfill(quantity float64) bool {
+	return i.stock >= quantity
+}
+
+func (i *Ingredient) IsOutOfStock() bool {
+	return i.stock <= 0
+}
+
+func (i *Ingredient) IsLowStock() bool {
+	return i.stock <= i.lowStockLevel
+}
+
+// Consume takes the quantity out of stock
+func (i *Ingredient) Consume(quantity float64) error {
+	if quantity <= 0 {
+		return shared.ErrInvalidQuantity
+	}
+	if !i.CanFulfill(quantity) {
+		return shared.ErrInsufficientStock
+	}
+	i.stock -= quantity
+	i.updatedAt = time.Now()
+	return nil
+}
+
+// Restock adds the quantity to stock
+func (i *Ingredient) Restock(quantity float64) error {
+	if quantity <= 0 {
+		return shared.ErrInvalidQuantity
+	}
+	i.stock += quantity
+	i.updatedAt = time.Now()
+	return nil
+}
+
+func (i *Ingredient) UpdateInfo(name string, unit shared.UnitOfMeasure, lowStockLevel float64) error {
+	if name == "" || !unit.IsValid() {
+		return shared.ErrInvalidInput
+	}
+	if lowStockLevel < 0 {
+		return shared.ErrInvalidQuantity
+	}
+
+	i.name = name
+	i.unit = unit
+	i.lowStockLevel = lowStockLevel
+	i.updatedAt = time.Now()
+	return nil
+}
+
+func ReconstructIngredient(
+	id IngredientID,
+	name string,
+	unit shared.UnitOfMeasure,
+	stock float64,
+	lowStockLevel float64,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Ingredient {
+	return &Ingredient{
+		id:            id,
+		name:          name,
+		unit:          unit,
+		stock:         stock,
+		lowStockLevel: lowStockLevel,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
+// Recipe lists the ingredients consumed by one unit of a sellable product
+type Recipe struct {
+	productID shared.ProductID
+	lines     []RecipeLine
+	updatedAt time.Time
+}
+
+func NewRecipe(productID shared.ProductID, lines []RecipeLine) (*Recipe, error) {
+	if len(lines) == 0 {
+		return nil, shared.ErrInvalidInput
+	}
+
+	seen := map[IngredientID]bool{}
+	for _, line := range lines {
+		if line.Quantity <= 0 {
+			return nil, shared.ErrInvalidQuantity
+		}
+		if seen[line.IngredientID] {
+			return nil, shared.ErrInvalidInput // Ingredient listed twice
+		}
+		seen[line.IngredientID] = true
+	}
+
+	return &Recipe{
+		productID: productID,
+		lines:     lines,
+		updatedAt: time.Now(),
+	}, nil
+}
+
+// Getters
+func (r *Recipe) ProductID() shared.ProductID { return r.productID }
+func (r *Recipe) Lines() []RecipeLine         { return r.lines }
+func (r *Recipe) UpdatedAt() time.Time        { return r.updatedAt }
+
+// Requirements returns the ingredient quantities needed to make quantity units
+func (r *Recipe) Requirements(quantity float64) map[IngredientID]float64 {
+	requirements := map[IngredientID]float64{}
+	for _, line := range r.lines {
+		requirements[line.IngredientID] += line.Quantity * quantity
+	}
+	return requirements
+}
+
+func ReconstructRecipe(productID shared.ProductID, lines []RecipeLine, updatedAt time.Time) *Recipe {
+	return &Recipe{
+		productID: productID,
+		lines:     lines,
+		updatedAt: updatedAt,
+	}
+}
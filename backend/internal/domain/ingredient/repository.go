@@ -0,0 +1,20 @@
+package ingredient
+
+import "POSFlowBackend/internal/domain/shared"
+
+// IngredientRepository defines the interface for ingredient persistence
+type IngredientRepository interface {
+	Save(ingredient *Ingredient) error
+	FindByID(id IngredientID) (*Ingredient, error)
+	FindAll() ([]*Ingredient, error)
+	FindLowStock() ([]*Ingredient, error)
+}
+
+// RecipeRepository defines the interface for recipe persistence
+type RecipeRepository interface {
+	Save(recipe *Recipe) error
+	// FindByProduct returns shared.ErrNotFound when the product has no recipe
+	FindByProduct(productID shared.ProductID) (*Recipe, error)
+	FindByIngredient(ingredientID IngredientID) ([]*Recipe, error)
+	Delete(productID shared.ProductID) error
+}
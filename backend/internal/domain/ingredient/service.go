@@ -0,0 +1,138 @@
+package ingredient
+
+import (
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"errors"
+)
+
+// InventoryService contains domain logic for ingredient stock and recipes
+type InventoryService struct {
+	ingredientRepo IngredientRepository
+	recipeRepo     RecipeRepository
+	productRepo    product.ProductRepository
+}
+
+func NewInventoryService(
+	ingredientRepo IngredientRepository,
+	recipeRepo RecipeRepository,
+	productRepo product.ProductRepository,
+) *InventoryService {
+	return &InventoryService{
+		ingredientRepo: ingredientRepo,
+		recipeRepo:     recipeRepo,
+		productRepo:    productRepo,
+	}
+}
+
+// FindRecipe returns the recipe of a product, or nil when it has none
+func (s *InventoryService) FindRecipe(productID shared.ProductID) (*Recipe, error) {
+	recipe, err := s.recipeRepo.FindByProduct(productID)
+	if errors.Is(err, shared.ErrNotFound) {
+		return nil, nil
+	}
+	return recipe, err
+}
+
+// CheckAvailability verifies every ingredient requirement can be fulfilled
+func (s *InventoryService) CheckAvailability(requirements map[IngredientID]float64) error {
+	for id, quantity := range requirements {
+		ing, err := s.ingredientRepo.FindByID(id)
+		if err != nil {
+			return err
+		}
+		if !ing.CanFulfill(quantity) {
+			return shared.ErrInsufficientStock
+		}
+	}
+	return nil
+}
+
+// Consume takes the required ingredient quantities out of stock and
+// refreshes the availability of the products that use them.
+// Nothing is consumed unless every requirement can be fulfilled.
+func (s *InventoryService) Consume(requirements map[IngredientID]float64) error {
+	var ingredients []*Ingredient
+
+	for id, quantity := range requirements {
+		ing, err := s.ingredientRepo.FindByID(id)
+		if err != nil {
+			return err
+		}
+		if err := ing.Consume(quantity); err != nil {
+			return err
+		}
+		ingredients = append(ingredients, ing)
+	}
+
+	var ids []IngredientID
+	for _, ing := range ingredients {
+		if err := s.ingredientRepo.Save(ing); err != nil {
+			return err
+		}
+		ids = append(ids, ing.ID())
+	}
+
+	return s.RefreshAvailability(ids)
+}
+
+// RefreshAvailability marks products unavailable when any ingredient of their
+// recipe cannot cover one more unit, and available again once all of them can
+func (s *InventoryService) RefreshAvailability(ingredientIDs []IngredientID) error {
+	refreshed := map[shared.ProductID]bool{}
+
+	for _, id := range ingredientIDs {
+		recipes, err := s.recipeRepo.FindByIngredient(id)
+		if err != nil {
+			return err
+		}
+
+		for _, recipe := range recipes {
+			if refreshed[recipe.ProductID()] {
+				continue
+			}
+			refreshed[recipe.ProductID()] = true
+
+			if err := s.RefreshProductAvailability(recipe); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// RefreshProductAvailability updates the availability of the recipe's product
+func (s *InventoryService) RefreshProductAvailability(recipe *Recipe) error {
+	prod, err := s.productRepo.FindByID(recipe.ProductID())
+	if errors.Is(err, shared.ErrNotFound) {
+		return nil // Archived products are left untouched
+	}
+	if err != nil {
+		return err
+	}
+
+	available := true
+	for _, line := range recipe.Lines() {
+		ing, err := s.ingredientRepo.FindByID(line.IngredientID)
+		if err != nil {
+			return err
+		}
+		if !ing.CanFulfill(line.Quantity) {
+			available = false
+			break
+		}
+	}
+
+	if available == prod.IsAvailable() {
+		return nil
+	}
+
+	if available {
+		prod.MarkAvailable()
+	} else {
+		prod.MarkUnavailable()
+	}
+
+	return s.productRepo.Save(prod)
+}
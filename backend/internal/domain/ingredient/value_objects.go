@@ -0,0 +1,14 @@
+package ingredient
+
+type IngredientID string
+
+func (i IngredientID) String() string {
+	return string(i)
+}
+
+// RecipeLine is the quantity of one ingredient used to make one unit of a product,
+// expressed in the ingredient's unit of measure
+type RecipeLine struct {
+	IngredientID IngredientID
+	Quantity     float64
+}
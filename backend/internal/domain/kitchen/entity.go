@@ -0,0 +1,125 @@
+package kitchen
+
+import (
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"strings"
+	"time"
+)
+
+// Station is a kitchen or bar station, such as the grill or the fryer,
+// that prepares the order items routed to it. Items are routed by product,
+// or failing that by product category.
+type Station struct {
+	id         StationID
+	name       string
+	products   []shared.ProductID
+	categories []product.Category
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+func NewStation(id StationID, name string, products []shared.ProductID, categories []product.Category) (*Station, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, shared.ErrInvalidInput
+	}
+
+	station := &Station{
+		id:        id,
+		name:      name,
+		createdAt: time.Now(),
+		updatedAt: time.Now(),
+	}
+
+	if err := station.AssignRouting(products, categories); err != nil {
+		return nil, err
+	}
+
+	return station, nil
+}
+
+// Getters
+func (s *Station) ID() StationID                  { return s.id }
+func (s *Station) Name() string                   { return s.name }
+func (s *Station) Products() []shared.ProductID   { return s.products }
+func (s *Station) Categories() []product.Category { return s.categories }
+func (s *Station) CreatedAt() time.Time           { return s.createdAt }
+func (s *Station) UpdatedAt() time.Time           { return s.updatedAt }
+
+// Business methods
+func (s *Station) Rename(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return shared.ErrInvalidInput
+	}
+	s.name = name
+	s.updatedAt = time.Now()
+	return nil
+}
+
+// AssignRouting sets the products and product categories prepared at this station
+func (s *Station) AssignRouting(products []shared.ProductID, categories []product.Category) error {
+	cleanProducts := []shared.ProductID{}
+	seen := make(map[shared.ProductID]bool)
+	for _, productID := range products {
+		if strings.TrimSpace(productID.String()) == "" {
+			return shared.ErrInvalidInput
+		}
+		if !seen[productID] {
+			seen[productID] = true
+			cleanProducts = append(cleanProducts, productID)
+		}
+	}
+
+	cleanCategories := []product.Category{}
+	seenCategories := make(map[product.Category]bool)
+	for _, category := range categories {
+		if !category.IsValid() {
+			return shared.ErrInvalidInput
+		}
+		if !seenCategories[category] {
+			seenCategories[category] = true
+			cleanCategories = append(cleanCategories, category)
+		}
+	}
+
+	s.products = cleanProducts
+	s.categories = cleanCategories
+	s.updatedAt = time.Now()
+	return nil
+}
+
+func (s *Station) PreparesProduct(productID shared.ProductID) bool {
+	for _, p := range s.products {
+		if p == productID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Station) PreparesCategory(category product.Category) bool {
+	for _, c := range s.categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+func ReconstructStation(
+	id StationID,
+	name string,
+	products []shared.ProductID,
+	categories []product.Category,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Station {
+	return &Station{
+		id:         id,
+		name:       name,
+		products:   products,
+		categories: categories,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+}
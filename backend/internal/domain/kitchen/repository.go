@@ -0,0 +1,8 @@
+package kitchen
+
+// StationRepository defines the interface for kitchen station persistence
+type StationRepository interface {
+	Save(station *Station) error
+	FindByID(id StationID) (*Station, error)
+	FindAll() ([]*Station, error)
+}
@@ -0,0 +1,38 @@
+package kitchen
+
+import (
+	"POSFlowBackend/internal/domain/product"
+)
+
+// RoutingService decides which station prepares an order item
+type RoutingService struct {
+	stationRepo StationRepository
+}
+
+func NewRoutingService(stationRepo StationRepository) *RoutingService {
+	return &RoutingService{stationRepo: stationRepo}
+}
+
+// ResolveStation picks the station assigned the product itself, then the
+// station assigned its category. It returns nil when no station prepares
+// the product, e.g. for bottled drinks handed out at the counter.
+func (s *RoutingService) ResolveStation(prod *product.Product) (*Station, error) {
+	stations, err := s.stationRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, station := range stations {
+		if station.PreparesProduct(prod.ID()) {
+			return station, nil
+		}
+	}
+
+	for _, station := range stations {
+		if station.PreparesCategory(prod.Category()) {
+			return station, nil
+		}
+	}
+
+	return nil, nil
+}
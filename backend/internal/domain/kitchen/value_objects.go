@@ -0,0 +1,7 @@
+package kitchen
+
+type StationID string
+
+func (s StationID) String() string {
+	return string(s)
+}
@@ -0,0 +1,289 @@
+package location
+
+import (
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"strings"
+	"time"
+)
+
+// Location is a place where stock is stored and sold from, such as the
+// main kitchen or the bar. The default location holds every unit of a
+// product's stock that is not assigned to another location.
+type Location struct {
+	id         LocationID
+	name       string
+	isDefault  bool
+	terminals  []string
+	categories []product.Category
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+func NewLocation(id LocationID, name string, terminals []string, categories []product.Category) (*Location, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, shared.ErrInvalidInput
+	}
+
+	loc := &Location{
+		id:        id,
+		name:      name,
+		createdAt: time.Now(),
+		updatedAt: time.Now(),
+	}
+
+	if err := loc.AssignRouting(terminals, categories); err != nil {
+		return nil, err
+	}
+
+	return loc, nil
+}
+
+// NewDefaultLocation creates the location that holds unassigned stock
+func NewDefaultLocation(id LocationID, name string) (*Location, error) {
+	loc, err := NewLocation(id, name, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	loc.isDefault = true
+	return loc, nil
+}
+
+// Getters
+func (l *Location) ID() LocationID                 { return l.id }
+func (l *Location) Name() string                   { return l.name }
+func (l *Location) IsDefault() bool                { return l.isDefault }
+func (l *Location) Terminals() []string            { return l.terminals }
+func (l *Location) Categories() []product.Category { return l.categories }
+func (l *Location) CreatedAt() time.Time           { return l.createdAt }
+func (l *Location) UpdatedAt() time.Time           { return l.updatedAt }
+
+// Business methods
+func (l *Location) Rename(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return shared.ErrInvalidInput
+	}
+	l.name = name
+	l.updatedAt = time.Now()
+	return nil
+}
+
+// AssignRouting sets the terminals and product categories that sell from this location
+func (l *Location) AssignRouting(terminals []string, categories []product.Category) error {
+	cleanTerminals := []string{}
+	seen := make(map[string]bool)
+	for _, terminal := range terminals {
+		terminal = strings.TrimSpace(terminal)
+		if terminal == "" {
+			return shared.ErrInvalidInput
+		}
+		if !seen[terminal] {
+			seen[terminal] = true
+			cleanTerminals = append(cleanTerminals, terminal)
+		}
+	}
+
+	cleanCategories := []product.Category{}
+	seenCategories := make(map[product.Category]bool)
+	for _, category := range categories {
+		if !category.IsValid() {
+			return shared.ErrInvalidInput
+		}
+		if !seenCategories[category] {
+			seenCategories[category] = true
+			cleanCategories = append(cleanCategories, category)
+		}
+	}
+
+	l.terminals = cleanTerminals
+	l.categories = cleanCategories
+	l.updatedAt = time.Now()
+	return nil
+}
+
+func (l *Location) ServesTerminal(terminalID string) bool {
+	for _, terminal := range l.terminals {
+		if terminal == terminalID {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *Location) ServesCategory(category product.Category) bool {
+	for _, c := range l.categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+func ReconstructLocation(
+	id LocationID,
+	name string,
+	isDefault bool,
+	terminals []string,
+	categories []product.Category,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Location {
+	return &Location{
+		id:         id,
+		name:       name,
+		isDefault:  isDefault,
+		terminals:  terminals,
+		categories: categories,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+// StockLevel is the stock of a product held at a non-default location
+type StockLevel struct {
+	locationID    LocationID
+	productID     shared.ProductID
+	quantity      float64
+	lowStockLevel float64
+	updatedAt     time.Time
+}
+
+func NewStockLevel(locationID LocationID, productID shared.ProductID, lowStockLevel float64) *StockLevel {
+	return &StockLevel{
+		locationID:    locationID,
+		productID:     productID,
+		lowStockLevel: lowStockLevel,
+		updatedAt:     time.Now(),
+	}
+}
+
+// Getters
+func (s *StockLevel) LocationID() LocationID      { return s.locationID }
+func (s *StockLevel) ProductID() shared.ProductID { return s.productID }
+func (s *StockLevel) Quantity() float64           { return s.quantity }
+func (s *StockLevel) LowStockLevel() float64      { return s.lowStockLevel }
+func (s *StockLevel) UpdatedAt() time.Time        { return s.updatedAt }
+
+// Business methods
+func (s *StockLevel) Increase(quantity float64) error {
+	if quantity <= 0 {
+		return shared.ErrInvalidQuantity
+	}
+	s.quantity = shared.RoundQuantity(s.quantity + quantity)
+	s.updatedAt = time.Now()
+	return nil
+}
+
+func (s *StockLevel) Decrease(quantity float64) error {
+	if quantity <= 0 {
+		return shared.ErrInvalidQuantity
+	}
+	if quantity > s.quantity {
+		return shared.ErrInsufficientStock
+	}
+	s.quantity = shared.RoundQuantity(s.quantity - quantity)
+	s.updatedAt = time.Now()
+	return nil
+}
+
+func (s *StockLevel) SetLowStockLevel(level float64) error {
+	if level < 0 {
+		return shared.ErrInvalidQuantity
+	}
+	s.lowStockLevel = level
+	s.updatedAt = time.Now()
+	return nil
+}
+
+func ReconstructStockLevel(
+	locationID LocationID,
+	productID shared.ProductID,
+	quantity float64,
+	lowStockLevel float64,
+	updatedAt time.Time,
+) *StockLevel {
+	return &StockLevel{
+		locationID:    locationID,
+		productID:     productID,
+		quantity:      quantity,
+		lowStockLevel: lowStockLevel,
+		updatedAt:     updatedAt,
+	}
+}
+
+// Transfer is a document recording stock moved from one location to another
+type Transfer struct {
+	id             TransferID
+	fromLocationID LocationID
+	toLocationID   LocationID
+	lines          []TransferLine
+	note           string
+	createdBy      string
+	createdAt      time.Time
+}
+
+func NewTransfer(
+	id TransferID,
+	fromLocationID LocationID,
+	toLocationID LocationID,
+	lines []TransferLine,
+	note string,
+	createdBy string,
+) (*Transfer, error) {
+	if fromLocationID == "" || toLocationID == "" || fromLocationID == toLocationID {
+		return nil, shared.ErrInvalidInput
+	}
+	if len(lines) == 0 {
+		return nil, shared.ErrInvalidInput
+	}
+
+	seen := make(map[shared.ProductID]bool)
+	for _, line := range lines {
+		if line.Quantity <= 0 {
+			return nil, shared.ErrInvalidQuantity
+		}
+		if seen[line.ProductID] {
+			return nil, shared.ErrInvalidInput
+		}
+		seen[line.ProductID] = true
+	}
+
+	return &Transfer{
+		id:             id,
+		fromLocationID: fromLocationID,
+		toLocationID:   toLocationID,
+		lines:          lines,
+		note:           note,
+		createdBy:      createdBy,
+		createdAt:      time.Now(),
+	}, nil
+}
+
+// Getters
+func (t *Transfer) ID() TransferID             { return t.id }
+func (t *Transfer) FromLocationID() LocationID { return t.fromLocationID }
+func (t *Transfer) ToLocationID() LocationID   { return t.toLocationID }
+func (t *Transfer) Lines() []TransferLine      { return t.lines }
+func (t *Transfer) Note() string               { return t.note }
+func (t *Transfer) CreatedBy() string          { return t.createdBy }
+func (t *Transfer) CreatedAt() time.Time       { return t.createdAt }
+
+func ReconstructTransfer(
+	id TransferID,
+	fromLocationID LocationID,
+	toLocationID LocationID,
+	lines []TransferLine,
+	note string,
+	createdBy string,
+	createdAt time.Time,
+) *Transfer {
+	return &Transfer{
+		id:             id,
+		fromLocationID: fromLocationID,
+		toLocationID:   toLocationID,
+		lines:          lines,
+		note:           note,
+		createdBy:      createdBy,
+		createdAt:      createdAt,
+	}
+}
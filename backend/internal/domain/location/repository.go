@@ -0,0 +1,47 @@
+package location
+
+import (
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+// LocationRepository defines the interface for location persistence
+type LocationRepository interface {
+	Save(location *Location) error
+	FindByID(id LocationID) (*Location, error)
+	FindAll() ([]*Location, error)
+	// FindDefault returns shared.ErrNotFound until a default location exists
+	FindDefault() (*Location, error)
+}
+
+// StockLevelRepository defines the interface for per-location stock persistence
+type StockLevelRepository interface {
+	Save(level *StockLevel) error
+	// Find returns shared.ErrNotFound when the product has no stock level at the location
+	Find(locationID LocationID, productID shared.ProductID) (*StockLevel, error)
+	FindByLocation(locationID LocationID) ([]*StockLevel, error)
+	FindByProduct(productID shared.ProductID) ([]*StockLevel, error)
+}
+
+// TransferRepository defines the interface for transfer document persistence
+type TransferRepository interface {
+	Save(transfer *Transfer) error
+	FindByID(id TransferID) (*Transfer, error)
+	FindAll() ([]*Transfer, error)
+	FindByLocation(locationID LocationID) ([]*Transfer, error)
+}
+
+// Stores are the repositories stock is moved through, all bound to the same
+// transaction
+type Stores struct {
+	Locations   LocationRepository
+	StockLevels StockLevelRepository
+	Transfers   TransferRepository
+	Products    product.ProductRepository
+}
+
+// UnitOfWork runs fn in a single transaction, committed only when fn
+// returns nil
+type UnitOfWork interface {
+	Do(fn func(stores Stores) error) error
+}
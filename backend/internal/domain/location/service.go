@@ -0,0 +1,481 @@
+package location
+
+import (
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"errors"
+)
+
+// allocationKey identifies the stock of a product at a location
+type allocationKey struct {
+	locationID LocationID
+	productID  shared.ProductID
+}
+
+// StockService contains domain logic for stock held across locations.
+// A product's stock is its total across all locations. Other locations hold
+// explicit stock levels; the default location holds the remainder, so code
+// that only knows about product totals keeps working against it.
+type StockService struct {
+	locationRepo LocationRepository
+	levelRepo    StockLevelRepository
+	transferRepo TransferRepository
+	productRepo  product.ProductRepository
+}
+
+func NewStockService(
+	locationRepo LocationRepository,
+	levelRepo StockLevelRepository,
+	transferRepo TransferRepository,
+	productRepo product.ProductRepository,
+) *StockService {
+	return &StockService{
+		locationRepo: locationRepo,
+		levelRepo:    levelRepo,
+		transferRepo: transferRepo,
+		productRepo:  productRepo,
+	}
+}
+
+// EnsureDefault creates the default location when it does not exist yet
+func (s *StockService) EnsureDefault(id LocationID, name string) (*Location, error) {
+	loc, err := s.locationRepo.FindDefault()
+	if err == nil {
+		return loc, nil
+	}
+	if !errors.Is(err, shared.ErrNotFound) {
+		return nil, err
+	}
+
+	loc, err = NewDefaultLocation(id, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.locationRepo.Save(loc); err != nil {
+		return nil, err
+	}
+
+	return loc, nil
+}
+
+// ResolveLocation picks the location an item is sold from: the location
+// serving the terminal, unless it is limited to other categories, then the
+// location serving the product category, and otherwise the default location
+func (s *StockService) ResolveLocation(terminalID string, category product.Category) (*Location, error) {
+	locations, err := s.locationRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if terminalID != "" {
+		for _, loc := range locations {
+			if loc.ServesTerminal(terminalID) && (len(loc.Categories()) == 0 || loc.ServesCategory(category)) {
+				return loc, nil
+			}
+		}
+	}
+
+	for _, loc := range locations {
+		if loc.ServesCategory(category) {
+			return loc, nil
+		}
+	}
+
+	return s.locationRepo.FindDefault()
+}
+
+// LocationOrDefault returns the location with the given ID, or the default
+// location when no ID is given
+func (s *StockService) LocationOrDefault(id LocationID) (*Location, error) {
+	if id == "" {
+		return s.locationRepo.FindDefault()
+	}
+	return s.locationRepo.FindByID(id)
+}
+
+// Available returns the quantity of a product held at a location
+func (s *StockService) Available(loc *Location, prod *product.Product) (float64, error) {
+	if !loc.IsDefault() {
+		level, err := s.levelRepo.Find(loc.ID(), prod.ID())
+		if errors.Is(err, shared.ErrNotFound) {
+			return 0, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		return level.Quantity(), nil
+	}
+
+	levels, err := s.levelRepo.FindByProduct(prod.ID())
+	if err != nil {
+		return 0, err
+	}
+
+	return remainder(prod, levels), nil
+}
+
+// CheckAvailability verifies every allocation can be taken from its location.
+// Product stock must not have been decreased for the allocations yet.
+func (s *StockService) CheckAvailability(allocations []Allocation) error {
+	required := make(map[allocationKey]float64)
+	for _, a := range allocations {
+		key := allocationKey{a.LocationID, a.ProductID}
+		required[key] = shared.RoundQuantity(required[key] + a.Quantity)
+	}
+
+	for key, quantity := range required {
+		loc, err := s.locationRepo.FindByID(key.locationID)
+		if err != nil {
+			return err
+		}
+		prod, err := s.productRepo.FindByID(key.productID)
+		if err != nil {
+			return err
+		}
+		available, err := s.Available(loc, prod)
+		if err != nil {
+			return err
+		}
+		if quantity > available {
+			return shared.ErrInsufficientStock
+		}
+	}
+
+	return nil
+}
+
+// Withdraw takes allocated quantities out of their locations' stock levels.
+// Allocations from the default location need no change beyond the product total.
+func (s *StockService) Withdraw(allocations []Allocation) error {
+	defaultLoc, err := s.locationRepo.FindDefault()
+	if err != nil {
+		return err
+	}
+
+	for _, a := range allocations {
+		if a.LocationID == defaultLoc.ID() {
+			continue
+		}
+		level, err := s.levelRepo.Find(a.LocationID, a.ProductID)
+		if err != nil {
+			return err
+		}
+		if err := level.Decrease(a.Quantity); err != nil {
+			return err
+		}
+		if err := s.levelRepo.Save(level); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Deposit adds allocated quantities to their locations' stock levels once
+// product stock has been increased by them. Allocations to the default
+// location need no change beyond the product total.
+func (s *StockService) Deposit(allocations []Allocation) error {
+	defaultLoc, err := s.locationRepo.FindDefault()
+	if err != nil {
+		return err
+	}
+
+	for _, a := range allocations {
+		if a.LocationID == defaultLoc.ID() {
+			continue
+		}
+		prod, err := s.productRepo.FindByIDIncludingArchived(a.ProductID)
+		if err != nil {
+			return err
+		}
+		level, err := s.findOrNewLevel(a.LocationID, prod)
+		if err != nil {
+			return err
+		}
+		if err := level.Increase(a.Quantity); err != nil {
+			return err
+		}
+		if err := s.levelRepo.Save(level); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TransferService moves stock between locations
+type TransferService struct {
+	uow UnitOfWork
+}
+
+func NewTransferService(uow UnitOfWork) *TransferService {
+	return &TransferService{uow: uow}
+}
+
+// Transfer moves stock between two locations and records the transfer
+// document. The stock levels and the document are saved in one transaction,
+// so nothing is moved unless every line is available at the source location.
+func (s *TransferService) Transfer(
+	id TransferID,
+	fromID LocationID,
+	toID LocationID,
+	lines []TransferLine,
+	note string,
+	createdBy string,
+) (*Transfer, error) {
+	var transfer *Transfer
+	err := s.uow.Do(func(stores Stores) error {
+		stock := NewStockService(stores.Locations, stores.StockLevels, stores.Transfers, stores.Products)
+		var err error
+		transfer, err = stock.transfer(id, fromID, toID, lines, note, createdBy)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// transfer moves stock between two locations and records the transfer document
+func (s *StockService) transfer(
+	id TransferID,
+	fromID LocationID,
+	toID LocationID,
+	lines []TransferLine,
+	note string,
+	createdBy string,
+) (*Transfer, error) {
+	transfer, err := NewTransfer(id, fromID, toID, lines, note, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := s.locationRepo.FindByID(fromID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.locationRepo.FindByID(toID)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []*StockLevel
+	for _, line := range lines {
+		prod, err := s.productRepo.FindByID(line.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		if err := prod.ValidateQuantity(line.Quantity); err != nil {
+			return nil, err
+		}
+
+		available, err := s.Available(from, prod)
+		if err != nil {
+			return nil, err
+		}
+		if line.Quantity > available {
+			return nil, shared.ErrInsufficientStock
+		}
+
+		if !from.IsDefault() {
+			level, err := s.levelRepo.Find(from.ID(), prod.ID())
+			if err != nil {
+				return nil, err
+			}
+			if err := level.Decrease(line.Quantity); err != nil {
+				return nil, err
+			}
+			changed = append(changed, level)
+		}
+
+		if !to.IsDefault() {
+			level, err := s.findOrNewLevel(to.ID(), prod)
+			if err != nil {
+				return nil, err
+			}
+			if err := level.Increase(line.Quantity); err != nil {
+				return nil, err
+			}
+			changed = append(changed, level)
+		}
+	}
+
+	// Save changes
+	for _, level := range changed {
+		if err := s.levelRepo.Save(level); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.transferRepo.Save(transfer); err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// SetLowStockLevel sets the low stock threshold of a product at a non-default location.
+// The default location uses the product's own threshold.
+func (s *StockService) SetLowStockLevel(locationID LocationID, productID shared.ProductID, level float64) (*StockPosition, error) {
+	loc, err := s.locationRepo.FindByID(locationID)
+	if err != nil {
+		return nil, err
+	}
+	if loc.IsDefault() {
+		return nil, shared.ErrInvalidInput
+	}
+
+	prod, err := s.productRepo.FindByID(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	stockLevel, err := s.findOrNewLevel(loc.ID(), prod)
+	if err != nil {
+		return nil, err
+	}
+	if err := stockLevel.SetLowStockLevel(level); err != nil {
+		return nil, err
+	}
+
+	if err := s.levelRepo.Save(stockLevel); err != nil {
+		return nil, err
+	}
+
+	return &StockPosition{
+		LocationID:    loc.ID(),
+		Product:       prod,
+		Quantity:      stockLevel.Quantity(),
+		LowStockLevel: stockLevel.LowStockLevel(),
+	}, nil
+}
+
+// GetPositions returns the stock of every product held at a location
+func (s *StockService) GetPositions(locationID LocationID) ([]StockPosition, error) {
+	loc, err := s.locationRepo.FindByID(locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if loc.IsDefault() {
+		products, err := s.productRepo.FindAll()
+		if err != nil {
+			return nil, err
+		}
+
+		positions := make([]StockPosition, 0, len(products))
+		for _, prod := range products {
+			levels, err := s.levelRepo.FindByProduct(prod.ID())
+			if err != nil {
+				return nil, err
+			}
+			positions = append(positions, StockPosition{
+				LocationID:    loc.ID(),
+				Product:       prod,
+				Quantity:      remainder(prod, levels),
+				LowStockLevel: prod.LowStockLevel(),
+			})
+		}
+		return positions, nil
+	}
+
+	levels, err := s.levelRepo.FindByLocation(loc.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([]StockPosition, 0, len(levels))
+	for _, level := range levels {
+		prod, err := s.productRepo.FindByID(level.ProductID())
+		if errors.Is(err, shared.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, StockPosition{
+			LocationID:    loc.ID(),
+			Product:       prod,
+			Quantity:      level.Quantity(),
+			LowStockLevel: level.LowStockLevel(),
+		})
+	}
+	return positions, nil
+}
+
+// GetLowStock returns the products at or below their low stock threshold at a location
+func (s *StockService) GetLowStock(locationID LocationID) ([]StockPosition, error) {
+	positions, err := s.GetPositions(locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var low []StockPosition
+	for _, position := range positions {
+		if position.IsLowStock() {
+			low = append(low, position)
+		}
+	}
+	return low, nil
+}
+
+// GetProductPositions returns the stock of a product at every location
+func (s *StockService) GetProductPositions(productID shared.ProductID) ([]StockPosition, error) {
+	prod, err := s.productRepo.FindByID(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	locations, err := s.locationRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	levels, err := s.levelRepo.FindByProduct(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	byLocation := make(map[LocationID]*StockLevel)
+	for _, level := range levels {
+		byLocation[level.LocationID()] = level
+	}
+
+	positions := make([]StockPosition, 0, len(locations))
+	for _, loc := range locations {
+		position := StockPosition{LocationID: loc.ID(), Product: prod}
+		if loc.IsDefault() {
+			position.Quantity = remainder(prod, levels)
+			position.LowStockLevel = prod.LowStockLevel()
+		} else if level, ok := byLocation[loc.ID()]; ok {
+			position.Quantity = level.Quantity()
+			position.LowStockLevel = level.LowStockLevel()
+		} else {
+			position.LowStockLevel = prod.LowStockLevel()
+		}
+		positions = append(positions, position)
+	}
+	return positions, nil
+}
+
+func (s *StockService) findOrNewLevel(locationID LocationID, prod *product.Product) (*StockLevel, error) {
+	level, err := s.levelRepo.Find(locationID, prod.ID())
+	if errors.Is(err, shared.ErrNotFound) {
+		return NewStockLevel(locationID, prod.ID(), prod.LowStockLevel()), nil
+	}
+	return level, err
+}
+
+// remainder returns the part of a product's total stock not held at other locations
+func remainder(prod *product.Product, levels []*StockLevel) float64 {
+	quantity := prod.Stock()
+	for _, level := range levels {
+		quantity = shared.RoundQuantity(quantity - level.Quantity())
+	}
+	if quantity < 0 {
+		return 0
+	}
+	return quantity
+}
@@ -0,0 +1,43 @@
+package location
+
+import (
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+type LocationID string
+
+func (l LocationID) String() string {
+	return string(l)
+}
+
+type TransferID string
+
+func (t TransferID) String() string {
+	return string(t)
+}
+
+// TransferLine is a quantity of a product moved between locations
+type TransferLine struct {
+	ProductID shared.ProductID
+	Quantity  float64
+}
+
+// Allocation is a quantity of a product taken from a location
+type Allocation struct {
+	LocationID LocationID
+	ProductID  shared.ProductID
+	Quantity   float64
+}
+
+// StockPosition is the stock of a product held at one location
+type StockPosition struct {
+	LocationID    LocationID
+	Product       *product.Product
+	Quantity      float64
+	LowStockLevel float64
+}
+
+func (p StockPosition) IsLowStock() bool {
+	return p.Quantity <= p.LowStockLevel
+}
@@ -0,0 +1,124 @@
+package mail
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"net/mail"
+	"time"
+)
+
+// Email is a message in the outgoing queue. Emails are retried while the
+// mail server is unreachable or refuses them, and end up failed once the
+// attempts run out, until staff retry them by hand.
+type Email struct {
+	id            EmailID
+	orderID       shared.OrderID
+	message       Message
+	status        Status
+	attempts      int
+	lastError     string
+	nextAttemptAt *time.Time
+	sentAt        *time.Time
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+// NewEmail queues a composed message for immediate sending
+func NewEmail(id EmailID, orderID shared.OrderID, message Message) (*Email, error) {
+	if _, err := mail.ParseAddress(message.To); err != nil {
+		return nil, fmt.Errorf("%w: invalid recipient %q", shared.ErrInvalidInput, message.To)
+	}
+	if message.Subject == "" || (message.TextBody == "" && message.HTMLBody == "") {
+		return nil, fmt.Errorf("%w: an email needs a subject and a body", shared.ErrInvalidInput)
+	}
+
+	now := time.Now()
+	return &Email{
+		id:            id,
+		orderID:       orderID,
+		message:       message,
+		status:        StatusPending,
+		nextAttemptAt: &now,
+		createdAt:     now,
+		updatedAt:     now,
+	}, nil
+}
+
+// Getters
+func (e *Email) ID() EmailID               { return e.id }
+func (e *Email) OrderID() shared.OrderID   { return e.orderID }
+func (e *Email) Message() Message          { return e.message }
+func (e *Email) Status() Status            { return e.status }
+func (e *Email) Attempts() int             { return e.attempts }
+func (e *Email) LastError() string         { return e.lastError }
+func (e *Email) NextAttemptAt() *time.Time { return e.nextAttemptAt }
+func (e *Email) SentAt() *time.Time        { return e.sentAt }
+func (e *Email) CreatedAt() time.Time      { return e.createdAt }
+func (e *Email) UpdatedAt() time.Time      { return e.updatedAt }
+
+// RecordSent marks the email as accepted by the mail server
+func (e *Email) RecordSent(at time.Time) {
+	e.attempts++
+	e.status = StatusSent
+	e.lastError = ""
+	e.nextAttemptAt = nil
+	e.sentAt = &at
+	e.updatedAt = at
+}
+
+// RecordFailure logs a failed attempt; the email is retried with the
+// policy's backoff until the attempts run out, when it is marked failed
+func (e *Email) RecordFailure(reason string, at time.Time, policy RetryPolicy) {
+	e.attempts++
+	e.lastError = reason
+	e.updatedAt = at
+
+	if e.attempts >= policy.MaxAttempts {
+		e.status = StatusFailed
+		e.nextAttemptAt = nil
+		return
+	}
+	next := at.Add(policy.Delay(e.attempts))
+	e.status = StatusPending
+	e.nextAttemptAt = &next
+}
+
+// Retry queues the email again right away with a fresh set of attempts
+func (e *Email) Retry() error {
+	if e.status == StatusSent {
+		return fmt.Errorf("%w: email %s was already sent", shared.ErrInvalidInput, e.id)
+	}
+
+	now := time.Now()
+	e.status = StatusPending
+	e.attempts = 0
+	e.nextAttemptAt = &now
+	e.updatedAt = now
+	return nil
+}
+
+func ReconstructEmail(
+	id EmailID,
+	orderID shared.OrderID,
+	message Message,
+	status Status,
+	attempts int,
+	lastError string,
+	nextAttemptAt *time.Time,
+	sentAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Email {
+	return &Email{
+		id:            id,
+		orderID:       orderID,
+		message:       message,
+		status:        status,
+		attempts:      attempts,
+		lastError:     lastError,
+		nextAttemptAt: nextAttemptAt,
+		sentAt:        sentAt,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
@@ -0,0 +1,17 @@
+package mail
+
+import "errors"
+
+// ErrRejected is wrapped by senders when the mail server refuses a message
+// for good, e.g. an unknown mailbox, so it is not retried
+var ErrRejected = errors.New("message rejected by the mail server")
+
+// Sender defines the interface for handing a message to a mail server
+type Sender interface {
+	Send(msg Message) error
+}
+
+// Composer defines the interface for rendering emails from templates
+type Composer interface {
+	ComposeReceipt(to string, receipt Receipt) (Message, error)
+}
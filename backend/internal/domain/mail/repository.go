@@ -0,0 +1,17 @@
+package mail
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"time"
+)
+
+// EmailRepository defines the interface for the outgoing email queue
+type EmailRepository interface {
+	Save(email *Email) error
+	FindByID(id EmailID) (*Email, error)
+	// FindRecent returns the newest emails first; an empty status or order
+	// matches every email
+	FindRecent(status Status, orderID shared.OrderID, limit int) ([]*Email, error)
+	// FindDue returns pending emails whose next attempt is due, oldest first
+	FindDue(now time.Time, limit int) ([]*Email, error)
+}
@@ -0,0 +1,273 @@
+package mail
+
+import (
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sendBatchSize caps how many due emails one pass attempts
+const sendBatchSize = 50
+
+// ReceiptMailer emails customer receipts. Receipts are composed when they
+// are queued, so the email shows the order as it was when it was paid, and
+// are sent through a queue so that a mail server outage only delays them.
+type ReceiptMailer struct {
+	orderRepo   order.OrderRepository
+	productRepo product.ProductRepository
+	emailRepo   EmailRepository
+	composer    Composer
+	sender      Sender
+	branding    Branding
+	policy      RetryPolicy
+}
+
+// NewReceiptMailer creates the service; sender may be nil when no mail
+// server is configured, in which case paid orders are not emailed and
+// sending a receipt fails with ErrDeviceUnavailable
+func NewReceiptMailer(
+	orderRepo order.OrderRepository,
+	productRepo product.ProductRepository,
+	emailRepo EmailRepository,
+	composer Composer,
+	sender Sender,
+	branding Branding,
+	policy RetryPolicy,
+) *ReceiptMailer {
+	return &ReceiptMailer{
+		orderRepo:   orderRepo,
+		productRepo: productRepo,
+		emailRepo:   emailRepo,
+		composer:    composer,
+		sender:      sender,
+		branding:    branding,
+		policy:      policy,
+	}
+}
+
+// SendOnCompletion implements event.Handler for order.completed, queueing
+// the receipt of a paid order that carries a customer email
+func (s *ReceiptMailer) SendOnCompletion(msg event.Message) error {
+	var completed order.OrderCompleted
+	if err := msg.Decode(&completed); err != nil {
+		return err
+	}
+	if s.sender == nil {
+		return nil
+	}
+
+	ord, err := s.orderRepo.FindByID(shared.OrderID(completed.OrderID))
+	if err != nil {
+		return err
+	}
+	if ord.CustomerEmail() == "" {
+		return nil
+	}
+
+	_, err = s.queue(ord, ord.CustomerEmail(), EmailID("receipt-"+strconv.FormatUint(msg.ID, 10)))
+	return err
+}
+
+// SendReceipt queues the receipt of an order for the given address, or for
+// the order's customer email when none is given
+func (s *ReceiptMailer) SendReceipt(id EmailID, orderID shared.OrderID, to string) (*Email, error) {
+	if s.sender == nil {
+		return nil, fmt.Errorf("%w: no mail server configured", shared.ErrDeviceUnavailable)
+	}
+
+	ord, err := s.orderRepo.FindByID(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	to = strings.TrimSpace(to)
+	if to == "" {
+		to = ord.CustomerEmail()
+	}
+	if to == "" {
+		return nil, fmt.Errorf("%w: the order has no customer email, give one to send the receipt to", shared.ErrInvalidInput)
+	}
+
+	return s.queue(ord, to, id)
+}
+
+// Preview composes the receipt email of an order without queueing it
+func (s *ReceiptMailer) Preview(orderID shared.OrderID, to string) (Message, error) {
+	ord, err := s.orderRepo.FindByID(orderID)
+	if err != nil {
+		return Message{}, err
+	}
+	if to == "" {
+		to = ord.CustomerEmail()
+	}
+	return s.composer.ComposeReceipt(to, s.receipt(ord))
+}
+
+// SendDue attempts every email whose next attempt is due and returns how
+// many were attempted
+func (s *ReceiptMailer) SendDue() (int, error) {
+	if s.sender == nil {
+		return 0, nil
+	}
+
+	emails, err := s.emailRepo.FindDue(time.Now(), sendBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	attempted := 0
+	for _, email := range emails {
+		if err := s.attempt(email); err != nil {
+			log.Printf("Error attempting email %s: %v", email.ID(), err)
+			continue
+		}
+		attempted++
+	}
+	return attempted, nil
+}
+
+// Retry sends an unsent email again right away and returns its outcome
+func (s *ReceiptMailer) Retry(id EmailID) (*Email, error) {
+	if s.sender == nil {
+		return nil, fmt.Errorf("%w: no mail server configured", shared.ErrDeviceUnavailable)
+	}
+
+	email, err := s.emailRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := email.Retry(); err != nil {
+		return nil, err
+	}
+	if err := s.attempt(email); err != nil {
+		return nil, err
+	}
+	return email, nil
+}
+
+// Run sends due emails every interval until stop is closed
+func (s *ReceiptMailer) Run(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if _, err := s.SendDue(); err != nil {
+				log.Printf("Error sending emails: %v", err)
+			}
+		}
+	}
+}
+
+// queue composes the receipt and saves it as a pending email; an email
+// already queued under the ID is returned as it is
+func (s *ReceiptMailer) queue(ord *order.Order, to string, id EmailID) (*Email, error) {
+	existing, err := s.emailRepo.FindByID(id)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, shared.ErrNotFound) {
+		return nil, err
+	}
+
+	message, err := s.composer.ComposeReceipt(to, s.receipt(ord))
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := NewEmail(id, ord.ID(), message)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.emailRepo.Save(email); err != nil {
+		return nil, err
+	}
+	return email, nil
+}
+
+// attempt hands the email to the mail server once and saves the outcome
+func (s *ReceiptMailer) attempt(email *Email) error {
+	now := time.Now()
+	err := s.sender.Send(email.Message())
+	switch {
+	case errors.Is(err, ErrRejected):
+		email.RecordFailure(err.Error(), now, RetryPolicy{MaxAttempts: 1})
+	case err != nil:
+		email.RecordFailure(err.Error(), now, s.policy)
+	default:
+		email.RecordSent(now)
+	}
+	return s.emailRepo.Save(email)
+}
+
+// receipt gathers what the templates show for an order
+func (s *ReceiptMailer) receipt(ord *order.Order) Receipt {
+	receipt := Receipt{
+		Store:         s.branding,
+		Reference:     orderReference(ord),
+		TableNumber:   ord.TableNumber().String(),
+		Date:          ord.CreatedAt().Local(),
+		Total:         formatAmount(ord.Total().Amount),
+		Currency:      ord.Total().Currency,
+		PaymentMethod: string(ord.PaymentMethod()),
+	}
+
+	for _, item := range ord.Items() {
+		name, unit := s.describeProduct(item.ProductID())
+		receipt.Lines = append(receipt.Lines, ReceiptLine{
+			Description: name,
+			Quantity:    formatQuantity(item.Quantity(), unit),
+			UnitPrice:   formatAmount(item.UnitPrice().Amount),
+			Amount:      formatAmount(item.Subtotal().Amount),
+		})
+	}
+	return receipt
+}
+
+// describeProduct returns the name and sale unit shown for a product,
+// falling back to its ID when the product has since been purged
+func (s *ReceiptMailer) describeProduct(productID shared.ProductID) (string, shared.UnitOfMeasure) {
+	prod, err := s.productRepo.FindByIDIncludingArchived(productID)
+	if err != nil {
+		return productID.String(), shared.UnitPiece
+	}
+	return prod.Name(), prod.Unit()
+}
+
+// orderReference is the ticket number called out for an order; orders
+// placed before tickets were numbered fall back to a shortened ID
+func orderReference(ord *order.Order) string {
+	if ord.TicketNumber() != "" {
+		return ord.TicketNumber().String()
+	}
+	ref := strings.ToUpper(strings.ReplaceAll(ord.ID().String(), "-", ""))
+	if len(ref) > 8 {
+		ref = ref[:8]
+	}
+	return ref
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+// formatQuantity shows whole pieces without decimals and measured
+// quantities with their unit, e.g. "2" or "0.35 kg"
+func formatQuantity(quantity float64, unit shared.UnitOfMeasure) string {
+	formatted := strconv.FormatFloat(quantity, 'f', -1, 64)
+	if unit == shared.UnitPiece {
+		return formatted
+	}
+	return formatted + " " + string(unit)
+}
@@ -0,0 +1,97 @@
+package mail
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"time"
+)
+
+type EmailID string
+
+func (e EmailID) String() string {
+	return string(e)
+}
+
+// Status tracks an email through the outgoing queue
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	StatusSent    Status = "sent"
+	StatusFailed  Status = "failed" // attempts ran out; retried only by hand
+)
+
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusPending, StatusSent, StatusFailed:
+		return true
+	}
+	return false
+}
+
+// Message is a composed email with a plain text and an HTML body
+type Message struct {
+	To       string `json:"to"`
+	Subject  string `json:"subject"`
+	TextBody string `json:"text_body"`
+	HTMLBody string `json:"html_body"`
+}
+
+// Branding is the store identity shown on emailed receipts
+type Branding struct {
+	Name        string
+	Address     string
+	Phone       string
+	TaxID       string
+	LogoURL     string
+	AccentColor string // CSS colour of headings and the total
+	Footer      string
+}
+
+// ReceiptLine is one item line of an emailed receipt
+type ReceiptLine struct {
+	Description string
+	Quantity    string // formatted, with the unit for measured products
+	UnitPrice   string
+	Amount      string
+}
+
+// Receipt holds everything a receipt template shows, already formatted
+type Receipt struct {
+	Store         Branding
+	Reference     string
+	TableNumber   string
+	Date          time.Time
+	Lines         []ReceiptLine
+	Total         string
+	Currency      string
+	PaymentMethod string
+}
+
+// RetryPolicy spaces out retries of an email the mail server didn't take
+// with exponential backoff: BaseDelay after the first failure, doubling up
+// to MaxDelay
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+func NewRetryPolicy(maxAttempts int, baseDelay, maxDelay time.Duration) (RetryPolicy, error) {
+	if maxAttempts <= 0 || baseDelay <= 0 || maxDelay < baseDelay {
+		return RetryPolicy{}, fmt.Errorf("%w: attempts and delays must be positive, max delay at least the base delay", shared.ErrInvalidInput)
+	}
+	return RetryPolicy{MaxAttempts: maxAttempts, BaseDelay: baseDelay, MaxDelay: maxDelay}, nil
+}
+
+// Delay returns how long to wait after the given number of failed attempts
+func (p RetryPolicy) Delay(failures int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return delay
+}
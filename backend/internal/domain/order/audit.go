@@ -0,0 +1,120 @@
+package order
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"time"
+)
+
+// AuditAction is what happened to an order in its audit trail
+type AuditAction string
+
+const (
+	AuditMoved      AuditAction = "moved"       // moved to another table
+	AuditMerged     AuditAction = "merged"      // other orders were merged into it
+	AuditMergedInto AuditAction = "merged_into" // merged into another order
+	AuditSplit      AuditAction = "split"       // parts of it were split off
+	AuditSplitFrom  AuditAction = "split_from"  // split off another order
+)
+
+// AuditRecord is an entry in an order's trail of moves, merges and splits
+type AuditRecord struct {
+	OrderID       shared.OrderID
+	Action        AuditAction
+	RelatedOrders []shared.OrderID // the other orders taking part
+	FromTable     TableNumber
+	ToTable       TableNumber
+	Amount        shared.Money // the value that changed hands
+	SplitMode     SplitMode    // how the bill was split, on splits only
+	StaffMember   string
+	Note          string
+	At            time.Time
+}
+
+// Audited is implemented by the events kept in the audit trail. Each
+// returns an entry for every order taking part, so the trail reads the
+// same from either side of a merge or split.
+type Audited interface {
+	AuditRecords() []AuditRecord
+}
+
+// AuditRecords implements Audited
+func (e OrderMoved) AuditRecords() []AuditRecord {
+	return []AuditRecord{{
+		OrderID:     shared.OrderID(e.OrderID),
+		Action:      AuditMoved,
+		FromTable:   TableNumber(e.FromTable),
+		ToTable:     TableNumber(e.ToTable),
+		Amount:      usd(e.Total),
+		StaffMember: e.StaffMember,
+		Note:        e.Note,
+		At:          e.MovedAt,
+	}}
+}
+
+// AuditRecords implements Audited
+func (e OrdersMerged) AuditRecords() []AuditRecord {
+	target := AuditRecord{
+		OrderID:     shared.OrderID(e.OrderID),
+		Action:      AuditMerged,
+		ToTable:     TableNumber(e.TableNumber),
+		Amount:      usd(0),
+		StaffMember: e.StaffMember,
+		Note:        e.Note,
+		At:          e.MergedAt,
+	}
+	records := []AuditRecord{}
+	for _, part := range e.Merged {
+		target.RelatedOrders = append(target.RelatedOrders, shared.OrderID(part.OrderID))
+		target.Amount = target.Amount.Add(usd(part.Total))
+		records = append(records, AuditRecord{
+			OrderID:       shared.OrderID(part.OrderID),
+			Action:        AuditMergedInto,
+			RelatedOrders: []shared.OrderID{shared.OrderID(e.OrderID)},
+			FromTable:     TableNumber(part.TableNumber),
+			ToTable:       TableNumber(e.TableNumber),
+			Amount:        usd(part.Total),
+			StaffMember:   e.StaffMember,
+			Note:          e.Note,
+			At:            e.MergedAt,
+		})
+	}
+	target.Amount = target.Amount.Round()
+	return append([]AuditRecord{target}, records...)
+}
+
+// AuditRecords implements Audited
+func (e OrderSplit) AuditRecords() []AuditRecord {
+	source := AuditRecord{
+		OrderID:     shared.OrderID(e.OrderID),
+		Action:      AuditSplit,
+		FromTable:   TableNumber(e.TableNumber),
+		Amount:      usd(0),
+		SplitMode:   e.Mode,
+		StaffMember: e.StaffMember,
+		Note:        e.Note,
+		At:          e.SplitAt,
+	}
+	records := []AuditRecord{}
+	for _, part := range e.Parts {
+		source.RelatedOrders = append(source.RelatedOrders, shared.OrderID(part.OrderID))
+		source.Amount = source.Amount.Add(usd(part.Total))
+		records = append(records, AuditRecord{
+			OrderID:       shared.OrderID(part.OrderID),
+			Action:        AuditSplitFrom,
+			RelatedOrders: []shared.OrderID{shared.OrderID(e.OrderID)},
+			FromTable:     TableNumber(e.TableNumber),
+			ToTable:       TableNumber(part.TableNumber),
+			Amount:        usd(part.Total),
+			SplitMode:     e.Mode,
+			StaffMember:   e.StaffMember,
+			Note:          e.Note,
+			At:            e.SplitAt,
+		})
+	}
+	source.Amount = source.Amount.Round()
+	return append([]AuditRecord{source}, records...)
+}
+
+func usd(amount float64) shared.Money {
+	return shared.Money{Amount: amount, Currency: "USD"}
+}
@@ -1,53 +1,226 @@
 package order
 
 import (
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/kitchen"
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
 	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"math"
+	"net/mail"
+	"strings"
 	"time"
 )
 
 // OrderItem represents an item in an order
 type OrderItem struct {
-	productID shared.ProductID
-	quantity  int
-	unitPrice shared.Money
-	subtotal  shared.Money
+	line              int // position in the order, from 1
+	productID         shared.ProductID
+	quantity          float64
+	unitPrice         shared.Money
+	unitCost          shared.Money
+	subtotal          shared.Money
+	allergenConflicts []product.Allergen
+	locationID        location.LocationID
+	weighed           bool
+	stationID         kitchen.StationID
+	status            ItemStatus
+	startedAt         *time.Time
+	doneAt            *time.Time
+	servedAt          *time.Time
 }
 
-func NewOrderItem(productID shared.ProductID, quantity int, unitPrice shared.Money) (*OrderItem, error) {
+func NewOrderItem(productID shared.ProductID, quantity float64, unitPrice, unitCost shared.Money) (*OrderItem, error) {
 	if quantity <= 0 {
 		return nil, shared.ErrInvalidQuantity
 	}
 
-	subtotal := unitPrice.Multiply(float64(quantity))
+	// Line prices are rounded to the cent; fractional quantities need it
+	subtotal := unitPrice.Multiply(quantity)
+	subtotal = subtotal.Round()
 
 	return &OrderItem{
 		productID: productID,
 		quantity:  quantity,
 		unitPrice: unitPrice,
+		unitCost:  unitCost,
 		subtotal:  subtotal,
+		status:    ItemQueued,
 	}, nil
 }
 
+func (oi *OrderItem) Line() int                   { return oi.line }
 func (oi *OrderItem) ProductID() shared.ProductID { return oi.productID }
-func (oi *OrderItem) Quantity() int               { return oi.quantity }
+func (oi *OrderItem) Quantity() float64           { return oi.quantity }
 func (oi *OrderItem) UnitPrice() shared.Money     { return oi.unitPrice }
+func (oi *OrderItem) UnitCost() shared.Money      { return oi.unitCost }
 func (oi *OrderItem) Subtotal() shared.Money      { return oi.subtotal }
 
+// CostTotal returns the cost of goods sold for this item, to the cent
+func (oi *OrderItem) CostTotal() shared.Money {
+	cost := oi.unitCost.Multiply(oi.quantity)
+	return cost.Round()
+}
+
+// AllergenConflicts returns the declared allergies this item contains
+func (oi *OrderItem) AllergenConflicts() []product.Allergen { return oi.allergenConflicts }
+
+// HasAllergenConflict reports whether the item conflicts with a declared allergy
+func (oi *OrderItem) HasAllergenConflict() bool { return len(oi.allergenConflicts) > 0 }
+
+// FlagAllergens records the declared allergies this item contains
+func (oi *OrderItem) FlagAllergens(conflicts []product.Allergen) {
+	oi.allergenConflicts = conflicts
+}
+
+// LocationID returns the location the item's stock was taken from
+func (oi *OrderItem) LocationID() location.LocationID { return oi.locationID }
+
+// AssignLocation records the location the item's stock is taken from
+func (oi *OrderItem) AssignLocation(locationID location.LocationID) {
+	oi.locationID = locationID
+}
+
+// Weighed reports whether the quantity is a weight read from a scale
+func (oi *OrderItem) Weighed() bool { return oi.weighed }
+
+// MarkWeighed records that the quantity was weighed
+func (oi *OrderItem) MarkWeighed() {
+	oi.weighed = true
+}
+
+// StationID returns the kitchen station preparing the item, empty when none does
+func (oi *OrderItem) StationID() kitchen.StationID { return oi.stationID }
+
+// AssignStation records the kitchen station that prepares the item
+func (oi *OrderItem) AssignStation(stationID kitchen.StationID) {
+	oi.stationID = stationID
+}
+
+// AwaitsStation reports whether the item is still being prepared at a station
+func (oi *OrderItem) AwaitsStation() bool {
+	return oi.stationID != "" && !oi.status.IsFinished()
+}
+
+// Preparation status and timestamps, kept for prep-time analytics
+func (oi *OrderItem) Status() ItemStatus    { return oi.status }
+func (oi *OrderItem) StartedAt() *time.Time { return oi.startedAt }
+func (oi *OrderItem) DoneAt() *time.Time    { return oi.doneAt }
+func (oi *OrderItem) ServedAt() *time.Time  { return oi.servedAt }
+
+// UpdateStatus moves the item forward through queued, cooking, done and served,
+// stamping the time each step was reached
+func (oi *OrderItem) UpdateStatus(newStatus ItemStatus, at time.Time) error {
+	if !newStatus.IsValid() {
+		return shared.ErrInvalidInput
+	}
+
+	if !oi.status.CanTransitionTo(newStatus) {
+		return fmt.Errorf("%w: item %d cannot go from %s to %s", shared.ErrOrderNotModifiable, oi.line, oi.status, newStatus)
+	}
+
+	switch newStatus {
+	case ItemCooking:
+		oi.startedAt = &at
+	case ItemDone:
+		oi.doneAt = &at
+	case ItemServed:
+		oi.servedAt = &at
+	}
+	oi.status = newStatus
+	return nil
+}
+
+// finish marks an unfinished item done, reporting whether it changed
+func (oi *OrderItem) finish(at time.Time) bool {
+	if oi.status.IsFinished() {
+		return false
+	}
+	oi.status = ItemDone
+	oi.doneAt = &at
+	return true
+}
+
+// billingShare copies the item as a share of its bill: it carries part of
+// the subtotal but none of the quantity, and nothing is left to prepare
+func (oi *OrderItem) billingShare(subtotal shared.Money) *OrderItem {
+	c := oi.copyWith(0, subtotal)
+	c.stationID = ""
+	c.status = ItemServed
+	return c
+}
+
+// copyWith copies the item with another quantity and subtotal, keeping its
+// price snapshots, stock location and preparation state
+func (oi *OrderItem) copyWith(quantity float64, subtotal shared.Money) *OrderItem {
+	c := *oi
+	c.quantity = quantity
+	c.subtotal = subtotal
+	c.allergenConflicts = append([]product.Allergen(nil), oi.allergenConflicts...)
+	return &c
+}
+
+func ReconstructOrderItem(
+	line int,
+	productID shared.ProductID,
+	quantity float64,
+	unitPrice shared.Money,
+	unitCost shared.Money,
+	subtotal shared.Money,
+	allergenConflicts []product.Allergen,
+	locationID location.LocationID,
+	weighed bool,
+	stationID kitchen.StationID,
+	status ItemStatus,
+	startedAt *time.Time,
+	doneAt *time.Time,
+	servedAt *time.Time,
+) *OrderItem {
+	return &OrderItem{
+		line:              line,
+		productID:         productID,
+		quantity:          quantity,
+		unitPrice:         unitPrice,
+		unitCost:          unitCost,
+		subtotal:          subtotal,
+		allergenConflicts: allergenConflicts,
+		locationID:        locationID,
+		weighed:           weighed,
+		stationID:         stationID,
+		status:            status,
+		startedAt:         startedAt,
+		doneAt:            doneAt,
+		servedAt:          servedAt,
+	}
+}
+
 // Order is an aggregate root
 type Order struct {
-	id          shared.OrderID
-	tableNumber TableNumber
-	items       []*OrderItem
-	status      OrderStatus
-	total       shared.Money
-	createdAt   time.Time
-	updatedAt   time.Time
+	event.Recorder
+
+	id                shared.OrderID
+	tableNumber       TableNumber
+	channel           Channel
+	ticketNumber      TicketNumber // empty on orders placed before tickets were numbered
+	businessDay       BusinessDayID
+	terminalID        string
+	items             []*OrderItem
+	status            OrderStatus
+	total             shared.Money
+	declaredAllergies []product.Allergen
+	paymentMethod     PaymentMethod // set once the order is completed and paid
+	customerEmail     string        // where the receipt is emailed, optional
+	createdAt         time.Time
+	updatedAt         time.Time
 }
 
 // NewOrder creates a new Order (Factory method)
 func NewOrder(
 	id shared.OrderID,
 	tableNumber TableNumber,
+	channel Channel,
+	ticket Ticket,
 	items []*OrderItem,
 ) (*Order, error) {
 
@@ -55,31 +228,112 @@ func NewOrder(
 		return nil, shared.ErrInvalidInput
 	}
 
-	// Calculate total
+	if !channel.IsValid() {
+		return nil, fmt.Errorf("%w: unknown channel %s", shared.ErrInvalidInput, channel)
+	}
+
+	// Calculate total and number the lines
 	total := shared.Money{Amount: 0, Currency: "USD"}
-	for _, item := range items {
+	for i, item := range items {
+		item.line = i + 1
 		total = total.Add(item.subtotal)
 	}
 
-	return &Order{
-		id:          id,
-		tableNumber: tableNumber,
-		items:       items,
-		status:      StatusPending,
-		total:       total,
-		createdAt:   time.Now(),
-		updatedAt:   time.Now(),
-	}, nil
+	o := &Order{
+		id:                id,
+		tableNumber:       tableNumber,
+		channel:           channel,
+		ticketNumber:      ticket.Number,
+		businessDay:       ticket.BusinessDay,
+		items:             items,
+		status:            StatusPending,
+		total:             total,
+		declaredAllergies: []product.Allergen{},
+		createdAt:         time.Now(),
+		updatedAt:         time.Now(),
+	}
+
+	o.Record(OrderCreated{
+		OrderID:      id.String(),
+		TicketNumber: ticket.Number.String(),
+		Channel:      channel,
+		TableNumber:  tableNumber.String(),
+		Total:        total.Amount,
+		ItemCount:    len(items),
+		CreatedAt:    o.createdAt,
+	})
+	return o, nil
 }
 
 // Getters
-func (o *Order) ID() shared.OrderID       { return o.id }
-func (o *Order) TableNumber() TableNumber { return o.tableNumber }
-func (o *Order) Items() []*OrderItem      { return o.items }
-func (o *Order) Status() OrderStatus      { return o.status }
-func (o *Order) Total() shared.Money      { return o.total }
-func (o *Order) CreatedAt() time.Time     { return o.createdAt }
-func (o *Order) UpdatedAt() time.Time     { return o.updatedAt }
+func (o *Order) ID() shared.OrderID           { return o.id }
+func (o *Order) TableNumber() TableNumber     { return o.tableNumber }
+func (o *Order) Channel() Channel             { return o.channel }
+func (o *Order) TicketNumber() TicketNumber   { return o.ticketNumber }
+func (o *Order) BusinessDay() BusinessDayID   { return o.businessDay }
+func (o *Order) TerminalID() string           { return o.terminalID }
+func (o *Order) Items() []*OrderItem          { return o.items }
+func (o *Order) Status() OrderStatus          { return o.status }
+func (o *Order) Total() shared.Money          { return o.total }
+func (o *Order) PaymentMethod() PaymentMethod { return o.paymentMethod }
+func (o *Order) CustomerEmail() string        { return o.customerEmail }
+func (o *Order) CreatedAt() time.Time         { return o.createdAt }
+func (o *Order) UpdatedAt() time.Time         { return o.updatedAt }
+
+// DeclaredAllergies returns the allergies declared by the customer
+func (o *Order) DeclaredAllergies() []product.Allergen { return o.declaredAllergies }
+
+// DeclareAllergies records the customer's declared allergies
+func (o *Order) DeclareAllergies(allergies []product.Allergen) error {
+	for _, a := range allergies {
+		if !a.IsValid() {
+			return shared.ErrInvalidInput
+		}
+	}
+	o.declaredAllergies = allergies
+	return nil
+}
+
+// SetCustomerEmail records the address the receipt is emailed to; an empty
+// address removes it
+func (o *Order) SetCustomerEmail(email string) error {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		o.customerEmail = ""
+		return nil
+	}
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil {
+		return fmt.Errorf("%w: invalid customer email %q", shared.ErrInvalidInput, email)
+	}
+	o.customerEmail = addr.Address
+	return nil
+}
+
+// AssignTerminal records the terminal the order was placed from
+func (o *Order) AssignTerminal(terminalID string) {
+	o.terminalID = terminalID
+}
+
+// AllergenWarnings lists the items that conflict with the declared allergies
+func (o *Order) AllergenWarnings() []AllergenWarning {
+	var warnings []AllergenWarning
+	for _, item := range o.items {
+		if item.HasAllergenConflict() {
+			warnings = append(warnings, AllergenWarning{
+				ProductID: item.productID,
+				Allergens: item.allergenConflicts,
+			})
+		}
+	}
+	return warnings
+}
+
+// HasAllergenWarnings reports whether any item conflicts with a declared allergy
+func (o *Order) HasAllergenWarnings() bool {
+	return len(o.AllergenWarnings()) > 0
+}
 
 // Business methods
 func (o *Order) UpdateStatus(newStatus OrderStatus) error {
@@ -91,8 +345,26 @@ func (o *Order) UpdateStatus(newStatus OrderStatus) error {
 		return shared.ErrOrderNotModifiable
 	}
 
-	o.status = newStatus
-	o.updatedAt = time.Now()
+	// Routed orders are ready only once every station has bumped its part
+	if newStatus == StatusReady {
+		if pending := o.PendingStations(); len(pending) > 0 {
+			names := make([]string, len(pending))
+			for i, stationID := range pending {
+				names[i] = stationID.String()
+			}
+			return fmt.Errorf("%w: waiting for stations %s", shared.ErrOrderNotModifiable, strings.Join(names, ", "))
+		}
+	}
+
+	now := time.Now()
+	if newStatus == StatusReady {
+		for _, item := range o.items {
+			o.finishItem(item, now)
+		}
+	}
+
+	o.setStatus(newStatus, now)
+	o.updatedAt = now
 	return nil
 }
 
@@ -108,14 +380,570 @@ func (o *Order) Complete() error {
 	return o.UpdateStatus(StatusCompleted)
 }
 
+// CompleteWithPayment completes the order, recording how it was paid
+func (o *Order) CompleteWithPayment(method PaymentMethod) error {
+	if !method.IsValid() {
+		return fmt.Errorf("%w: unknown payment method %s", shared.ErrInvalidInput, method)
+	}
+	if !o.status.CanTransitionTo(StatusCompleted) {
+		return shared.ErrOrderNotModifiable
+	}
+
+	o.paymentMethod = method
+	return o.UpdateStatus(StatusCompleted)
+}
+
 func (o *Order) Cancel() error {
 	return o.UpdateStatus(StatusCancelled)
 }
 
+// Item returns the item on the given line
+func (o *Order) Item(line int) (*OrderItem, error) {
+	for _, item := range o.items {
+		if item.line == line {
+			return item, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: order has no line %d", shared.ErrNotFound, line)
+}
+
+// UpdateItemStatus moves one item forward and keeps the order status in step:
+// the order starts preparing once any item has started and becomes ready once
+// every item is done.
+func (o *Order) UpdateItemStatus(line int, newStatus ItemStatus) error {
+	if !o.IsOpen() {
+		return shared.ErrOrderNotModifiable
+	}
+
+	item, err := o.Item(line)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	previous := item.status
+	if err := item.UpdateStatus(newStatus, now); err != nil {
+		return err
+	}
+	o.recordItemStatus(item, previous, now)
+
+	o.syncStatus(now)
+	o.updatedAt = now
+	return nil
+}
+
+// syncStatus derives the order status from its items, only ever moving forward
+func (o *Order) syncStatus(at time.Time) {
+	started, finished := false, true
+	for _, item := range o.items {
+		if item.status != ItemQueued {
+			started = true
+		}
+		if !item.status.IsFinished() {
+			finished = false
+		}
+	}
+
+	if o.status == StatusPending && started {
+		o.setStatus(StatusPreparing, at)
+	}
+	if o.status == StatusPreparing && finished {
+		o.setStatus(StatusReady, at)
+	}
+}
+
+// setStatus changes the order status and records the change
+func (o *Order) setStatus(status OrderStatus, at time.Time) {
+	if status == o.status {
+		return
+	}
+	o.Record(OrderStatusChanged{
+		OrderID:      o.id.String(),
+		TicketNumber: o.ticketNumber.String(),
+		From:         o.status,
+		To:           status,
+		ChangedAt:    at,
+	})
+	o.status = status
+
+	if status == StatusCompleted {
+		o.Record(OrderCompleted{
+			OrderID:       o.id.String(),
+			TicketNumber:  o.ticketNumber.String(),
+			TableNumber:   o.tableNumber.String(),
+			Total:         o.total.Amount,
+			PaymentMethod: o.paymentMethod,
+			CompletedAt:   at,
+		})
+	}
+}
+
+// finishItem marks an unfinished item done and records the change
+func (o *Order) finishItem(item *OrderItem, at time.Time) {
+	previous := item.status
+	if item.finish(at) {
+		o.recordItemStatus(item, previous, at)
+	}
+}
+
+func (o *Order) recordItemStatus(item *OrderItem, from ItemStatus, at time.Time) {
+	o.Record(ItemStatusChanged{
+		OrderID:      o.id.String(),
+		TicketNumber: o.ticketNumber.String(),
+		Line:         item.line,
+		ProductID:    item.productID.String(),
+		From:         from,
+		To:           item.status,
+		ChangedAt:    at,
+	})
+}
+
+// ItemsForStation returns the items routed to a station
+func (o *Order) ItemsForStation(stationID kitchen.StationID) []*OrderItem {
+	var items []*OrderItem
+	for _, item := range o.items {
+		if item.stationID == stationID {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// PendingStations lists the stations that have not yet bumped their items, in item order
+func (o *Order) PendingStations() []kitchen.StationID {
+	var stations []kitchen.StationID
+	seen := make(map[kitchen.StationID]bool)
+	for _, item := range o.items {
+		if item.AwaitsStation() && !seen[item.stationID] {
+			seen[item.stationID] = true
+			stations = append(stations, item.stationID)
+		}
+	}
+	return stations
+}
+
+// AwaitsStation reports whether a station still has items of this order to prepare
+func (o *Order) AwaitsStation(stationID kitchen.StationID) bool {
+	if !o.IsPending() {
+		return false
+	}
+	for _, item := range o.items {
+		if item.stationID == stationID && item.AwaitsStation() {
+			return true
+		}
+	}
+	return false
+}
+
+// BumpStation marks the items a station prepared as done. The order moves
+// to preparing on the first bump and to ready once every station has bumped.
+func (o *Order) BumpStation(stationID kitchen.StationID) error {
+	if !o.AwaitsStation(stationID) {
+		if !o.IsPending() {
+			return shared.ErrOrderNotModifiable
+		}
+		return fmt.Errorf("%w: station %s has nothing to prepare on this order", shared.ErrInvalidInput, stationID)
+	}
+
+	now := time.Now()
+	for _, item := range o.items {
+		if item.stationID == stationID {
+			o.finishItem(item, now)
+		}
+	}
+
+	// Once the last station has bumped, nothing is left for the kitchen
+	if len(o.PendingStations()) == 0 {
+		for _, item := range o.items {
+			o.finishItem(item, now)
+		}
+	}
+
+	o.syncStatus(now)
+	o.updatedAt = now
+	return nil
+}
+
+// MoveToTable seats the order at another table
+func (o *Order) MoveToTable(table TableNumber, note ChangeNote) error {
+	if !o.IsOpen() {
+		return shared.ErrOrderNotModifiable
+	}
+	if table == o.tableNumber {
+		return fmt.Errorf("%w: order is already at table %s", shared.ErrInvalidInput, table)
+	}
+
+	now := time.Now()
+	o.Record(OrderMoved{
+		OrderID:      o.id.String(),
+		TicketNumber: o.ticketNumber.String(),
+		FromTable:    o.tableNumber.String(),
+		ToTable:      table.String(),
+		Total:        o.total.Amount,
+		StaffMember:  note.StaffMember,
+		Note:         note.Note,
+		MovedAt:      now,
+	})
+	o.tableNumber = table
+	o.updatedAt = now
+	return nil
+}
+
+// Merge moves the items of other open orders onto this one, keeping their
+// price snapshots and preparation state. The merged orders are closed as
+// merged and keep their items as a record, so only this order is paid. The
+// status follows the items as it does when they are prepared, so it only
+// moves forward: an order that is ready takes no items still in the kitchen.
+func (o *Order) Merge(others []*Order, note ChangeNote) error {
+	if !o.IsOpen() {
+		return shared.ErrOrderNotModifiable
+	}
+	if len(others) == 0 {
+		return fmt.Errorf("%w: no orders to merge", shared.ErrInvalidInput)
+	}
+
+	seen := map[shared.OrderID]bool{o.id: true}
+	for _, other := range others {
+		if seen[other.id] {
+			return fmt.Errorf("%w: order %s is merged more than once or into itself", shared.ErrInvalidInput, other.id)
+		}
+		seen[other.id] = true
+		if !other.IsOpen() {
+			return fmt.Errorf("%w: order %s is %s", shared.ErrOrderNotModifiable, other.id, other.status)
+		}
+		if o.status == StatusReady && !other.prepared() {
+			return fmt.Errorf("%w: order %s is still being prepared", shared.ErrOrderNotModifiable, other.id)
+		}
+	}
+
+	now := time.Now()
+	var merged []OrderPart
+	for _, other := range others {
+		for _, item := range other.items {
+			o.addItem(item.copyWith(item.quantity, item.subtotal))
+		}
+		o.declaredAllergies = unionAllergies(o.declaredAllergies, other.declaredAllergies)
+		if o.customerEmail == "" {
+			o.customerEmail = other.customerEmail
+		}
+
+		merged = append(merged, other.part())
+		other.setStatus(StatusMerged, now)
+		other.updatedAt = now
+	}
+
+	o.syncStatus(now)
+	o.updatedAt = now
+	o.Record(OrdersMerged{
+		OrderID:      o.id.String(),
+		TicketNumber: o.ticketNumber.String(),
+		TableNumber:  o.tableNumber.String(),
+		Merged:       merged,
+		Total:        o.total.Amount,
+		StaffMember:  note.StaffMember,
+		Note:         note.Note,
+		MergedAt:     now,
+	})
+	return nil
+}
+
+// SplitItems moves the selected lines, or part of their quantity, to a new
+// order at the given table. A partly split line is charged at its price
+// snapshot and the line left behind keeps the rest of its subtotal, so the
+// two orders add up to the original total. At least one item must stay.
+func (o *Order) SplitItems(part SplitPart, table TableNumber, lines []SplitLine, note ChangeNote) (*Order, error) {
+	quantities, err := o.splitQuantities(lines)
+	if err != nil {
+		return nil, err
+	}
+
+	var kept, moved []*OrderItem
+	for _, item := range o.items {
+		quantity, ok := quantities[item.line]
+		switch {
+		case !ok:
+			kept = append(kept, item)
+		case quantity == item.quantity:
+			moved = append(moved, item.copyWith(item.quantity, item.subtotal))
+		default:
+			subtotal := item.unitPrice.Multiply(quantity)
+			subtotal = subtotal.Round()
+			moved = append(moved, item.copyWith(quantity, subtotal))
+			item.quantity = shared.RoundQuantity(item.quantity - quantity)
+			item.subtotal = fromCents(cents(item.subtotal)-cents(subtotal), item.subtotal.Currency)
+			kept = append(kept, item)
+		}
+	}
+
+	now := time.Now()
+	o.items = kept
+	o.recalculateTotal()
+	o.updatedAt = now
+
+	split := o.splitOff(part, table, moved, now)
+	o.recordSplit(SplitByItems, []*Order{split}, note, now)
+	return split, nil
+}
+
+// CheckSplitItems reports whether the lines can be split off the order
+func (o *Order) CheckSplitItems(lines []SplitLine) error {
+	_, err := o.splitQuantities(lines)
+	return err
+}
+
+// splitQuantities resolves the quantity split off each line, checking every
+// line before any is changed
+func (o *Order) splitQuantities(lines []SplitLine) (map[int]float64, error) {
+	if !o.IsOpen() {
+		return nil, shared.ErrOrderNotModifiable
+	}
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("%w: no lines to split off", shared.ErrInvalidInput)
+	}
+
+	quantities := map[int]float64{}
+	for _, sl := range lines {
+		item, err := o.Item(sl.Line)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := quantities[sl.Line]; ok {
+			return nil, fmt.Errorf("%w: line %d is given more than once", shared.ErrInvalidInput, sl.Line)
+		}
+
+		quantity := shared.RoundQuantity(sl.Quantity)
+		if sl.Quantity == 0 {
+			quantity = item.quantity
+		}
+		if quantity <= 0 || quantity > item.quantity {
+			return nil, fmt.Errorf("%w: line %d: requested %g of %g", shared.ErrInvalidInput, sl.Line, sl.Quantity, item.quantity)
+		}
+		quantities[sl.Line] = quantity
+	}
+
+	remaining := 0
+	for _, item := range o.items {
+		if quantity, ok := quantities[item.line]; !ok || quantity < item.quantity {
+			remaining++
+		}
+	}
+	if remaining == 0 {
+		return nil, fmt.Errorf("%w: at least one item must stay on the order", shared.ErrInvalidInput)
+	}
+
+	return quantities, nil
+}
+
+// SplitEvenly splits the bill into equal parts, this order being the first.
+// The money is split, not the items: every line's subtotal is shared out to
+// the cent, the leftover cents going to the first parts, and each new order
+// gets a share of every line without any of its quantity. The items stay on
+// this order, so the kitchen and stock keep seeing them once.
+func (o *Order) SplitEvenly(parts []SplitPart, note ChangeNote) ([]*Order, error) {
+	if err := o.CheckSplitEvenly(len(parts) + 1); err != nil {
+		return nil, err
+	}
+
+	n := int64(len(parts) + 1)
+	items := make([][]*OrderItem, len(parts))
+	for _, item := range o.items {
+		subtotal := cents(item.subtotal)
+		each, leftover := subtotal/n, subtotal%n
+		for i := range parts {
+			share := each
+			if int64(i+1) < leftover {
+				share++
+			}
+			items[i] = append(items[i], item.billingShare(fromCents(share, item.subtotal.Currency)))
+		}
+		kept := each
+		if leftover > 0 {
+			kept++
+		}
+		item.subtotal = fromCents(kept, item.subtotal.Currency)
+	}
+
+	now := time.Now()
+	o.recalculateTotal()
+	o.updatedAt = now
+
+	var splits []*Order
+	for i, part := range parts {
+		splits = append(splits, o.splitOff(part, o.tableNumber, items[i], now))
+	}
+	o.recordSplit(SplitEvenly, splits, note, now)
+	return splits, nil
+}
+
+// CheckSplitEvenly reports whether the bill can be split into equal parts
+func (o *Order) CheckSplitEvenly(parts int) error {
+	if !o.IsOpen() {
+		return shared.ErrOrderNotModifiable
+	}
+	if parts < 2 {
+		return fmt.Errorf("%w: an order splits into at least two parts", shared.ErrInvalidInput)
+	}
+	return nil
+}
+
+// splitOff creates the order for items split off this one. It is not
+// recorded as created: the kitchen already has its items and their stock
+// was taken when this order was placed.
+func (o *Order) splitOff(part SplitPart, table TableNumber, items []*OrderItem, at time.Time) *Order {
+	split := &Order{
+		id:                part.ID,
+		tableNumber:       table,
+		channel:           o.channel,
+		ticketNumber:      part.Ticket.Number,
+		businessDay:       part.Ticket.BusinessDay,
+		terminalID:        o.terminalID,
+		status:            o.status,
+		total:             shared.Money{Amount: 0, Currency: o.total.Currency},
+		declaredAllergies: append([]product.Allergen{}, o.declaredAllergies...),
+		createdAt:         at,
+		updatedAt:         at,
+	}
+	for _, item := range items {
+		split.addItem(item)
+	}
+	return split
+}
+
+func (o *Order) recordSplit(mode SplitMode, splits []*Order, note ChangeNote, at time.Time) {
+	parts := make([]OrderPart, len(splits))
+	for i, split := range splits {
+		parts[i] = split.part()
+	}
+	o.Record(OrderSplit{
+		OrderID:      o.id.String(),
+		TicketNumber: o.ticketNumber.String(),
+		TableNumber:  o.tableNumber.String(),
+		Mode:         mode,
+		Parts:        parts,
+		Total:        o.total.Amount,
+		StaffMember:  note.StaffMember,
+		Note:         note.Note,
+		SplitAt:      at,
+	})
+}
+
+// addItem appends an item on the next free line
+func (o *Order) addItem(item *OrderItem) {
+	item.line = 1
+	for _, existing := range o.items {
+		if existing.line >= item.line {
+			item.line = existing.line + 1
+		}
+	}
+	o.items = append(o.items, item)
+	o.recalculateTotal()
+}
+
+func (o *Order) recalculateTotal() {
+	var total int64
+	for _, item := range o.items {
+		total += cents(item.subtotal)
+	}
+	o.total = fromCents(total, o.total.Currency)
+}
+
+func (o *Order) part() OrderPart {
+	return OrderPart{
+		OrderID:      o.id.String(),
+		TicketNumber: o.ticketNumber.String(),
+		TableNumber:  o.tableNumber.String(),
+		Total:        o.total.Amount,
+	}
+}
+
+// cents converts money to whole cents, so splitting it loses nothing to rounding
+func cents(m shared.Money) int64 {
+	return int64(math.Round(m.Amount * 100))
+}
+
+func fromCents(c int64, currency string) shared.Money {
+	return shared.Money{Amount: float64(c) / 100, Currency: currency}
+}
+
+func unionAllergies(a, b []product.Allergen) []product.Allergen {
+	union := append([]product.Allergen{}, a...)
+	for _, allergen := range b {
+		found := false
+		for _, existing := range union {
+			if existing == allergen {
+				found = true
+				break
+			}
+		}
+		if !found {
+			union = append(union, allergen)
+		}
+	}
+	return union
+}
+
+// prepared reports whether the kitchen is done with every item
+func (o *Order) prepared() bool {
+	for _, item := range o.items {
+		if !item.status.IsFinished() {
+			return false
+		}
+	}
+	return true
+}
+
 func (o *Order) IsPending() bool {
 	return o.status == StatusPending || o.status == StatusPreparing
 }
 
+// IsOpen reports whether the order is still to be paid, so its table is taken
+func (o *Order) IsOpen() bool {
+	return o.status != StatusCompleted && o.status != StatusCancelled && o.status != StatusMerged
+}
+
 func (o *Order) IsCompleted() bool {
 	return o.status == StatusCompleted
 }
+
+// TotalCost returns the cost of goods sold for the whole order
+func (o *Order) TotalCost() shared.Money {
+	total := shared.Money{Amount: 0, Currency: o.total.Currency}
+	for _, item := range o.items {
+		total = total.Add(item.CostTotal())
+	}
+	return total.Round()
+}
+
+func ReconstructOrder(
+	id shared.OrderID,
+	tableNumber TableNumber,
+	channel Channel,
+	ticketNumber TicketNumber,
+	businessDay BusinessDayID,
+	terminalID string,
+	items []*OrderItem,
+	status OrderStatus,
+	total shared.Money,
+	declaredAllergies []product.Allergen,
+	paymentMethod PaymentMethod,
+	customerEmail string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Order {
+	return &Order{
+		id:                id,
+		tableNumber:       tableNumber,
+		channel:           channel,
+		ticketNumber:      ticketNumber,
+		businessDay:       businessDay,
+		terminalID:        terminalID,
+		items:             items,
+		status:            status,
+		total:             total,
+		declaredAllergies: declaredAllergies,
+		paymentMethod:     paymentMethod,
+		customerEmail:     customerEmail,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
+	}
+}
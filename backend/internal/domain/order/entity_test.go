@@ -0,0 +1,311 @@
+package order
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"errors"
+	"testing"
+)
+
+// testLine is an item of a test order: a quantity at a unit price in cents
+type testLine struct {
+	quantity  float64
+	unitCents int64
+}
+
+func newTestOrder(t *testing.T, lines ...testLine) *Order {
+	t.Helper()
+
+	var items []*OrderItem
+	for i, line := range lines {
+		item, err := NewOrderItem(
+			shared.ProductID(string(rune('a'+i))),
+			line.quantity,
+			fromCents(line.unitCents, "USD"),
+			fromCents(0, "USD"),
+		)
+		if err != nil {
+			t.Fatalf("NewOrderItem() error: %v", err)
+		}
+		items = append(items, item)
+	}
+
+	ord, err := NewOrder("order-1", "T1", ChannelTakeaway, Ticket{Number: NewTicketNumber("T", 1)}, items)
+	if err != nil {
+		t.Fatalf("NewOrder() error: %v", err)
+	}
+	return ord
+}
+
+func testParts(n int) []SplitPart {
+	parts := make([]SplitPart, n)
+	for i := range parts {
+		parts[i] = SplitPart{
+			ID:     shared.OrderID(string(rune('p' + i))),
+			Ticket: Ticket{Number: NewTicketNumber("T", i+2)},
+		}
+	}
+	return parts
+}
+
+// subtotalCents lists the subtotal of every line of an order, in cents
+func subtotalCents(ord *Order) []int64 {
+	var subtotals []int64
+	for _, item := range ord.Items() {
+		subtotals = append(subtotals, cents(item.Subtotal()))
+	}
+	return subtotals
+}
+
+func equalCents(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSplitItems(t *testing.T) {
+	tests := []struct {
+		name      string
+		lines     []testLine
+		split     []SplitLine
+		wantKept  []int64 // line subtotals left on the order, in cents
+		wantSplit []int64 // line subtotals of the new order, in cents
+	}{
+		{
+			name:      "whole line",
+			lines:     []testLine{{2, 450}, {1, 300}},
+			split:     []SplitLine{{Line: 2}},
+			wantKept:  []int64{900},
+			wantSplit: []int64{300},
+		},
+		{
+			name:      "part of a line at its unit price",
+			lines:     []testLine{{3, 333}},
+			split:     []SplitLine{{Line: 1, Quantity: 1}},
+			wantKept:  []int64{666},
+			wantSplit: []int64{333},
+		},
+		{
+			// 0.333 kg at 9.99 is charged 3.33; 0.111 kg of it at 9.99 rounds to 1.11
+			name:      "part of a weighed line keeps the rounded remainder",
+			lines:     []testLine{{0.333, 999}},
+			split:     []SplitLine{{Line: 1, Quantity: 0.111}},
+			wantKept:  []int64{222},
+			wantSplit: []int64{111},
+		},
+		{
+			// 0.5 kg at 0.99 is charged 0.50; 0.25 kg of it rounds to 0.25
+			name:      "rounding leftovers stay on the order",
+			lines:     []testLine{{0.5, 99}},
+			split:     []SplitLine{{Line: 1, Quantity: 0.25}},
+			wantKept:  []int64{25},
+			wantSplit: []int64{25},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ord := newTestOrder(t, tt.lines...)
+			before := cents(ord.Total())
+
+			split, err := ord.SplitItems(testParts(1)[0], "T2", tt.split, ChangeNote{})
+			if err != nil {
+				t.Fatalf("SplitItems() error: %v", err)
+			}
+
+			if got := subtotalCents(ord); !equalCents(got, tt.wantKept) {
+				t.Errorf("kept subtotals = %v, want %v", got, tt.wantKept)
+			}
+			if got := subtotalCents(split); !equalCents(got, tt.wantSplit) {
+				t.Errorf("split subtotals = %v, want %v", got, tt.wantSplit)
+			}
+			if got := cents(ord.Total()) + cents(split.Total()); got != before {
+				t.Errorf("totals add up to %d cents, want %d", got, before)
+			}
+		})
+	}
+}
+
+func TestSplitItemsRejectsInvalidLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		split []SplitLine
+	}{
+		{name: "no lines", split: nil},
+		{name: "every item", split: []SplitLine{{Line: 1}, {Line: 2}}},
+		{name: "unknown line", split: []SplitLine{{Line: 3}}},
+		{name: "line given twice", split: []SplitLine{{Line: 1, Quantity: 1}, {Line: 1, Quantity: 1}}},
+		{name: "more than the line holds", split: []SplitLine{{Line: 1, Quantity: 3}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ord := newTestOrder(t, testLine{2, 450}, testLine{1, 300})
+
+			if _, err := ord.SplitItems(testParts(1)[0], "T2", tt.split, ChangeNote{}); err == nil {
+				t.Fatal("SplitItems() succeeded, want an error")
+			}
+			if got := subtotalCents(ord); !equalCents(got, []int64{900, 300}) {
+				t.Errorf("order changed to %v after a rejected split", got)
+			}
+		})
+	}
+}
+
+func TestSplitEvenly(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []testLine
+		parts int
+		want  [][]int64 // line subtotals of every part, this order first, in cents
+	}{
+		{
+			name:  "divides exactly",
+			lines: []testLine{{3, 333}},
+			parts: 3,
+			want:  [][]int64{{333}, {333}, {333}},
+		},
+		{
+			name:  "single piece",
+			lines: []testLine{{1, 250}},
+			parts: 3,
+			want:  [][]int64{{84}, {83}, {83}},
+		},
+		{
+			name:  "leftover cents go to the first parts",
+			lines: []testLine{{1, 1001}, {4, 25}},
+			parts: 3,
+			want:  [][]int64{{334, 34}, {334, 33}, {333, 33}},
+		},
+		{
+			// 0.75 kg at 19.99 is charged 14.99
+			name:  "weighed line",
+			lines: []testLine{{0.75, 1999}},
+			parts: 2,
+			want:  [][]int64{{750}, {749}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ord := newTestOrder(t, tt.lines...)
+			before := cents(ord.Total())
+			var quantities []float64
+			for _, item := range ord.Items() {
+				quantities = append(quantities, item.Quantity())
+			}
+
+			splits, err := ord.SplitEvenly(testParts(tt.parts-1), ChangeNote{})
+			if err != nil {
+				t.Fatalf("SplitEvenly() error: %v", err)
+			}
+			if len(splits) != tt.parts-1 {
+				t.Fatalf("SplitEvenly() made %d orders, want %d", len(splits), tt.parts-1)
+			}
+
+			if got := subtotalCents(ord); !equalCents(got, tt.want[0]) {
+				t.Errorf("kept subtotals = %v, want %v", got, tt.want[0])
+			}
+			for i, item := range ord.Items() {
+				if item.Quantity() != quantities[i] {
+					t.Errorf("line %d quantity = %g, want %g", item.Line(), item.Quantity(), quantities[i])
+				}
+			}
+
+			total := cents(ord.Total())
+			for i, split := range splits {
+				if got := subtotalCents(split); !equalCents(got, tt.want[i+1]) {
+					t.Errorf("part %d subtotals = %v, want %v", i+2, got, tt.want[i+1])
+				}
+				for _, item := range split.Items() {
+					if item.Quantity() != 0 || !item.Status().IsFinished() {
+						t.Errorf("part %d line %d has quantity %g and status %s, want a billing share", i+2, item.Line(), item.Quantity(), item.Status())
+					}
+				}
+				total += cents(split.Total())
+			}
+			if total != before {
+				t.Errorf("totals add up to %d cents, want %d", total, before)
+			}
+		})
+	}
+}
+
+func TestSplitEvenlyRejectsSinglePart(t *testing.T) {
+	ord := newTestOrder(t, testLine{1, 250})
+
+	if _, err := ord.SplitEvenly(nil, ChangeNote{}); !errors.Is(err, shared.ErrInvalidInput) {
+		t.Errorf("SplitEvenly() error = %v, want %v", err, shared.ErrInvalidInput)
+	}
+	if got := subtotalCents(ord); !equalCents(got, []int64{250}) {
+		t.Errorf("order changed to %v after a rejected split", got)
+	}
+}
+
+func TestMergeStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		target     OrderStatus
+		other      OrderStatus
+		wantStatus OrderStatus
+		wantErr    error
+	}{
+		{name: "pending into pending", target: StatusPending, other: StatusPending, wantStatus: StatusPending},
+		{name: "ready into pending starts preparing", target: StatusPending, other: StatusReady, wantStatus: StatusPreparing},
+		{name: "pending into preparing", target: StatusPreparing, other: StatusPending, wantStatus: StatusPreparing},
+		{name: "ready into ready", target: StatusReady, other: StatusReady, wantStatus: StatusReady},
+		{name: "pending into ready", target: StatusReady, other: StatusPending, wantErr: shared.ErrOrderNotModifiable},
+		{name: "preparing into ready", target: StatusReady, other: StatusPreparing, wantErr: shared.ErrOrderNotModifiable},
+	}
+
+	// advance moves a new order through the kitchen to the given status
+	advance := func(t *testing.T, ord *Order, status OrderStatus) {
+		t.Helper()
+		for _, step := range []OrderStatus{StatusPreparing, StatusReady} {
+			if ord.Status() == status {
+				return
+			}
+			if err := ord.UpdateStatus(step); err != nil {
+				t.Fatalf("UpdateStatus(%s) error: %v", step, err)
+			}
+		}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := newTestOrder(t, testLine{1, 250})
+			other := newTestOrder(t, testLine{2, 300})
+			other.id = "order-2"
+			advance(t, target, tt.target)
+			advance(t, other, tt.other)
+
+			err := target.Merge([]*Order{other}, ChangeNote{})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Merge() error = %v, want %v", err, tt.wantErr)
+				}
+				if target.Status() != tt.target || len(target.Items()) != 1 {
+					t.Errorf("target changed to %s with %d items after a rejected merge", target.Status(), len(target.Items()))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Merge() error: %v", err)
+			}
+			if target.Status() != tt.wantStatus {
+				t.Errorf("target status = %s, want %s", target.Status(), tt.wantStatus)
+			}
+			if other.Status() != StatusMerged {
+				t.Errorf("merged order status = %s, want %s", other.Status(), StatusMerged)
+			}
+			if got := cents(target.Total()); got != 850 {
+				t.Errorf("target total = %d cents, want 850", got)
+			}
+		})
+	}
+}
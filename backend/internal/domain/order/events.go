@@ -0,0 +1,113 @@
+package order
+
+import (
+	"POSFlowBackend/internal/domain/event"
+	"time"
+)
+
+// OrderCreated is recorded when an order is placed
+type OrderCreated struct {
+	OrderID      string    `json:"order_id"`
+	TicketNumber string    `json:"ticket_number"`
+	Channel      Channel   `json:"channel"`
+	TableNumber  string    `json:"table_number"`
+	Total        float64   `json:"total"`
+	ItemCount    int       `json:"item_count"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (e OrderCreated) EventName() event.Topic { return event.TopicOrderCreated }
+func (e OrderCreated) AggregateID() string    { return e.OrderID }
+
+// OrderStatusChanged is recorded whenever the order status moves
+type OrderStatusChanged struct {
+	OrderID      string      `json:"order_id"`
+	TicketNumber string      `json:"ticket_number"`
+	From         OrderStatus `json:"from"`
+	To           OrderStatus `json:"to"`
+	ChangedAt    time.Time   `json:"changed_at"`
+}
+
+func (e OrderStatusChanged) EventName() event.Topic { return event.TopicOrderStatusChanged }
+func (e OrderStatusChanged) AggregateID() string    { return e.OrderID }
+
+// OrderCompleted is recorded when an order is completed and paid
+type OrderCompleted struct {
+	OrderID       string        `json:"order_id"`
+	TicketNumber  string        `json:"ticket_number"`
+	TableNumber   string        `json:"table_number"`
+	Total         float64       `json:"total"`
+	PaymentMethod PaymentMethod `json:"payment_method,omitempty"`
+	CompletedAt   time.Time     `json:"completed_at"`
+}
+
+func (e OrderCompleted) EventName() event.Topic { return event.TopicOrderCompleted }
+func (e OrderCompleted) AggregateID() string    { return e.OrderID }
+
+// ItemStatusChanged is recorded whenever an item moves through preparation
+type ItemStatusChanged struct {
+	OrderID      string     `json:"order_id"`
+	TicketNumber string     `json:"ticket_number"`
+	Line         int        `json:"line"`
+	ProductID    string     `json:"product_id"`
+	From         ItemStatus `json:"from"`
+	To           ItemStatus `json:"to"`
+	ChangedAt    time.Time  `json:"changed_at"`
+}
+
+func (e ItemStatusChanged) EventName() event.Topic { return event.TopicItemStatusChanged }
+func (e ItemStatusChanged) AggregateID() string    { return e.OrderID }
+
+// OrderMoved is recorded when an order is moved to another table
+type OrderMoved struct {
+	OrderID      string    `json:"order_id"`
+	TicketNumber string    `json:"ticket_number"`
+	FromTable    string    `json:"from_table"`
+	ToTable      string    `json:"to_table"`
+	Total        float64   `json:"total"`
+	StaffMember  string    `json:"staff_member,omitempty"`
+	Note         string    `json:"note,omitempty"`
+	MovedAt      time.Time `json:"moved_at"`
+}
+
+func (e OrderMoved) EventName() event.Topic { return event.TopicOrderMoved }
+func (e OrderMoved) AggregateID() string    { return e.OrderID }
+
+// OrderPart identifies an order taking part in a merge or split
+type OrderPart struct {
+	OrderID      string  `json:"order_id"`
+	TicketNumber string  `json:"ticket_number"`
+	TableNumber  string  `json:"table_number"`
+	Total        float64 `json:"total"`
+}
+
+// OrdersMerged is recorded on the order that other orders were merged into
+type OrdersMerged struct {
+	OrderID      string      `json:"order_id"`
+	TicketNumber string      `json:"ticket_number"`
+	TableNumber  string      `json:"table_number"`
+	Merged       []OrderPart `json:"merged"`
+	Total        float64     `json:"total"`
+	StaffMember  string      `json:"staff_member,omitempty"`
+	Note         string      `json:"note,omitempty"`
+	MergedAt     time.Time   `json:"merged_at"`
+}
+
+func (e OrdersMerged) EventName() event.Topic { return event.TopicOrdersMerged }
+func (e OrdersMerged) AggregateID() string    { return e.OrderID }
+
+// OrderSplit is recorded on an order when parts of it are split off into new orders
+type OrderSplit struct {
+	OrderID      string      `json:"order_id"`
+	TicketNumber string      `json:"ticket_number"`
+	TableNumber  string      `json:"table_number"`
+	Mode         SplitMode   `json:"mode"`
+	Parts        []OrderPart `json:"parts"`
+	Total        float64     `json:"total"` // what is left on the order
+	StaffMember  string      `json:"staff_member,omitempty"`
+	Note         string      `json:"note,omitempty"`
+	SplitAt      time.Time   `json:"split_at"`
+}
+
+func (e OrderSplit) EventName() event.Topic { return event.TopicOrderSplit }
+func (e OrderSplit) AggregateID() string    { return e.OrderID }
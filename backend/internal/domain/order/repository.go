@@ -1,6 +1,9 @@
 package order
 
 import (
+	"POSFlowBackend/internal/domain/ingredient"
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
 	"POSFlowBackend/internal/domain/shared"
 	"time"
 )
@@ -8,9 +11,37 @@ import (
 // OrderRepository defines the interface for order persistence
 type OrderRepository interface {
 	Save(order *Order) error
+	// SaveAll saves the orders of a merge or split together, or none of them
+	SaveAll(orders []*Order) error
 	FindByID(id shared.OrderID) (*Order, error)
 	FindAll() ([]*Order, error)
 	FindPending() ([]*Order, error)
+	// FindOpen returns the orders not yet completed or cancelled, oldest first
+	FindOpen() ([]*Order, error)
 	FindByStatus(status OrderStatus) ([]*Order, error)
 	FindByDateRange(start, end time.Time) ([]*Order, error)
+	FindByBusinessDay(day BusinessDayID) ([]*Order, error)
+	FindByTicket(day BusinessDayID, ticket TicketNumber) ([]*Order, error)
+	ExistsByProduct(productID shared.ProductID) (bool, error)
+	// FindAudit returns the moves, merges and splits of an order, oldest first
+	FindAudit(id shared.OrderID) ([]AuditRecord, error)
+}
+
+// Stores are the repositories an order is placed through, all bound to
+// the same transaction
+type Stores struct {
+	Orders      OrderRepository
+	Tickets     TicketRepository
+	Products    product.ProductRepository
+	Ingredients ingredient.IngredientRepository
+	Recipes     ingredient.RecipeRepository
+	Locations   location.LocationRepository
+	StockLevels location.StockLevelRepository
+	Transfers   location.TransferRepository
+}
+
+// UnitOfWork runs fn in a single transaction, committed only when fn
+// returns nil
+type UnitOfWork interface {
+	Do(fn func(stores Stores) error) error
 }
@@ -1,73 +1,245 @@
 package order
 
 import (
+	"POSFlowBackend/internal/domain/ingredient"
+	"POSFlowBackend/internal/domain/kitchen"
+	"POSFlowBackend/internal/domain/location"
 	"POSFlowBackend/internal/domain/product"
 	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/table"
+	"fmt"
 )
 
 // OrderService contains domain logic for orders
 type OrderService struct {
-	orderRepo   OrderRepository
-	productRepo product.ProductRepository
+	uow       UnitOfWork
+	orderRepo OrderRepository
+	routing   *kitchen.RoutingService
+	tickets   *TicketService
+	floor     *table.FloorService
 }
 
-func NewOrderService(orderRepo OrderRepository, productRepo product.ProductRepository) *OrderService {
+func NewOrderService(
+	uow UnitOfWork,
+	orderRepo OrderRepository,
+	routing *kitchen.RoutingService,
+	tickets *TicketService,
+	floor *table.FloorService,
+) *OrderService {
 	return &OrderService{
-		orderRepo:   orderRepo,
-		productRepo: productRepo,
+		uow:       uow,
+		orderRepo: orderRepo,
+		routing:   routing,
+		tickets:   tickets,
+		floor:     floor,
 	}
 }
 
-// CreateOrder handles order creation with stock validation
+// CreateOrder handles order creation with stock validation.
+// Products with a recipe consume their ingredients; other products
+// consume their own stock from the location serving the terminal or the
+// product category. Everything is read and written in one transaction,
+// so nothing is saved unless all stock is available and the order is
+// placed. Items containing any of the declared allergies are flagged and
+// returned as warnings; they do not prevent the order from being placed.
+// Dine-in orders must be placed at a table on the floor plan; other
+// channels may leave the table out. The ticket number is issued last,
+// once the order is known to be valid.
 func (s *OrderService) CreateOrder(
 	id shared.OrderID,
 	tableNumber TableNumber,
+	channel Channel,
+	terminalID string,
 	itemRequests []struct {
 		ProductID shared.ProductID
-		Quantity  int
+		Quantity  float64
 	},
+	declaredAllergies []product.Allergen,
+	customerEmail string,
+) (*Order, []AllergenWarning, error) {
+
+	if !channel.IsValid() {
+		return nil, nil, fmt.Errorf("%w: unknown channel %s", shared.ErrInvalidInput, channel)
+	}
+
+	// Check the table is on the floor plan
+	if err := s.floor.ValidateTable(tableNumber.String(), channel == ChannelDineIn); err != nil {
+		return nil, nil, err
+	}
+
+	var order *Order
+	err := s.uow.Do(func(stores Stores) error {
+		var err error
+		order, err = s.placeOrder(stores, id, tableNumber, channel, terminalID, itemRequests, declaredAllergies, customerEmail)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return order, order.AllergenWarnings(), nil
+}
+
+// placeOrder builds and saves an order through repositories bound to one transaction
+func (s *OrderService) placeOrder(
+	stores Stores,
+	id shared.OrderID,
+	tableNumber TableNumber,
+	channel Channel,
+	terminalID string,
+	itemRequests []struct {
+		ProductID shared.ProductID
+		Quantity  float64
+	},
+	declaredAllergies []product.Allergen,
+	customerEmail string,
 ) (*Order, error) {
+	inventory := ingredient.NewInventoryService(stores.Ingredients, stores.Recipes, stores.Products)
+	stock := location.NewStockService(stores.Locations, stores.StockLevels, stores.Transfers, stores.Products)
+	tickets := NewTicketService(stores.Tickets, s.tickets.prefixes)
 
 	var orderItems []*OrderItem
+	var allocations []location.Allocation
+	products := map[shared.ProductID]*product.Product{}
+	requirements := map[ingredient.IngredientID]float64{}
 
-	// Validate stock and create order items
+	// Validate products and create order items
 	for _, req := range itemRequests {
-		product, err := s.productRepo.FindByID(req.ProductID)
+		prod, ok := products[req.ProductID]
+		if !ok {
+			var err error
+			prod, err = stores.Products.FindByID(req.ProductID)
+			if err != nil {
+				return nil, err
+			}
+			products[req.ProductID] = prod
+		}
+
+		if !prod.IsActive() {
+			return nil, shared.ErrInvalidInput
+		}
+
+		if !prod.IsAvailable() {
+			return nil, shared.ErrInsufficientStock
+		}
+
+		// Validate the quantity against the product's unit precision
+		if err := prod.ValidateQuantity(req.Quantity); err != nil {
+			return nil, err
+		}
+
+		// Create order item, snapshotting current price and cost
+		item, err := NewOrderItem(req.ProductID, req.Quantity, prod.Price(), prod.Cost())
 		if err != nil {
 			return nil, err
 		}
 
-		if !product.IsActive() {
-			return nil, shared.ErrInvalidInput
+		item.FlagAllergens(prod.ConflictingAllergens(declaredAllergies))
+		if prod.IsWeighed() {
+			item.MarkWeighed()
 		}
 
-		// Check stock availability
-		if err := product.DecreaseStock(req.Quantity); err != nil {
+		// Route to the kitchen station preparing the product
+		station, err := s.routing.ResolveStation(prod)
+		if err != nil {
 			return nil, err
 		}
+		if station != nil {
+			item.AssignStation(station.ID())
+		}
 
-		// Create order item
-		item, err := NewOrderItem(req.ProductID, req.Quantity, product.Price())
+		recipe, err := inventory.FindRecipe(req.ProductID)
 		if err != nil {
 			return nil, err
 		}
 
+		if recipe != nil {
+			// Accumulate ingredient requirements
+			for ingredientID, quantity := range recipe.Requirements(req.Quantity) {
+				requirements[ingredientID] += quantity
+			}
+		} else {
+			// Allocate product stock from the serving location
+			loc, err := stock.ResolveLocation(terminalID, prod.Category())
+			if err != nil {
+				return nil, err
+			}
+			item.AssignLocation(loc.ID())
+			allocations = append(allocations, location.Allocation{
+				LocationID: loc.ID(),
+				ProductID:  req.ProductID,
+				Quantity:   req.Quantity,
+			})
+		}
+
 		orderItems = append(orderItems, item)
+	}
 
-		// Save updated product stock
-		if err := s.productRepo.Save(product); err != nil {
+	// Check stock availability per location, then against product totals
+	if err := stock.CheckAvailability(allocations); err != nil {
+		return nil, err
+	}
+
+	withdrawn := map[shared.ProductID]float64{}
+	for _, a := range allocations {
+		if err := products[a.ProductID].DecreaseStock(a.Quantity); err != nil {
 			return nil, err
 		}
+		withdrawn[a.ProductID] = shared.RoundQuantity(withdrawn[a.ProductID] + a.Quantity)
+	}
+
+	// Check ingredient availability
+	if err := inventory.CheckAvailability(requirements); err != nil {
+		return nil, err
+	}
+
+	// Number the ticket within the business day
+	ticket, err := tickets.Issue(channel)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create order
-	order, err := NewOrder(id, tableNumber, orderItems)
+	order, err := NewOrder(id, tableNumber, channel, ticket, orderItems)
 	if err != nil {
 		return nil, err
 	}
 
+	order.AssignTerminal(terminalID)
+
+	if err := order.DeclareAllergies(declaredAllergies); err != nil {
+		return nil, err
+	}
+
+	if err := order.SetCustomerEmail(customerEmail); err != nil {
+		return nil, err
+	}
+
+	// Take product stock with a guarded update, so the order fails rather
+	// than oversells, then save the products with their lots and events
+	for productID, quantity := range withdrawn {
+		if err := stores.Products.WithdrawStock(productID, quantity); err != nil {
+			return nil, err
+		}
+	}
+	for _, prod := range products {
+		if err := stores.Products.Save(prod); err != nil {
+			return nil, err
+		}
+	}
+
+	// Withdraw stock from locations
+	if err := stock.Withdraw(allocations); err != nil {
+		return nil, err
+	}
+
+	// Consume ingredients
+	if err := inventory.Consume(requirements); err != nil {
+		return nil, err
+	}
+
 	// Save order
-	if err := s.orderRepo.Save(order); err != nil {
+	if err := stores.Orders.Save(order); err != nil {
 		return nil, err
 	}
 
@@ -78,3 +250,232 @@ func (s *OrderService) CreateOrder(
 func (s *OrderService) GetPendingOrders() ([]*Order, error) {
 	return s.orderRepo.FindPending()
 }
+
+// GetStationQueue returns the pending orders with items still to prepare
+// at a station, oldest first
+func (s *OrderService) GetStationQueue(stationID kitchen.StationID) ([]*Order, error) {
+	orders, err := s.orderRepo.FindPending()
+	if err != nil {
+		return nil, err
+	}
+
+	var queue []*Order
+	for _, ord := range orders {
+		if ord.AwaitsStation(stationID) {
+			queue = append(queue, ord)
+		}
+	}
+	return queue, nil
+}
+
+// BumpStation marks a station's part of an order as done
+func (s *OrderService) BumpStation(orderID shared.OrderID, stationID kitchen.StationID) (*Order, error) {
+	ord, err := s.orderRepo.FindByID(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ord.BumpStation(stationID); err != nil {
+		return nil, err
+	}
+
+	if err := s.orderRepo.Save(ord); err != nil {
+		return nil, err
+	}
+
+	return ord, nil
+}
+
+// MoveOrder moves an open order to another table on the floor plan
+func (s *OrderService) MoveOrder(id shared.OrderID, tableNumber TableNumber, note ChangeNote) (*Order, error) {
+	var ord *Order
+	err := s.uow.Do(func(stores Stores) error {
+		var err error
+		ord, err = stores.Orders.FindByID(id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.floor.ValidateTable(tableNumber.String(), ord.Channel() == ChannelDineIn); err != nil {
+			return err
+		}
+
+		if err := ord.MoveToTable(tableNumber, note); err != nil {
+			return err
+		}
+
+		return stores.Orders.Save(ord)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ord, nil
+}
+
+// MergeOrders merges other open orders into the target order. The items
+// keep their price snapshots, and their stock stays taken as the quantities
+// do not change. Allergen warnings are flagged again, as the merged order
+// carries the allergies declared on every order. All the orders are saved
+// in one transaction.
+func (s *OrderService) MergeOrders(targetID shared.OrderID, ids []shared.OrderID, note ChangeNote) (*Order, []*Order, error) {
+	var target *Order
+	var merged []*Order
+	err := s.uow.Do(func(stores Stores) error {
+		var err error
+		target, err = stores.Orders.FindByID(targetID)
+		if err != nil {
+			return err
+		}
+
+		merged = nil
+		for _, id := range ids {
+			ord, err := stores.Orders.FindByID(id)
+			if err != nil {
+				return err
+			}
+			merged = append(merged, ord)
+		}
+
+		if err := target.Merge(merged, note); err != nil {
+			return err
+		}
+
+		if err := flagAllergens(stores.Products, target); err != nil {
+			return err
+		}
+
+		return stores.Orders.SaveAll(append([]*Order{target}, merged...))
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return target, merged, nil
+}
+
+// SplitByItems moves some of an order's items into a new order, at the same
+// table unless another is given. The new order gets its own ticket number,
+// issued in the same transaction that saves both orders.
+func (s *OrderService) SplitByItems(
+	id shared.OrderID,
+	newID shared.OrderID,
+	tableNumber TableNumber,
+	lines []SplitLine,
+	note ChangeNote,
+) (*Order, *Order, error) {
+	var ord, split *Order
+	err := s.uow.Do(func(stores Stores) error {
+		var err error
+		ord, err = stores.Orders.FindByID(id)
+		if err != nil {
+			return err
+		}
+
+		table := tableNumber
+		if table == "" {
+			table = ord.TableNumber()
+		} else if err := s.floor.ValidateTable(table.String(), ord.Channel() == ChannelDineIn); err != nil {
+			return err
+		}
+
+		if err := ord.CheckSplitItems(lines); err != nil {
+			return err
+		}
+
+		// Check the quantities split off a line suit the product's unit
+		for _, line := range lines {
+			if line.Quantity == 0 {
+				continue
+			}
+			item, err := ord.Item(line.Line)
+			if err != nil {
+				return err
+			}
+			prod, err := stores.Products.FindByIDIncludingArchived(item.ProductID())
+			if err != nil {
+				return err
+			}
+			if err := prod.ValidateQuantity(line.Quantity); err != nil {
+				return err
+			}
+		}
+
+		tickets := NewTicketService(stores.Tickets, s.tickets.prefixes)
+		ticket, err := tickets.Issue(ord.Channel())
+		if err != nil {
+			return err
+		}
+
+		split, err = ord.SplitItems(SplitPart{ID: newID, Ticket: ticket}, table, lines, note)
+		if err != nil {
+			return err
+		}
+
+		return stores.Orders.SaveAll([]*Order{ord, split})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ord, split, nil
+}
+
+// SplitEvenly splits an order's bill into equal parts, one per new ID plus
+// the order itself. Each new order gets its own ticket number, issued in the
+// same transaction that saves every part.
+func (s *OrderService) SplitEvenly(id shared.OrderID, newIDs []shared.OrderID, note ChangeNote) (*Order, []*Order, error) {
+	var ord *Order
+	var splits []*Order
+	err := s.uow.Do(func(stores Stores) error {
+		var err error
+		ord, err = stores.Orders.FindByID(id)
+		if err != nil {
+			return err
+		}
+		// Check the split before numbering the new tickets
+		if err := ord.CheckSplitEvenly(len(newIDs) + 1); err != nil {
+			return err
+		}
+
+		tickets := NewTicketService(stores.Tickets, s.tickets.prefixes)
+		var parts []SplitPart
+		for _, newID := range newIDs {
+			ticket, err := tickets.Issue(ord.Channel())
+			if err != nil {
+				return err
+			}
+			parts = append(parts, SplitPart{ID: newID, Ticket: ticket})
+		}
+
+		splits, err = ord.SplitEvenly(parts, note)
+		if err != nil {
+			return err
+		}
+
+		return stores.Orders.SaveAll(append([]*Order{ord}, splits...))
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ord, splits, nil
+}
+
+// flagAllergens checks every item against the order's declared allergies
+func flagAllergens(productRepo product.ProductRepository, ord *Order) error {
+	products := map[shared.ProductID]*product.Product{}
+	for _, item := range ord.Items() {
+		prod, ok := products[item.ProductID()]
+		if !ok {
+			var err error
+			prod, err = productRepo.FindByIDIncludingArchived(item.ProductID())
+			if err != nil {
+				return err
+			}
+			products[item.ProductID()] = prod
+		}
+		item.FlagAllergens(prod.ConflictingAllergens(ord.DeclaredAllergies()))
+	}
+	return nil
+}
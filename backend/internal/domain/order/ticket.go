@@ -0,0 +1,135 @@
+package order
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// BusinessDay is the span between two day closes; ticket numbers restart
+// with each one. A day opens with its first order.
+type BusinessDay struct {
+	id       BusinessDayID
+	openedAt time.Time
+	closedAt *time.Time
+}
+
+func ReconstructBusinessDay(id BusinessDayID, openedAt time.Time, closedAt *time.Time) *BusinessDay {
+	return &BusinessDay{
+		id:       id,
+		openedAt: openedAt,
+		closedAt: closedAt,
+	}
+}
+
+// Getters
+func (d *BusinessDay) ID() BusinessDayID    { return d.id }
+func (d *BusinessDay) OpenedAt() time.Time  { return d.openedAt }
+func (d *BusinessDay) ClosedAt() *time.Time { return d.closedAt }
+func (d *BusinessDay) IsOpen() bool         { return d.closedAt == nil }
+
+// Ticket is the number an order is called out by
+type Ticket struct {
+	BusinessDay BusinessDayID
+	Number      TicketNumber
+}
+
+// TicketRepository hands out ticket numbers
+type TicketRepository interface {
+	// NextNumber returns the next number of a sequence within the open
+	// business day, opening a day first if none is open. Both happen
+	// atomically, so concurrent orders never share a number.
+	NextNumber(sequence string, at time.Time) (BusinessDayID, int, error)
+	// CurrentDay returns the open business day, or nil when none is open
+	CurrentDay() (*BusinessDay, error)
+	FindDay(id BusinessDayID) (*BusinessDay, error)
+	// CloseDay closes the open business day, or returns nil when none is open
+	CloseDay(at time.Time) (*BusinessDay, error)
+}
+
+// TicketService numbers orders per business day. Channels with a prefix
+// count on their own; channels without one share the plain sequence, so
+// numbers never repeat within a day.
+type TicketService struct {
+	repo     TicketRepository
+	prefixes map[Channel]string
+}
+
+func NewTicketService(repo TicketRepository, prefixes map[Channel]string) *TicketService {
+	return &TicketService{
+		repo:     repo,
+		prefixes: prefixes,
+	}
+}
+
+// Issue hands out the next ticket for a channel
+func (s *TicketService) Issue(channel Channel) (Ticket, error) {
+	prefix := s.prefixes[channel]
+
+	day, number, err := s.repo.NextNumber(prefix, time.Now())
+	if err != nil {
+		return Ticket{}, err
+	}
+
+	return Ticket{BusinessDay: day, Number: NewTicketNumber(prefix, number)}, nil
+}
+
+// CurrentDay returns the open business day, or nil when none is open
+func (s *TicketService) CurrentDay() (*BusinessDay, error) {
+	return s.repo.CurrentDay()
+}
+
+// FindDay returns a business day by its ID
+func (s *TicketService) FindDay(id BusinessDayID) (*BusinessDay, error) {
+	return s.repo.FindDay(id)
+}
+
+// CloseDay ends the open business day when the sales of the day it opened
+// on, or a later one, are closed, so numbering restarts with the next
+// order. Closing an earlier date leaves the open day alone. Returns the
+// closed day, or nil when nothing was closed.
+func (s *TicketService) CloseDay(salesDate time.Time) (*BusinessDay, error) {
+	day, err := s.repo.CurrentDay()
+	if err != nil || day == nil {
+		return nil, err
+	}
+
+	opened := day.OpenedAt().In(salesDate.Location())
+	openedDate := time.Date(opened.Year(), opened.Month(), opened.Day(), 0, 0, 0, 0, salesDate.Location())
+	closedDate := time.Date(salesDate.Year(), salesDate.Month(), salesDate.Day(), 0, 0, 0, 0, salesDate.Location())
+	if closedDate.Before(openedDate) {
+		return nil, nil
+	}
+
+	return s.repo.CloseDay(time.Now())
+}
+
+// ParseTicketPrefixes reads channel prefixes such as
+// "takeaway=T,delivery=D"; channels left out get plain numbers, and
+// "none" gives every channel plain numbers
+func ParseTicketPrefixes(value string) (map[Channel]string, error) {
+	prefixes := map[Channel]string{}
+	if strings.TrimSpace(value) == "none" {
+		return prefixes, nil
+	}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, prefix, ok := strings.Cut(entry, "=")
+		channel := Channel(strings.TrimSpace(name))
+		prefix = strings.ToUpper(strings.TrimSpace(prefix))
+		if !ok || !channel.IsValid() {
+			return nil, fmt.Errorf("%w: invalid ticket prefix %q", shared.ErrInvalidInput, entry)
+		}
+		if prefix == "" || strings.IndexFunc(prefix, func(r rune) bool { return !unicode.IsLetter(r) }) >= 0 {
+			return nil, fmt.Errorf("%w: ticket prefix for %s must be letters only", shared.ErrInvalidInput, channel)
+		}
+		prefixes[channel] = prefix
+	}
+	return prefixes, nil
+}
@@ -1,5 +1,12 @@
 package order
 
+import (
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"strconv"
+	"strings"
+)
+
 type OrderStatus string
 
 const (
@@ -8,11 +15,12 @@ const (
 	StatusReady     OrderStatus = "ready"
 	StatusCompleted OrderStatus = "completed"
 	StatusCancelled OrderStatus = "cancelled"
+	StatusMerged    OrderStatus = "merged" // its items were moved into another order
 )
 
 func (s OrderStatus) IsValid() bool {
 	switch s {
-	case StatusPending, StatusPreparing, StatusReady, StatusCompleted, StatusCancelled:
+	case StatusPending, StatusPreparing, StatusReady, StatusCompleted, StatusCancelled, StatusMerged:
 		return true
 	}
 	return false
@@ -25,6 +33,7 @@ func (s OrderStatus) CanTransitionTo(newStatus OrderStatus) bool {
 		StatusReady:     {StatusCompleted, StatusCancelled},
 		StatusCompleted: {},
 		StatusCancelled: {},
+		StatusMerged:    {},
 	}
 
 	allowedTransitions := transitions[s]
@@ -36,8 +45,143 @@ func (s OrderStatus) CanTransitionTo(newStatus OrderStatus) bool {
 	return false
 }
 
+// ItemStatus tracks the preparation of a single order item
+type ItemStatus string
+
+const (
+	ItemQueued  ItemStatus = "queued"
+	ItemCooking ItemStatus = "cooking"
+	ItemDone    ItemStatus = "done"
+	ItemServed  ItemStatus = "served"
+)
+
+func (s ItemStatus) IsValid() bool {
+	switch s {
+	case ItemQueued, ItemCooking, ItemDone, ItemServed:
+		return true
+	}
+	return false
+}
+
+func (s ItemStatus) CanTransitionTo(newStatus ItemStatus) bool {
+	transitions := map[ItemStatus][]ItemStatus{
+		ItemQueued:  {ItemCooking, ItemDone},
+		ItemCooking: {ItemDone},
+		ItemDone:    {ItemServed},
+		ItemServed:  {},
+	}
+
+	for _, allowed := range transitions[s] {
+		if allowed == newStatus {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinished reports whether the kitchen is done with the item
+func (s ItemStatus) IsFinished() bool {
+	return s == ItemDone || s == ItemServed
+}
+
 type TableNumber string
 
 func (t TableNumber) String() string {
 	return string(t)
 }
+
+// PaymentMethod is how a completed order was paid
+type PaymentMethod string
+
+const (
+	PaymentCash  PaymentMethod = "cash"
+	PaymentCard  PaymentMethod = "card"
+	PaymentOther PaymentMethod = "other"
+)
+
+func (p PaymentMethod) IsValid() bool {
+	switch p {
+	case PaymentCash, PaymentCard, PaymentOther:
+		return true
+	}
+	return false
+}
+
+// Channel is how an order was placed; it may prefix the ticket number
+type Channel string
+
+const (
+	ChannelDineIn   Channel = "dine_in"
+	ChannelTakeaway Channel = "takeaway"
+	ChannelDelivery Channel = "delivery"
+	ChannelOnline   Channel = "online"
+)
+
+func (c Channel) IsValid() bool {
+	switch c {
+	case ChannelDineIn, ChannelTakeaway, ChannelDelivery, ChannelOnline:
+		return true
+	}
+	return false
+}
+
+// BusinessDayID identifies a business day; ticket numbers restart with each one
+type BusinessDayID uint64
+
+func (d BusinessDayID) String() string {
+	return strconv.FormatUint(uint64(d), 10)
+}
+
+// TicketNumber is the short number called out to the kitchen and customers,
+// such as "42" or "T-42", unique within a business day
+type TicketNumber string
+
+func NewTicketNumber(prefix string, number int) TicketNumber {
+	if prefix == "" {
+		return TicketNumber(strconv.Itoa(number))
+	}
+	return TicketNumber(prefix + "-" + strconv.Itoa(number))
+}
+
+// ParseTicketNumber normalises a ticket number typed by staff, so "t-42"
+// and " T-42 " both find T-42
+func ParseTicketNumber(s string) TicketNumber {
+	return TicketNumber(strings.ToUpper(strings.TrimSpace(s)))
+}
+
+func (t TicketNumber) String() string {
+	return string(t)
+}
+
+// AllergenWarning flags an order item containing a declared allergy
+type AllergenWarning struct {
+	ProductID shared.ProductID
+	Allergens []product.Allergen
+}
+
+// SplitMode is how an order's bill was split
+type SplitMode string
+
+const (
+	SplitByItems SplitMode = "items"
+	SplitEvenly  SplitMode = "even"
+)
+
+// SplitLine selects an order line, or part of its quantity, to split off.
+// A zero quantity takes the whole line.
+type SplitLine struct {
+	Line     int
+	Quantity float64
+}
+
+// SplitPart is a new order created by a split, with its own ticket
+type SplitPart struct {
+	ID     shared.OrderID
+	Ticket Ticket
+}
+
+// ChangeNote records who moved, merged or split an order, and why
+type ChangeNote struct {
+	StaffMember string
+	Note        string
+}
@@ -0,0 +1,15 @@
+package peripheral
+
+// CashDrawer defines the interface for opening the till's cash drawer
+type CashDrawer interface {
+	// Open pulses the drawer's solenoid; it does not report whether the drawer
+	// actually opened, only whether the pulse was sent
+	Open() error
+}
+
+// PoleDisplay defines the interface for a two-line customer-facing display
+type PoleDisplay interface {
+	// Show replaces both lines; text longer than the display is cut off
+	Show(top, bottom string) error
+	Clear() error
+}
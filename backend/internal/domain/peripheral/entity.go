@@ -0,0 +1,92 @@
+package peripheral
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DrawerOpening is the audit record of one attempt to open the cash drawer.
+// Failed attempts are recorded too, so a drawer opened by key after a
+// failed kick still leaves a trace.
+type DrawerOpening struct {
+	id          OpeningID
+	reason      Reason
+	orderID     shared.OrderID // the cash sale, empty for a no sale
+	staffMember string
+	note        string
+	succeeded   bool
+	failure     string
+	openedAt    time.Time
+}
+
+// NewSaleOpening records the drawer opening for a completed cash sale
+func NewSaleOpening(id OpeningID, orderID shared.OrderID) (*DrawerOpening, error) {
+	if orderID == "" {
+		return nil, fmt.Errorf("%w: a sale opening needs an order", shared.ErrInvalidInput)
+	}
+
+	return &DrawerOpening{
+		id:       id,
+		reason:   ReasonSale,
+		orderID:  orderID,
+		openedAt: time.Now(),
+	}, nil
+}
+
+// NewNoSaleOpening records a manual opening; it must say who opened the drawer
+func NewNoSaleOpening(id OpeningID, staffMember, note string) (*DrawerOpening, error) {
+	if strings.TrimSpace(staffMember) == "" {
+		return nil, fmt.Errorf("%w: staff member is required to open the drawer without a sale", shared.ErrInvalidInput)
+	}
+
+	return &DrawerOpening{
+		id:          id,
+		reason:      ReasonNoSale,
+		staffMember: strings.TrimSpace(staffMember),
+		note:        strings.TrimSpace(note),
+		openedAt:    time.Now(),
+	}, nil
+}
+
+// Getters
+func (d *DrawerOpening) ID() OpeningID           { return d.id }
+func (d *DrawerOpening) Reason() Reason          { return d.reason }
+func (d *DrawerOpening) OrderID() shared.OrderID { return d.orderID }
+func (d *DrawerOpening) StaffMember() string     { return d.staffMember }
+func (d *DrawerOpening) Note() string            { return d.note }
+func (d *DrawerOpening) Succeeded() bool         { return d.succeeded }
+func (d *DrawerOpening) Failure() string         { return d.failure }
+func (d *DrawerOpening) OpenedAt() time.Time     { return d.openedAt }
+
+// RecordOutcome stores the result of sending the kick pulse
+func (d *DrawerOpening) RecordOutcome(err error) {
+	d.succeeded = err == nil
+	d.failure = ""
+	if err != nil {
+		d.failure = err.Error()
+	}
+}
+
+func ReconstructDrawerOpening(
+	id OpeningID,
+	reason Reason,
+	orderID shared.OrderID,
+	staffMember string,
+	note string,
+	succeeded bool,
+	failure string,
+	openedAt time.Time,
+) *DrawerOpening {
+	return &DrawerOpening{
+		id:          id,
+		reason:      reason,
+		orderID:     orderID,
+		staffMember: staffMember,
+		note:        note,
+		succeeded:   succeeded,
+		failure:     failure,
+		openedAt:    openedAt,
+	}
+}
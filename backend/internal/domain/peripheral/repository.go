@@ -0,0 +1,12 @@
+package peripheral
+
+import "time"
+
+// DrawerOpeningRepository defines the interface for the cash drawer audit log
+type DrawerOpeningRepository interface {
+	Save(opening *DrawerOpening) error
+	FindByID(id OpeningID) (*DrawerOpening, error)
+	// FindByDateRange returns openings in the range, newest first; an empty
+	// reason matches every opening
+	FindByDateRange(start, end time.Time, reason Reason) ([]*DrawerOpening, error)
+}
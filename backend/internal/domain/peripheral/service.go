@@ -0,0 +1,173 @@
+package peripheral
+
+import (
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// displayStaleAfter is how old an event may be before it is no longer worth
+// showing to the customer; a backlog after an outage shouldn't replay old totals
+const displayStaleAfter = time.Minute
+
+// DrawerService opens the cash drawer and keeps the audit log of every opening
+type DrawerService struct {
+	drawer      CashDrawer
+	openingRepo DrawerOpeningRepository
+}
+
+// NewDrawerService creates the service; drawer may be nil when no drawer is
+// configured, in which case sales don't open anything and a no sale fails
+// with ErrDeviceUnavailable after being recorded
+func NewDrawerService(drawer CashDrawer, openingRepo DrawerOpeningRepository) *DrawerService {
+	return &DrawerService{
+		drawer:      drawer,
+		openingRepo: openingRepo,
+	}
+}
+
+// OpenForSale implements event.Handler for order.completed, opening the
+// drawer when the order was paid in cash. A failed kick is recorded rather
+// than retried: by the time a retry ran the customer would be long gone.
+func (s *DrawerService) OpenForSale(msg event.Message) error {
+	var completed order.OrderCompleted
+	if err := msg.Decode(&completed); err != nil {
+		return err
+	}
+	if completed.PaymentMethod != order.PaymentCash || s.drawer == nil {
+		return nil
+	}
+
+	// The message may be delivered again; open the drawer only once
+	id := OpeningID("sale-" + strconv.FormatUint(msg.ID, 10))
+	if _, err := s.openingRepo.FindByID(id); err == nil {
+		return nil
+	}
+
+	opening, err := NewSaleOpening(id, shared.OrderID(completed.OrderID))
+	if err != nil {
+		return err
+	}
+
+	opening.RecordOutcome(s.drawer.Open())
+	if !opening.Succeeded() {
+		log.Printf("Error opening cash drawer for order %s: %s", completed.OrderID, opening.Failure())
+	}
+
+	return s.openingRepo.Save(opening)
+}
+
+// OpenNoSale opens the drawer without a sale. The opening is recorded even
+// when the kick fails; the error is returned after it has been saved.
+func (s *DrawerService) OpenNoSale(id OpeningID, staffMember, note string) (*DrawerOpening, error) {
+	opening, err := NewNoSaleOpening(id, staffMember, note)
+	if err != nil {
+		return nil, err
+	}
+
+	kickErr := fmt.Errorf("%w: no cash drawer configured", shared.ErrDeviceUnavailable)
+	if s.drawer != nil {
+		kickErr = s.drawer.Open()
+	}
+	opening.RecordOutcome(kickErr)
+
+	if err := s.openingRepo.Save(opening); err != nil {
+		return nil, err
+	}
+	return opening, kickErr
+}
+
+// FindByDays returns drawer openings from the start of the start day to the
+// end of the end day; an empty reason matches every opening
+func (s *DrawerService) FindByDays(start, end time.Time, reason Reason) ([]*DrawerOpening, error) {
+	if reason != "" && !reason.IsValid() {
+		return nil, fmt.Errorf("%w: unknown drawer opening reason %s", shared.ErrInvalidInput, reason)
+	}
+	if start.After(end) {
+		return nil, fmt.Errorf("%w: start date cannot be after end date", shared.ErrInvalidInput)
+	}
+
+	startOfRange := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	endOfRange := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location()).Add(24 * time.Hour)
+	return s.openingRepo.FindByDateRange(startOfRange, endOfRange, reason)
+}
+
+// DisplayService drives the customer pole display
+type DisplayService struct {
+	display  PoleDisplay
+	greeting string
+}
+
+// NewDisplayService creates the service; display may be nil when no pole
+// display is configured. The greeting is shown after a sale is completed.
+func NewDisplayService(display PoleDisplay, greeting string) *DisplayService {
+	return &DisplayService{
+		display:  display,
+		greeting: greeting,
+	}
+}
+
+// ShowTotal implements event.Handler for order.created, showing the order's
+// total. Display errors are logged, not returned: redelivering the event
+// would repeat every other subscriber's work for a cosmetic output.
+func (s *DisplayService) ShowTotal(msg event.Message) error {
+	var created order.OrderCreated
+	if err := msg.Decode(&created); err != nil {
+		return err
+	}
+	if s.display == nil || time.Since(msg.OccurredAt) > displayStaleAfter {
+		return nil
+	}
+
+	s.logFailure(s.display.Show("TOTAL", formatAmount(created.Total)))
+	return nil
+}
+
+// ShowPaid implements event.Handler for order.completed, thanking the
+// customer with the amount paid
+func (s *DisplayService) ShowPaid(msg event.Message) error {
+	var completed order.OrderCompleted
+	if err := msg.Decode(&completed); err != nil {
+		return err
+	}
+	if s.display == nil || time.Since(msg.OccurredAt) > displayStaleAfter {
+		return nil
+	}
+
+	s.logFailure(s.display.Show("PAID "+formatAmount(completed.Total), s.greeting))
+	return nil
+}
+
+// Show puts arbitrary text on the display, e.g. an item as it is rung up
+func (s *DisplayService) Show(top, bottom string) error {
+	if s.display == nil {
+		return fmt.Errorf("%w: no pole display configured", shared.ErrDeviceUnavailable)
+	}
+	if strings.TrimSpace(top) == "" && strings.TrimSpace(bottom) == "" {
+		return fmt.Errorf("%w: nothing to show", shared.ErrInvalidInput)
+	}
+	return s.display.Show(top, bottom)
+}
+
+// Clear blanks the display
+func (s *DisplayService) Clear() error {
+	if s.display == nil {
+		return fmt.Errorf("%w: no pole display configured", shared.ErrDeviceUnavailable)
+	}
+	return s.display.Clear()
+}
+
+func (s *DisplayService) logFailure(err error) {
+	if err != nil {
+		log.Printf("Error updating pole display: %v", err)
+	}
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
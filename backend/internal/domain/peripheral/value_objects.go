@@ -0,0 +1,23 @@
+package peripheral
+
+type OpeningID string
+
+func (o OpeningID) String() string {
+	return string(o)
+}
+
+// Reason records why the cash drawer was opened
+type Reason string
+
+const (
+	ReasonSale   Reason = "sale"    // a cash sale was completed
+	ReasonNoSale Reason = "no_sale" // opened by hand without a sale
+)
+
+func (r Reason) IsValid() bool {
+	switch r {
+	case ReasonSale, ReasonNoSale:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,172 @@
+package printing
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Document is a printout laid out for a paper width, independent of the
+// printer language it is eventually rendered to. Text added to it is
+// wrapped so that every line fits the paper.
+type Document struct {
+	paper PaperWidth
+	lines []Line
+	cut   bool
+}
+
+func NewDocument(paper PaperWidth) *Document {
+	return &Document{paper: paper}
+}
+
+// Getters
+func (d *Document) Paper() PaperWidth { return d.paper }
+func (d *Document) Lines() []Line     { return d.lines }
+
+// Cut reports whether the paper is cut after the document
+func (d *Document) Cut() bool { return d.cut }
+
+// Width returns how many characters fit on a line in the given size
+func (d *Document) Width(large bool) int {
+	if large {
+		return d.paper.Columns() / 2
+	}
+	return d.paper.Columns()
+}
+
+// Add appends a line, wrapping its text at word boundaries when too long
+func (d *Document) Add(line Line) {
+	if line.Align == "" {
+		line.Align = AlignLeft
+	}
+	for _, text := range wrap(line.Text, d.Width(line.Large)) {
+		wrapped := line
+		wrapped.Text = text
+		d.lines = append(d.lines, wrapped)
+	}
+}
+
+// Text appends plain text with the given alignment
+func (d *Document) Text(text string, align Align) {
+	d.Add(Line{Text: text, Align: align})
+}
+
+// Heading appends large, bold, centered text
+func (d *Document) Heading(text string) {
+	d.Add(Line{Text: text, Align: AlignCenter, Bold: true, Large: true})
+}
+
+// Row appends a label on the left and a value flush right, e.g. an item
+// and its price. A label too long for the line continues below it.
+func (d *Document) Row(label, value string, bold bool) {
+	width := d.Width(false)
+	labelWidth := width - utf8.RuneCountInString(value) - 1
+	if labelWidth < 1 {
+		d.Add(Line{Text: label, Bold: bold})
+		d.Add(Line{Text: value, Align: AlignRight, Bold: bold})
+		return
+	}
+
+	labels := wrap(label, labelWidth)
+	first := labels[0]
+	padding := width - utf8.RuneCountInString(first) - utf8.RuneCountInString(value)
+	d.lines = append(d.lines, Line{Text: first + strings.Repeat(" ", padding) + value, Align: AlignLeft, Bold: bold})
+	for _, rest := range labels[1:] {
+		d.lines = append(d.lines, Line{Text: rest, Align: AlignLeft, Bold: bold})
+	}
+}
+
+// Divider appends a dashed line across the paper
+func (d *Document) Divider() {
+	d.lines = append(d.lines, Line{Text: strings.Repeat("-", d.Width(false)), Align: AlignLeft})
+}
+
+// Blank appends an empty line
+func (d *Document) Blank() {
+	d.lines = append(d.lines, Line{Align: AlignLeft})
+}
+
+// CutPaper asks for the paper to be cut once the document is printed
+func (d *Document) CutPaper() {
+	d.cut = true
+}
+
+// PlainText renders the document as it will look on paper, one string per
+// printed line. Large lines are shown at normal size.
+func (d *Document) PlainText() string {
+	var b strings.Builder
+	width := d.Width(false)
+	for _, line := range d.lines {
+		b.WriteString(strings.TrimRight(pad(line.Text, line.Align, width), " "))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func ReconstructDocument(paper PaperWidth, lines []Line, cut bool) *Document {
+	return &Document{
+		paper: paper,
+		lines: lines,
+		cut:   cut,
+	}
+}
+
+// pad aligns text within the given width
+func pad(text string, align Align, width int) string {
+	space := width - utf8.RuneCountInString(text)
+	if space <= 0 {
+		return text
+	}
+	switch align {
+	case AlignCenter:
+		return strings.Repeat(" ", space/2) + text + strings.Repeat(" ", space-space/2)
+	case AlignRight:
+		return strings.Repeat(" ", space) + text
+	}
+	return text + strings.Repeat(" ", space)
+}
+
+// wrap splits text into lines of at most width characters, breaking at
+// spaces where possible and cutting words longer than a line. Leading
+// spaces indent every resulting line.
+func wrap(text string, width int) []string {
+	indent := text[:len(text)-len(strings.TrimLeft(text, " "))]
+	if indent != "" && len(indent) < width {
+		lines := wrap(text[len(indent):], width-len(indent))
+		for i := range lines {
+			lines[i] = indent + lines[i]
+		}
+		return lines
+	}
+
+	words := strings.Fields(text)
+	if len(words) == 0 || width < 1 {
+		return []string{""}
+	}
+
+	var lines []string
+	current := ""
+	for _, word := range words {
+		for utf8.RuneCountInString(word) > width {
+			if current != "" {
+				lines = append(lines, current)
+				current = ""
+			}
+			runes := []rune(word)
+			lines = append(lines, string(runes[:width]))
+			word = string(runes[width:])
+		}
+		switch {
+		case current == "":
+			current = word
+		case utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) <= width:
+			current += " " + word
+		default:
+			lines = append(lines, current)
+			current = word
+		}
+	}
+	if current != "" {
+		lines = append(lines, current)
+	}
+	return lines
+}
@@ -0,0 +1,245 @@
+package printing
+
+import (
+	"POSFlowBackend/internal/domain/kitchen"
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// KitchenPrinter is a printer in the kitchen or at the bar that prints the
+// chits of the stations it serves. Items reach a station through the
+// station's product and category routing, so the bar printer serving a
+// bar station that prepares drinks gets every drink ordered.
+type KitchenPrinter struct {
+	id        PrinterID
+	name      string
+	driver    Driver
+	address   string // host:port for tcp, a path for file
+	paper     PaperWidth
+	stations  []kitchen.StationID
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewKitchenPrinter(id PrinterID, name string, driver Driver, address string, paper PaperWidth, stations []kitchen.StationID) (*KitchenPrinter, error) {
+	printer := &KitchenPrinter{
+		id:        id,
+		createdAt: time.Now(),
+		updatedAt: time.Now(),
+	}
+
+	if err := printer.Rename(name); err != nil {
+		return nil, err
+	}
+	if err := printer.Connect(driver, address, paper); err != nil {
+		return nil, err
+	}
+	printer.AssignStations(stations)
+
+	return printer, nil
+}
+
+// Getters
+func (p *KitchenPrinter) ID() PrinterID                 { return p.id }
+func (p *KitchenPrinter) Name() string                  { return p.name }
+func (p *KitchenPrinter) Driver() Driver                { return p.driver }
+func (p *KitchenPrinter) Address() string               { return p.address }
+func (p *KitchenPrinter) Paper() PaperWidth             { return p.paper }
+func (p *KitchenPrinter) Stations() []kitchen.StationID { return p.stations }
+func (p *KitchenPrinter) CreatedAt() time.Time          { return p.createdAt }
+func (p *KitchenPrinter) UpdatedAt() time.Time          { return p.updatedAt }
+
+// Business methods
+func (p *KitchenPrinter) Rename(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("%w: printer name is required", shared.ErrInvalidInput)
+	}
+	p.name = name
+	p.updatedAt = time.Now()
+	return nil
+}
+
+// Connect sets how the printer is reached and the paper it is loaded with
+func (p *KitchenPrinter) Connect(driver Driver, address string, paper PaperWidth) error {
+	if !driver.IsValid() {
+		return fmt.Errorf("%w: unknown printer driver %s", shared.ErrInvalidInput, driver)
+	}
+	if driver != DriverMemory && strings.TrimSpace(address) == "" {
+		return fmt.Errorf("%w: %s printers need an address", shared.ErrInvalidInput, driver)
+	}
+	if !paper.IsValid() {
+		return fmt.Errorf("%w: paper width must be %s or %s", shared.ErrInvalidInput, Paper58mm, Paper80mm)
+	}
+
+	p.driver = driver
+	p.address = strings.TrimSpace(address)
+	p.paper = paper
+	p.updatedAt = time.Now()
+	return nil
+}
+
+// AssignStations sets the stations whose chits the printer prints
+func (p *KitchenPrinter) AssignStations(stations []kitchen.StationID) {
+	clean := []kitchen.StationID{}
+	seen := make(map[kitchen.StationID]bool)
+	for _, stationID := range stations {
+		if !seen[stationID] {
+			seen[stationID] = true
+			clean = append(clean, stationID)
+		}
+	}
+	p.stations = clean
+	p.updatedAt = time.Now()
+}
+
+func (p *KitchenPrinter) Serves(stationID kitchen.StationID) bool {
+	for _, s := range p.stations {
+		if s == stationID {
+			return true
+		}
+	}
+	return false
+}
+
+func ReconstructKitchenPrinter(
+	id PrinterID,
+	name string,
+	driver Driver,
+	address string,
+	paper PaperWidth,
+	stations []kitchen.StationID,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *KitchenPrinter {
+	return &KitchenPrinter{
+		id:        id,
+		name:      name,
+		driver:    driver,
+		address:   address,
+		paper:     paper,
+		stations:  stations,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// PrintJob is a kitchen chit queued for a printer. Jobs are retried while
+// the printer is offline and end up failed once the attempts run out,
+// until staff retry them by hand.
+type PrintJob struct {
+	id            PrintJobID
+	printerID     PrinterID
+	orderID       shared.OrderID
+	stationID     kitchen.StationID
+	kind          JobKind
+	document      *Document
+	status        JobStatus
+	attempts      int
+	lastError     string
+	nextAttemptAt *time.Time
+	printedAt     *time.Time
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+// NewPrintJob queues a document for immediate printing
+func NewPrintJob(id PrintJobID, printerID PrinterID, orderID shared.OrderID, stationID kitchen.StationID, kind JobKind, document *Document) *PrintJob {
+	now := time.Now()
+	return &PrintJob{
+		id:            id,
+		printerID:     printerID,
+		orderID:       orderID,
+		stationID:     stationID,
+		kind:          kind,
+		document:      document,
+		status:        JobPending,
+		nextAttemptAt: &now,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+}
+
+// Getters
+func (j *PrintJob) ID() PrintJobID               { return j.id }
+func (j *PrintJob) PrinterID() PrinterID         { return j.printerID }
+func (j *PrintJob) OrderID() shared.OrderID      { return j.orderID }
+func (j *PrintJob) StationID() kitchen.StationID { return j.stationID }
+func (j *PrintJob) Kind() JobKind                { return j.kind }
+func (j *PrintJob) Document() *Document          { return j.document }
+func (j *PrintJob) Status() JobStatus            { return j.status }
+func (j *PrintJob) Attempts() int                { return j.attempts }
+func (j *PrintJob) LastError() string            { return j.lastError }
+func (j *PrintJob) NextAttemptAt() *time.Time    { return j.nextAttemptAt }
+func (j *PrintJob) PrintedAt() *time.Time        { return j.printedAt }
+func (j *PrintJob) CreatedAt() time.Time         { return j.createdAt }
+func (j *PrintJob) UpdatedAt() time.Time         { return j.updatedAt }
+
+// RecordPrinted marks the job as printed
+func (j *PrintJob) RecordPrinted(at time.Time) {
+	j.attempts++
+	j.status = JobPrinted
+	j.lastError = ""
+	j.nextAttemptAt = nil
+	j.printedAt = &at
+	j.updatedAt = at
+}
+
+// RecordFailure logs a failed attempt; the job is retried after the
+// policy's interval until the attempts run out, when it is marked failed
+func (j *PrintJob) RecordFailure(reason string, at time.Time, policy RetryPolicy) {
+	j.attempts++
+	j.lastError = reason
+	j.updatedAt = at
+
+	if j.attempts >= policy.MaxAttempts {
+		j.status = JobFailed
+		j.nextAttemptAt = nil
+		return
+	}
+	next := at.Add(policy.Interval)
+	j.status = JobPending
+	j.nextAttemptAt = &next
+}
+
+// Retry queues the job again right away with a fresh set of attempts
+func (j *PrintJob) Retry() {
+	now := time.Now()
+	j.status = JobPending
+	j.attempts = 0
+	j.nextAttemptAt = &now
+	j.updatedAt = now
+}
+
+func ReconstructPrintJob(
+	id PrintJobID,
+	printerID PrinterID,
+	orderID shared.OrderID,
+	stationID kitchen.StationID,
+	kind JobKind,
+	document *Document,
+	status JobStatus,
+	attempts int,
+	lastError string,
+	nextAttemptAt *time.Time,
+	printedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *PrintJob {
+	return &PrintJob{
+		id:            id,
+		printerID:     printerID,
+		orderID:       orderID,
+		stationID:     stationID,
+		kind:          kind,
+		document:      document,
+		status:        status,
+		attempts:      attempts,
+		lastError:     lastError,
+		nextAttemptAt: nextAttemptAt,
+		printedAt:     printedAt,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
@@ -0,0 +1,12 @@
+package printing
+
+// Printer defines the interface for sending documents to a printer
+type Printer interface {
+	// Print renders the document in the printer's language and sends it
+	Print(doc *Document) error
+}
+
+// Connector opens the kitchen printers configured through the API
+type Connector interface {
+	Connect(p *KitchenPrinter) (Printer, error)
+}
@@ -0,0 +1,19 @@
+package printing
+
+import "time"
+
+type KitchenPrinterRepository interface {
+	Save(printer *KitchenPrinter) error
+	FindByID(id PrinterID) (*KitchenPrinter, error)
+	FindAll() ([]*KitchenPrinter, error)
+	Delete(id PrinterID) error
+}
+
+type PrintJobRepository interface {
+	Save(job *PrintJob) error
+	FindByID(id PrintJobID) (*PrintJob, error)
+	// FindByStatus lists jobs newest first; an empty status matches all
+	FindByStatus(status JobStatus, limit int) ([]*PrintJob, error)
+	// FindDue returns pending jobs whose next attempt is due, oldest first
+	FindDue(now time.Time, limit int) ([]*PrintJob, error)
+}
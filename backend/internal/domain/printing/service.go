@@ -0,0 +1,438 @@
+package printing
+
+import (
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/kitchen"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// printBatchSize caps how many due print jobs one pass attempts
+const printBatchSize = 50
+
+// ReceiptService lays out customer receipts for orders and prints them
+type ReceiptService struct {
+	orderRepo   order.OrderRepository
+	productRepo product.ProductRepository
+	printer     Printer
+	paper       PaperWidth
+	store       StoreInfo
+	footer      string
+}
+
+// NewReceiptService creates the service; printer may be nil when no receipt
+// printer is configured, in which case receipts can be previewed but
+// printing fails with ErrDeviceUnavailable
+func NewReceiptService(
+	orderRepo order.OrderRepository,
+	productRepo product.ProductRepository,
+	printer Printer,
+	paper PaperWidth,
+	store StoreInfo,
+	footer string,
+) *ReceiptService {
+	return &ReceiptService{
+		orderRepo:   orderRepo,
+		productRepo: productRepo,
+		printer:     printer,
+		paper:       paper,
+		store:       store,
+		footer:      footer,
+	}
+}
+
+// Paper returns the paper width of the receipt printer
+func (s *ReceiptService) Paper() PaperWidth {
+	return s.paper
+}
+
+// Receipt lays out the receipt of an order for the given paper width
+func (s *ReceiptService) Receipt(orderID shared.OrderID, paper PaperWidth) (*Document, error) {
+	ord, err := s.orderRepo.FindByID(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := NewDocument(paper)
+
+	// Store header
+	if s.store.Name != "" {
+		doc.Heading(s.store.Name)
+	}
+	if s.store.Address != "" {
+		doc.Text(s.store.Address, AlignCenter)
+	}
+	if s.store.Phone != "" {
+		doc.Text("Tel: "+s.store.Phone, AlignCenter)
+	}
+	if s.store.TaxID != "" {
+		doc.Text("Tax ID: "+s.store.TaxID, AlignCenter)
+	}
+	doc.Divider()
+
+	// Table and ticket
+	doc.Row(orderPlace(ord), "Order #"+orderReference(ord), false)
+	doc.Text(ord.CreatedAt().Local().Format("02/01/2006 15:04"), AlignLeft)
+	doc.Divider()
+
+	// Item lines
+	for _, item := range ord.Items() {
+		name, unit := describeProduct(s.productRepo, item.ProductID())
+		doc.Row(name, formatAmount(item.Subtotal().Amount), false)
+		doc.Text(fmt.Sprintf("  %s x %s", formatQuantity(item.Quantity(), unit), formatAmount(item.UnitPrice().Amount)), AlignLeft)
+	}
+	doc.Divider()
+
+	// Total
+	doc.Row("TOTAL", formatAmount(ord.Total().Amount), true)
+	doc.Divider()
+
+	// Footer
+	if s.footer != "" {
+		doc.Blank()
+		for _, line := range strings.Split(s.footer, "\n") {
+			doc.Text(line, AlignCenter)
+		}
+	}
+	doc.CutPaper()
+
+	return doc, nil
+}
+
+// PrintReceipt lays out the receipt of an order and sends it to the receipt printer
+func (s *ReceiptService) PrintReceipt(orderID shared.OrderID) (*Document, error) {
+	if s.printer == nil {
+		return nil, fmt.Errorf("%w: no receipt printer configured", shared.ErrDeviceUnavailable)
+	}
+
+	doc, err := s.Receipt(orderID, s.paper)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.printer.Print(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// KitchenTicketService prints kitchen chits: one per station and printer
+// for each new order, on request as a reprint, and as a void when an order
+// is cancelled before its items are done. Chits go through a queue of
+// print jobs so that a printer that is offline gets them once it is back.
+type KitchenTicketService struct {
+	orderRepo   order.OrderRepository
+	productRepo product.ProductRepository
+	stationRepo kitchen.StationRepository
+	printerRepo KitchenPrinterRepository
+	jobRepo     PrintJobRepository
+	connector   Connector
+	policy      RetryPolicy
+}
+
+func NewKitchenTicketService(
+	orderRepo order.OrderRepository,
+	productRepo product.ProductRepository,
+	stationRepo kitchen.StationRepository,
+	printerRepo KitchenPrinterRepository,
+	jobRepo PrintJobRepository,
+	connector Connector,
+	policy RetryPolicy,
+) *KitchenTicketService {
+	return &KitchenTicketService{
+		orderRepo:   orderRepo,
+		productRepo: productRepo,
+		stationRepo: stationRepo,
+		printerRepo: printerRepo,
+		jobRepo:     jobRepo,
+		connector:   connector,
+		policy:      policy,
+	}
+}
+
+// PrintNewOrder implements event.Handler for order.created, queueing the
+// chits of the new order
+func (s *KitchenTicketService) PrintNewOrder(msg event.Message) error {
+	var created order.OrderCreated
+	if err := msg.Decode(&created); err != nil {
+		return err
+	}
+
+	ord, err := s.orderRepo.FindByID(shared.OrderID(created.OrderID))
+	if err != nil {
+		return err
+	}
+
+	_, err = s.queue(ord, "", JobNewOrder, strconv.FormatUint(msg.ID, 10), ord.Items())
+	return err
+}
+
+// PrintVoids implements event.Handler for order.status_changed, queueing
+// void chits for the items still to be prepared when an order is cancelled
+func (s *KitchenTicketService) PrintVoids(msg event.Message) error {
+	var changed order.OrderStatusChanged
+	if err := msg.Decode(&changed); err != nil {
+		return err
+	}
+	if changed.To != order.StatusCancelled {
+		return nil
+	}
+
+	ord, err := s.orderRepo.FindByID(shared.OrderID(changed.OrderID))
+	if err != nil {
+		return err
+	}
+
+	var unfinished []*order.OrderItem
+	for _, item := range ord.Items() {
+		if !item.Status().IsFinished() {
+			unfinished = append(unfinished, item)
+		}
+	}
+
+	_, err = s.queue(ord, "", JobVoid, strconv.FormatUint(msg.ID, 10), unfinished)
+	return err
+}
+
+// Reprint queues copies of an order's chits, for one station or for all of
+// them; batch identifies the request and must be unique
+func (s *KitchenTicketService) Reprint(orderID shared.OrderID, stationID kitchen.StationID, batch string) ([]*PrintJob, error) {
+	ord, err := s.orderRepo.FindByID(orderID)
+	if err != nil {
+		return nil, err
+	}
+	if stationID != "" {
+		if _, err := s.stationRepo.FindByID(stationID); err != nil {
+			return nil, err
+		}
+	}
+
+	jobs, err := s.queue(ord, stationID, JobReprint, batch, ord.Items())
+	if err != nil {
+		return nil, err
+	}
+	if len(jobs) == 0 {
+		return nil, fmt.Errorf("%w: no kitchen printer serves the stations of this order", shared.ErrInvalidInput)
+	}
+	return jobs, nil
+}
+
+// PrintDue attempts the jobs whose next attempt is due and returns how
+// many were attempted
+func (s *KitchenTicketService) PrintDue() (int, error) {
+	jobs, err := s.jobRepo.FindDue(time.Now(), printBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	attempted := 0
+	for _, job := range jobs {
+		if err := s.attempt(job); err != nil {
+			log.Printf("Error attempting print job %s: %v", job.ID(), err)
+			continue
+		}
+		attempted++
+	}
+	return attempted, nil
+}
+
+// Retry prints a job again right away and returns its outcome
+func (s *KitchenTicketService) Retry(id PrintJobID) (*PrintJob, error) {
+	job, err := s.jobRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	job.Retry()
+	if err := s.attempt(job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// Run prints due jobs every interval until stop is closed
+func (s *KitchenTicketService) Run(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if _, err := s.PrintDue(); err != nil {
+				log.Printf("Error printing kitchen chits: %v", err)
+			}
+		}
+	}
+}
+
+// queue lays out a chit of the given items for every station and printer
+// serving it, optionally limited to one station. Jobs already queued for
+// the batch are left as they are.
+func (s *KitchenTicketService) queue(ord *order.Order, onlyStation kitchen.StationID, kind JobKind, batch string, items []*order.OrderItem) ([]*PrintJob, error) {
+	byStation := make(map[kitchen.StationID][]*order.OrderItem)
+	var stationIDs []kitchen.StationID
+	for _, item := range items {
+		stationID := item.StationID()
+		if stationID == "" || (onlyStation != "" && stationID != onlyStation) {
+			continue
+		}
+		if _, seen := byStation[stationID]; !seen {
+			stationIDs = append(stationIDs, stationID)
+		}
+		byStation[stationID] = append(byStation[stationID], item)
+	}
+	if len(stationIDs) == 0 {
+		return nil, nil
+	}
+
+	printers, err := s.printerRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []*PrintJob
+	for _, stationID := range stationIDs {
+		stationName := stationID.String()
+		if station, err := s.stationRepo.FindByID(stationID); err == nil {
+			stationName = station.Name()
+		}
+
+		for _, printer := range printers {
+			if !printer.Serves(stationID) {
+				continue
+			}
+
+			id := NewPrintJobID(batch, printer.ID(), stationID.String())
+			existing, err := s.jobRepo.FindByID(id)
+			if err == nil {
+				jobs = append(jobs, existing)
+				continue
+			}
+			if !errors.Is(err, shared.ErrNotFound) {
+				return nil, err
+			}
+
+			doc := s.chit(ord, stationName, kind, byStation[stationID], printer.Paper())
+			job := NewPrintJob(id, printer.ID(), ord.ID(), stationID, kind, doc)
+			if err := s.jobRepo.Save(job); err != nil {
+				return nil, err
+			}
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+// chit lays out a kitchen chit: the table number large enough to read
+// across the pass, then each item with its quantity and allergy warnings
+func (s *KitchenTicketService) chit(ord *order.Order, stationName string, kind JobKind, items []*order.OrderItem, paper PaperWidth) *Document {
+	doc := NewDocument(paper)
+
+	switch kind {
+	case JobVoid:
+		doc.Heading("*** VOID ***")
+	case JobReprint:
+		doc.Add(Line{Text: "REPRINT", Align: AlignCenter, Bold: true})
+	}
+	doc.Add(Line{Text: stationName, Align: AlignCenter, Bold: true})
+	doc.Heading(strings.ToUpper(orderPlace(ord)))
+	doc.Row("Order #"+orderReference(ord), ord.CreatedAt().Local().Format("15:04"), false)
+	doc.Divider()
+
+	for _, item := range items {
+		name, unit := describeProduct(s.productRepo, item.ProductID())
+		doc.Add(Line{Text: formatQuantity(item.Quantity(), unit) + " x " + name, Bold: true, Large: true})
+		for _, allergen := range item.AllergenConflicts() {
+			doc.Text("  !! ALLERGY: "+strings.ToUpper(string(allergen)), AlignLeft)
+		}
+	}
+	doc.Divider()
+	doc.CutPaper()
+
+	return doc
+}
+
+// attempt prints the job once on its printer and saves the outcome
+func (s *KitchenTicketService) attempt(job *PrintJob) error {
+	now := time.Now()
+
+	printer, err := s.printerRepo.FindByID(job.PrinterID())
+	if errors.Is(err, shared.ErrNotFound) {
+		job.RecordFailure("printer no longer exists", now, RetryPolicy{MaxAttempts: 1})
+		return s.jobRepo.Save(job)
+	}
+	if err != nil {
+		return err
+	}
+
+	device, err := s.connector.Connect(printer)
+	if err == nil {
+		err = device.Print(job.Document())
+	}
+	if err != nil {
+		job.RecordFailure(err.Error(), now, s.policy)
+	} else {
+		job.RecordPrinted(now)
+	}
+	return s.jobRepo.Save(job)
+}
+
+// describeProduct returns the name and sale unit printed for a product,
+// falling back to its ID when the product has since been purged
+func describeProduct(productRepo product.ProductRepository, productID shared.ProductID) (string, shared.UnitOfMeasure) {
+	prod, err := productRepo.FindByIDIncludingArchived(productID)
+	if err != nil {
+		return productID.String(), shared.UnitPiece
+	}
+	return prod.Name(), prod.Unit()
+}
+
+// orderPlace names where the order is served: its table, or the channel
+// for orders without one such as takeaways
+func orderPlace(ord *order.Order) string {
+	if ord.TableNumber() != "" {
+		return "Table " + ord.TableNumber().String()
+	}
+	words := strings.Split(string(ord.Channel()), "_")
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// orderReference is the ticket number called out for an order; orders
+// placed before tickets were numbered fall back to a shortened ID
+func orderReference(ord *order.Order) string {
+	if ord.TicketNumber() != "" {
+		return ord.TicketNumber().String()
+	}
+	ref := strings.ToUpper(strings.ReplaceAll(ord.ID().String(), "-", ""))
+	if len(ref) > 8 {
+		ref = ref[:8]
+	}
+	return ref
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+// formatQuantity prints whole pieces without decimals and measured
+// quantities with their unit, e.g. "2" or "0.35 kg"
+func formatQuantity(quantity float64, unit shared.UnitOfMeasure) string {
+	formatted := strconv.FormatFloat(quantity, 'f', -1, 64)
+	if unit == shared.UnitPiece {
+		return formatted
+	}
+	return formatted + " " + string(unit)
+}
@@ -0,0 +1,142 @@
+package printing
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"time"
+)
+
+// PaperWidth is the width of the paper roll a printer is loaded with
+type PaperWidth string
+
+const (
+	Paper58mm PaperWidth = "58mm"
+	Paper80mm PaperWidth = "80mm"
+)
+
+func (p PaperWidth) IsValid() bool {
+	switch p {
+	case Paper58mm, Paper80mm:
+		return true
+	}
+	return false
+}
+
+// Columns returns how many characters of the standard font fit on a line
+func (p PaperWidth) Columns() int {
+	if p == Paper58mm {
+		return 32
+	}
+	return 48
+}
+
+// ParsePaperWidth validates a paper width, e.g. from configuration or a request
+func ParsePaperWidth(value string) (PaperWidth, error) {
+	paper := PaperWidth(value)
+	if !paper.IsValid() {
+		return "", fmt.Errorf("%w: paper width must be %s or %s", shared.ErrInvalidInput, Paper58mm, Paper80mm)
+	}
+	return paper, nil
+}
+
+// Align is the horizontal alignment of a printed line
+type Align string
+
+const (
+	AlignLeft   Align = "left"
+	AlignCenter Align = "center"
+	AlignRight  Align = "right"
+)
+
+// Line is a single printed line. Large lines are printed at double width
+// and height, so only half as many characters fit.
+type Line struct {
+	Text  string `json:"text"`
+	Align Align  `json:"align"`
+	Bold  bool   `json:"bold,omitempty"`
+	Large bool   `json:"large,omitempty"`
+}
+
+// StoreInfo is printed in the header of customer receipts
+type StoreInfo struct {
+	Name    string
+	Address string
+	Phone   string
+	TaxID   string
+}
+
+type PrinterID string
+
+func (p PrinterID) String() string {
+	return string(p)
+}
+
+type PrintJobID string
+
+func (p PrintJobID) String() string {
+	return string(p)
+}
+
+// NewPrintJobID derives the ID of a chit from the batch it belongs to, so
+// that printing the same batch again, e.g. when an event is redelivered,
+// finds the existing jobs instead of printing twice
+func NewPrintJobID(batch string, printerID PrinterID, stationID string) PrintJobID {
+	return PrintJobID(fmt.Sprintf("%s-%s-%s", batch, printerID, stationID))
+}
+
+// Driver is how the server reaches a printer
+type Driver string
+
+const (
+	DriverTCP    Driver = "tcp"    // raw port, usually 9100
+	DriverFile   Driver = "file"   // device such as /dev/usb/lp0, or a capture file
+	DriverMemory Driver = "memory" // in-memory printer for development
+)
+
+func (d Driver) IsValid() bool {
+	switch d {
+	case DriverTCP, DriverFile, DriverMemory:
+		return true
+	}
+	return false
+}
+
+// JobKind tells why a kitchen chit was printed
+type JobKind string
+
+const (
+	JobNewOrder JobKind = "order"   // items of a new order
+	JobReprint  JobKind = "reprint" // copy requested by staff
+	JobVoid     JobKind = "void"    // items that are no longer wanted
+)
+
+// JobStatus is where a print job stands
+type JobStatus string
+
+const (
+	JobPending JobStatus = "pending"
+	JobPrinted JobStatus = "printed"
+	JobFailed  JobStatus = "failed"
+)
+
+func (s JobStatus) IsValid() bool {
+	switch s {
+	case JobPending, JobPrinted, JobFailed:
+		return true
+	}
+	return false
+}
+
+// RetryPolicy decides how print jobs are retried while a printer is
+// offline: every Interval, up to MaxAttempts attempts in total
+type RetryPolicy struct {
+	MaxAttempts int
+	Interval    time.Duration
+}
+
+func NewRetryPolicy(maxAttempts int, interval time.Duration) (RetryPolicy, error) {
+	if maxAttempts < 1 || interval <= 0 {
+		return RetryPolicy{}, fmt.Errorf("%w: print retries need at least one attempt and a positive interval", shared.ErrInvalidInput)
+	}
+	return RetryPolicy{MaxAttempts: maxAttempts, Interval: interval}, nil
+}
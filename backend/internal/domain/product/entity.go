@@ -1,20 +1,35 @@
 package product
 
 import (
+	"POSFlowBackend/internal/domain/event"
 	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"strings"
 	"time"
 )
 
 type Product struct {
-	id          shared.ProductID
-	name        string
-	description string
-	price       shared.Money
-	category    Category
-	stock       *Stock
-	active      bool
-	createdAt   time.Time
-	updatedAt   time.Time
+	event.Recorder
+
+	id               shared.ProductID
+	name             string
+	description      string
+	sku              string
+	price            shared.Money
+	cost             shared.Money
+	category         Category
+	stock            *Stock
+	unit             shared.UnitOfMeasure
+	purchaseUnit     shared.UnitOfMeasure
+	unitsPerPurchase float64 // sale units in one purchase unit
+	weighed          bool    // sold by weight read from a scale
+	allergens        []Allergen
+	dietaryLabels    []DietaryLabel
+	active           bool
+	available        bool
+	archivedAt       *time.Time
+	createdAt        time.Time
+	updatedAt        time.Time
 }
 
 func NewProduct(
@@ -22,37 +37,62 @@ func NewProduct(
 	name string,
 	price shared.Money,
 	category Category,
-	initialStock int,
+	unit shared.UnitOfMeasure,
+	initialStock float64,
 ) (*Product, error) {
 	if name == "" {
 		return nil, shared.ErrInvalidInput
 	}
 
-	if !category.IsValid() {
+	if !category.IsValid() || !unit.IsValid() {
 		return nil, shared.ErrInvalidInput
 	}
 
+	if err := unit.ValidateQuantity(initialStock); err != nil {
+		return nil, err
+	}
+
 	return &Product{
-		id:        id,
-		name:      name,
-		price:     price,
-		category:  category,
-		stock:     NewStock(initialStock, 5),
-		active:    true,
-		createdAt: time.Now(),
-		updatedAt: time.Now(),
+		id:               id,
+		name:             name,
+		price:            price,
+		cost:             shared.Money{Amount: 0, Currency: price.Currency},
+		category:         category,
+		stock:            NewStock(initialStock, 5),
+		unit:             unit,
+		purchaseUnit:     unit,
+		unitsPerPurchase: 1,
+		allergens:        []Allergen{},
+		dietaryLabels:    []DietaryLabel{},
+		active:           true,
+		available:        true,
+		createdAt:        time.Now(),
+		updatedAt:        time.Now(),
 	}, nil
 }
 
-func (p *Product) ID() shared.ProductID { return p.id }
-func (p *Product) Name() string         { return p.name }
-func (p *Product) Description() string  { return p.description }
-func (p *Product) Price() shared.Money  { return p.price }
-func (p *Product) Category() Category   { return p.category }
-func (p *Product) Stock() int           { return p.stock.Quantity }
-func (p *Product) IsActive() bool       { return p.active }
-func (p *Product) CreatedAt() time.Time { return p.createdAt }
-func (p *Product) UpdatedAt() time.Time { return p.updatedAt }
+func (p *Product) ID() shared.ProductID               { return p.id }
+func (p *Product) Name() string                       { return p.name }
+func (p *Product) Description() string                { return p.description }
+func (p *Product) SKU() string                        { return p.sku }
+func (p *Product) Price() shared.Money                { return p.price }
+func (p *Product) Cost() shared.Money                 { return p.cost }
+func (p *Product) Category() Category                 { return p.category }
+func (p *Product) Stock() float64                     { return p.stock.Quantity }
+func (p *Product) LowStockLevel() float64             { return p.stock.LowStockLevel }
+func (p *Product) Unit() shared.UnitOfMeasure         { return p.unit }
+func (p *Product) PurchaseUnit() shared.UnitOfMeasure { return p.purchaseUnit }
+func (p *Product) UnitsPerPurchase() float64          { return p.unitsPerPurchase }
+func (p *Product) IsWeighed() bool                    { return p.weighed }
+func (p *Product) Lots() []Lot                        { return p.stock.Lots }
+func (p *Product) Allergens() []Allergen              { return p.allergens }
+func (p *Product) DietaryLabels() []DietaryLabel      { return p.dietaryLabels }
+func (p *Product) IsActive() bool                     { return p.active }
+func (p *Product) IsAvailable() bool                  { return p.available }
+func (p *Product) IsArchived() bool                   { return p.archivedAt != nil }
+func (p *Product) ArchivedAt() *time.Time             { return p.archivedAt }
+func (p *Product) CreatedAt() time.Time               { return p.createdAt }
+func (p *Product) UpdatedAt() time.Time               { return p.updatedAt }
 
 func (p *Product) UpdatePrice(newPrice shared.Money) error {
 	if newPrice.Amount <= 0 {
@@ -63,34 +103,209 @@ func (p *Product) UpdatePrice(newPrice shared.Money) error {
 	return nil
 }
 
-func (p *Product) UpdateStock(quantity int) error {
-	if quantity < 0 {
-		return shared.ErrInvalidQuantity
+// UpdateCost sets the unit cost price of the product
+func (p *Product) UpdateCost(newCost shared.Money) error {
+	if newCost.Amount < 0 {
+		return shared.ErrInvalidPrice
 	}
+	p.cost = newCost
+	p.updatedAt = time.Now()
+	return nil
+}
+
+// MarginPercent returns the gross margin of the current price over cost
+func (p *Product) MarginPercent() float64 {
+	if p.price.Amount == 0 {
+		return 0
+	}
+	return (p.price.Amount - p.cost.Amount) / p.price.Amount * 100
+}
 
+func (p *Product) UpdateStock(quantity float64) error {
+	if err := p.unit.ValidateQuantity(quantity); err != nil {
+		return err
+	}
+
+	previous := p.stock.Quantity
 	p.stock.Quantity = quantity
+	p.stock.Reconcile()
 	p.updatedAt = time.Now()
+	p.recordStockChange(previous)
 	return nil
 }
 
-func (p *Product) IncreaseStock(quantity int) error {
-	if quantity <= 0 {
+func (p *Product) IncreaseStock(quantity float64) error {
+	if err := p.ValidateQuantity(quantity); err != nil {
+		return err
+	}
+	previous := p.stock.Quantity
+	p.stock.Increase(quantity)
+	p.updatedAt = time.Now()
+	p.recordStockChange(previous)
+	return nil
+}
+
+// DecreaseStock takes stock out, consuming the lot that expires first
+func (p *Product) DecreaseStock(quantity float64) error {
+	if err := p.ValidateQuantity(quantity); err != nil {
+		return err
+	}
+	if quantity > p.stock.Quantity {
 		return shared.ErrInvalidQuantity
 	}
-	p.stock.Quantity += quantity
+	previous := p.stock.Quantity
+	if err := p.stock.Decrease(quantity); err != nil {
+		return err
+	}
 	p.updatedAt = time.Now()
+	p.recordStockChange(previous)
 	return nil
 }
 
-func (p *Product) DecreaseStock(quantity int) error {
-	if quantity <= 0 || quantity > p.stock.Quantity {
+// recordStockChange records a stock movement, and a low stock warning when
+// the movement took the product down to its low stock level
+func (p *Product) recordStockChange(previous float64) {
+	if previous == p.stock.Quantity {
+		return
+	}
+
+	p.Record(StockChanged{
+		ProductID: p.id.String(),
+		Previous:  previous,
+		Stock:     p.stock.Quantity,
+		ChangedAt: p.updatedAt,
+	})
+
+	if p.stock.IsLowStock() && previous > p.stock.LowStockLevel {
+		p.Record(StockLow{
+			ProductID:     p.id.String(),
+			Name:          p.name,
+			Stock:         p.stock.Quantity,
+			LowStockLevel: p.stock.LowStockLevel,
+			Unit:          string(p.unit),
+			ChangedAt:     p.updatedAt,
+		})
+	}
+}
+
+// ReceiveLot adds stock received in a lot with an expiry date
+func (p *Product) ReceiveLot(id LotID, code string, quantity float64, expiresAt time.Time) (Lot, error) {
+	if id == "" || expiresAt.IsZero() {
+		return Lot{}, shared.ErrInvalidInput
+	}
+	if err := p.ValidateQuantity(quantity); err != nil {
+		return Lot{}, err
+	}
+
+	lot := Lot{
+		ID:         id,
+		Code:       strings.TrimSpace(code),
+		Quantity:   quantity,
+		ExpiresAt:  expiresAt,
+		ReceivedAt: time.Now(),
+	}
+	previous := p.stock.Quantity
+	p.stock.ReceiveLot(lot)
+	p.updatedAt = time.Now()
+	p.recordStockChange(previous)
+	return lot, nil
+}
+
+// RemoveLot takes the remaining stock of a lot out, e.g. when it is written off
+func (p *Product) RemoveLot(id LotID) (Lot, error) {
+	previous := p.stock.Quantity
+	lot, err := p.stock.RemoveLot(id)
+	if err != nil {
+		return Lot{}, err
+	}
+	p.updatedAt = time.Now()
+	p.recordStockChange(previous)
+	return lot, nil
+}
+
+// LotsExpiringBefore returns the lots that expire before the given time, earliest first
+func (p *Product) LotsExpiringBefore(t time.Time) []Lot {
+	var lots []Lot
+	for _, lot := range p.stock.Lots {
+		if lot.ExpiresBefore(t) {
+			lots = append(lots, lot)
+		}
+	}
+	return lots
+}
+
+// ValidateQuantity checks a quantity is positive and within the precision of the product's unit
+func (p *Product) ValidateQuantity(quantity float64) error {
+	if quantity <= 0 {
 		return shared.ErrInvalidQuantity
 	}
-	p.stock.Quantity -= quantity
+	return p.unit.ValidateQuantity(quantity)
+}
+
+// UpdateUnits sets the unit the product is stocked and sold in, and the unit it is
+// purchased in. Mass and volume units convert at their fixed rate; otherwise
+// unitsPerPurchase gives how many sale units one purchase unit holds.
+// A zero unitsPerPurchase uses the fixed rate, or 1 for the same unit.
+func (p *Product) UpdateUnits(unit, purchaseUnit shared.UnitOfMeasure, unitsPerPurchase float64) error {
+	if !unit.IsValid() || !purchaseUnit.IsValid() || unitsPerPurchase < 0 {
+		return shared.ErrInvalidInput
+	}
+
+	factor, fixed := purchaseUnit.ConversionFactor(unit)
+	if fixed && unit != shared.UnitPiece {
+		if unitsPerPurchase != 0 && unitsPerPurchase != factor {
+			return fmt.Errorf("%w: one %s is %g %s", shared.ErrInvalidInput, purchaseUnit, factor, unit)
+		}
+		unitsPerPurchase = factor
+	} else if unitsPerPurchase == 0 {
+		if purchaseUnit != unit {
+			return fmt.Errorf("%w: units_per_purchase is required to convert %s to %s", shared.ErrInvalidInput, purchaseUnit, unit)
+		}
+		unitsPerPurchase = 1
+	}
+
+	if p.weighed && !unit.IsMass() {
+		return fmt.Errorf("%w: weighed products must be sold by weight", shared.ErrInvalidInput)
+	}
+
+	// Stock on hand must be expressible in the new unit
+	if err := unit.ValidateQuantity(p.stock.Quantity); err != nil {
+		return err
+	}
+
+	p.unit = unit
+	p.purchaseUnit = purchaseUnit
+	p.unitsPerPurchase = unitsPerPurchase
+	p.updatedAt = time.Now()
+	return nil
+}
+
+// SetWeighed flags the product as sold by weight, priced per sale unit.
+// Only products sold in a mass unit (kg or g) can be weighed.
+func (p *Product) SetWeighed(weighed bool) error {
+	if weighed && !p.unit.IsMass() {
+		return fmt.Errorf("%w: weighed products must be sold in kg or g", shared.ErrInvalidInput)
+	}
+	p.weighed = weighed
 	p.updatedAt = time.Now()
 	return nil
 }
 
+// ToSaleUnits converts a quantity in purchase units to sale units
+func (p *Product) ToSaleUnits(purchaseQuantity float64) float64 {
+	return p.unit.Round(purchaseQuantity * p.unitsPerPurchase)
+}
+
+// SaleUnitCost converts a cost per purchase unit to a cost per sale unit
+func (p *Product) SaleUnitCost(purchaseCost shared.Money) shared.Money {
+	return shared.Money{Amount: purchaseCost.Amount / p.unitsPerPurchase, Currency: purchaseCost.Currency}
+}
+
+// PurchaseUnitCost returns the current cost of one purchase unit
+func (p *Product) PurchaseUnitCost() shared.Money {
+	return p.cost.Multiply(p.unitsPerPurchase)
+}
+
 func (p *Product) IsLowStock() bool {
 	return p.stock.IsLowStock()
 }
@@ -105,6 +320,45 @@ func (p *Product) Activate() {
 	p.updatedAt = time.Now()
 }
 
+// MarkUnavailable flags the product as temporarily unsellable,
+// e.g. because an ingredient of its recipe ran out
+func (p *Product) MarkUnavailable() {
+	p.available = false
+	p.updatedAt = time.Now()
+}
+
+// MarkAvailable flags the product as sellable again
+func (p *Product) MarkAvailable() {
+	p.available = true
+	p.updatedAt = time.Now()
+}
+
+// Archive retires the product from the catalog while keeping it
+// resolvable for order history
+func (p *Product) Archive() error {
+	if p.archivedAt != nil {
+		return shared.ErrInvalidInput // Product is already archived
+	}
+
+	now := time.Now()
+	p.active = false
+	p.archivedAt = &now
+	p.updatedAt = now
+	return nil
+}
+
+// Restore brings an archived product back into the catalog
+func (p *Product) Restore() error {
+	if p.archivedAt == nil {
+		return shared.ErrInvalidInput // Product is not archived
+	}
+
+	p.active = true
+	p.archivedAt = nil
+	p.updatedAt = time.Now()
+	return nil
+}
+
 func (p *Product) UpdateInfo(name, description string, category Category) error {
 	if name == "" {
 		return shared.ErrInvalidInput
@@ -119,3 +373,116 @@ func (p *Product) UpdateInfo(name, description string, category Category) error
 	p.updatedAt = time.Now()
 	return nil
 }
+
+// UpdateSKU sets the stock keeping unit code of the product
+func (p *Product) UpdateSKU(sku string) error {
+	sku = strings.TrimSpace(sku)
+	if strings.ContainsAny(sku, " \t\n") {
+		return shared.ErrInvalidInput
+	}
+	p.sku = sku
+	p.updatedAt = time.Now()
+	return nil
+}
+
+// UpdateTags replaces the allergens and dietary labels of the product
+func (p *Product) UpdateTags(allergens []Allergen, dietaryLabels []DietaryLabel) error {
+	for _, a := range allergens {
+		if !a.IsValid() {
+			return shared.ErrInvalidInput
+		}
+	}
+	for _, d := range dietaryLabels {
+		if !d.IsValid() {
+			return shared.ErrInvalidInput
+		}
+	}
+
+	p.allergens = allergens
+	p.dietaryLabels = dietaryLabels
+	p.updatedAt = time.Now()
+	return nil
+}
+
+// ContainsAllergen reports whether the product is tagged with the allergen
+func (p *Product) ContainsAllergen(allergen Allergen) bool {
+	for _, a := range p.allergens {
+		if a == allergen {
+			return true
+		}
+	}
+	return false
+}
+
+// ConflictingAllergens returns the declared allergies the product contains
+func (p *Product) ConflictingAllergens(declared []Allergen) []Allergen {
+	var conflicts []Allergen
+	for _, a := range declared {
+		if p.ContainsAllergen(a) {
+			conflicts = append(conflicts, a)
+		}
+	}
+	return conflicts
+}
+
+// HasTag reports whether the product carries the allergen or dietary label
+func (p *Product) HasTag(tag string) bool {
+	if p.ContainsAllergen(Allergen(tag)) {
+		return true
+	}
+	for _, d := range p.dietaryLabels {
+		if string(d) == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func ReconstructProduct(
+	id shared.ProductID,
+	name string,
+	description string,
+	sku string,
+	price shared.Money,
+	cost shared.Money,
+	category Category,
+	stock float64,
+	unit shared.UnitOfMeasure,
+	purchaseUnit shared.UnitOfMeasure,
+	unitsPerPurchase float64,
+	weighed bool,
+	lots []Lot,
+	allergens []Allergen,
+	dietaryLabels []DietaryLabel,
+	active bool,
+	available bool,
+	archivedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Product {
+	productStock := NewStock(stock, 5)
+	productStock.Lots = lots
+	productStock.Reconcile()
+
+	return &Product{
+		id:               id,
+		name:             name,
+		description:      description,
+		sku:              sku,
+		price:            price,
+		cost:             cost,
+		category:         category,
+		stock:            productStock,
+		unit:             unit,
+		purchaseUnit:     purchaseUnit,
+		unitsPerPurchase: unitsPerPurchase,
+		weighed:          weighed,
+		allergens:        allergens,
+		dietaryLabels:    dietaryLabels,
+		active:           active,
+		available:        available,
+		archivedAt:       archivedAt,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+	}
+}
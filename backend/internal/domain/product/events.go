@@ -0,0 +1,30 @@
+package product
+
+import (
+	"POSFlowBackend/internal/domain/event"
+	"time"
+)
+
+// StockChanged is recorded whenever the stock of a product changes
+type StockChanged struct {
+	ProductID string    `json:"product_id"`
+	Previous  float64   `json:"previous"`
+	Stock     float64   `json:"stock"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+func (e StockChanged) EventName() event.Topic { return event.TopicStockChanged }
+func (e StockChanged) AggregateID() string    { return e.ProductID }
+
+// StockLow is recorded when the stock of a product drops to its low stock level
+type StockLow struct {
+	ProductID     string    `json:"product_id"`
+	Name          string    `json:"product_name"`
+	Stock         float64   `json:"stock"`
+	LowStockLevel float64   `json:"low_stock_level"`
+	Unit          string    `json:"unit"`
+	ChangedAt     time.Time `json:"changed_at"`
+}
+
+func (e StockLow) EventName() event.Topic { return event.TopicStockLow }
+func (e StockLow) AggregateID() string    { return e.ProductID }
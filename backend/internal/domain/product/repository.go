@@ -1,14 +1,32 @@
 package product
 
-import "POSFlowBackend/internal/domain/shared"
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"time"
+)
 
 // ProductRepository defines the interface for product persistence
 // Implementation will be in infrastructure layer
 type ProductRepository interface {
 	Save(product *Product) error
+	// WithdrawStock takes quantity out of a product's stock in a single
+	// guarded update, returning shared.ErrInsufficientStock when less is left
+	WithdrawStock(id shared.ProductID, quantity float64) error
 	FindByID(id shared.ProductID) (*Product, error)
+	// FindByIDIncludingArchived also resolves archived products, e.g. for order history
+	FindByIDIncludingArchived(id shared.ProductID) (*Product, error)
+	FindArchived() ([]*Product, error)
 	FindAll() ([]*Product, error)
 	FindByCategory(category Category) ([]*Product, error)
 	FindLowStock() ([]*Product, error)
+	// FindWithLotsExpiringBefore returns active products holding a lot that expires before t
+	FindWithLotsExpiringBefore(t time.Time) ([]*Product, error)
+	// Search returns the products matching the filter, best matches first,
+	// along with the total number of matches before pagination
+	Search(filter ProductFilter) ([]*Product, int, error)
 	Delete(id shared.ProductID) error
+	// FindCostHistory returns the recorded cost prices of a product, newest first
+	FindCostHistory(id shared.ProductID) ([]CostRecord, error)
+	// Purge permanently removes a product, archived or not
+	Purge(id shared.ProductID) error
 }
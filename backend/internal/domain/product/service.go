@@ -12,7 +12,7 @@ func NewProductService(repo ProductRepository) *ProductService {
 }
 
 // CheckStockAvailability checks if we have enough stock for an order
-func (s *ProductService) CheckStockAvailability(productID shared.ProductID, quantity int) error {
+func (s *ProductService) CheckStockAvailability(productID shared.ProductID, quantity float64) error {
 	product, err := s.repo.FindByID(productID)
 	if err != nil {
 		return err
@@ -1,6 +1,10 @@
 package product
 
-import "POSFlowBackend/internal/domain/shared"
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"sort"
+	"time"
+)
 
 type Category string
 
@@ -20,14 +24,18 @@ func (c Category) IsValid() bool {
 }
 
 type Stock struct {
-	Quantity      int
-	LowStockLevel int
+	Quantity      float64
+	LowStockLevel float64
+	// Lots tracks the part of Quantity received with an expiry date,
+	// earliest expiry first. Stock without a lot never expires.
+	Lots []Lot
 }
 
-func NewStock(quantity, lowStockLevel int) *Stock {
+func NewStock(quantity, lowStockLevel float64) *Stock {
 	return &Stock{
 		Quantity:      quantity,
 		LowStockLevel: lowStockLevel,
+		Lots:          []Lot{},
 	}
 }
 
@@ -35,18 +43,229 @@ func (s *Stock) IsLowStock() bool {
 	return s.Quantity <= s.LowStockLevel
 }
 
-func (s *Stock) CanFulfill(quantity int) bool {
+func (s *Stock) CanFulfill(quantity float64) bool {
 	return s.Quantity >= quantity
 }
 
-func (s *Stock) Decrease(quantity int) error {
+// Decrease takes stock out, consuming the lot that expires first (FEFO)
+func (s *Stock) Decrease(quantity float64) error {
 	if !s.CanFulfill(quantity) {
 		return shared.ErrInsufficientStock
 	}
-	s.Quantity -= quantity
+	s.Quantity = shared.RoundQuantity(s.Quantity - quantity)
+	s.consumeLots(quantity)
 	return nil
 }
 
-func (s *Stock) Increase(quantity int) {
-	s.Quantity += quantity
+func (s *Stock) Increase(quantity float64) {
+	s.Quantity = shared.RoundQuantity(s.Quantity + quantity)
+}
+
+// ReceiveLot adds stock received in a lot
+func (s *Stock) ReceiveLot(lot Lot) {
+	s.Quantity = shared.RoundQuantity(s.Quantity + lot.Quantity)
+	s.Lots = append(s.Lots, lot)
+	s.sortLots()
+}
+
+// RemoveLot takes a whole lot out of stock and returns it
+func (s *Stock) RemoveLot(id LotID) (Lot, error) {
+	for i, lot := range s.Lots {
+		if lot.ID == id {
+			s.Lots = append(s.Lots[:i:i], s.Lots[i+1:]...)
+			s.Quantity = max(shared.RoundQuantity(s.Quantity-lot.Quantity), 0)
+			return lot, nil
+		}
+	}
+	return Lot{}, shared.ErrNotFound
+}
+
+// LotQuantity returns the quantity held in lots
+func (s *Stock) LotQuantity() float64 {
+	total := 0.0
+	for _, lot := range s.Lots {
+		total += lot.Quantity
+	}
+	return shared.RoundQuantity(total)
+}
+
+// Reconcile trims lots when the quantity was set below what the lots hold,
+// e.g. after a stock count, assuming the earliest-expiring stock is gone
+func (s *Stock) Reconcile() {
+	s.sortLots()
+	if excess := shared.RoundQuantity(s.LotQuantity() - s.Quantity); excess > 0 {
+		s.consumeLots(excess)
+	}
+}
+
+func (s *Stock) consumeLots(quantity float64) {
+	remaining := quantity
+	kept := s.Lots[:0]
+	for _, lot := range s.Lots {
+		if remaining > 0 {
+			taken := min(lot.Quantity, remaining)
+			lot.Quantity = shared.RoundQuantity(lot.Quantity - taken)
+			remaining = shared.RoundQuantity(remaining - taken)
+		}
+		if lot.Quantity > 0 {
+			kept = append(kept, lot)
+		}
+	}
+	s.Lots = kept
+}
+
+func (s *Stock) sortLots() {
+	sort.SliceStable(s.Lots, func(i, j int) bool {
+		return s.Lots[i].ExpiresAt.Before(s.Lots[j].ExpiresAt)
+	})
+}
+
+type LotID string
+
+func (l LotID) String() string {
+	return string(l)
+}
+
+// Lot is a batch of stock received together with a shared expiry date.
+// ExpiresAt is the last day the lot may be sold.
+type Lot struct {
+	ID         LotID
+	Code       string
+	Quantity   float64
+	ExpiresAt  time.Time
+	ReceivedAt time.Time
+}
+
+// IsExpired reports whether the whole expiry day has passed
+func (l Lot) IsExpired(now time.Time) bool {
+	return now.After(l.ExpiresAt.AddDate(0, 0, 1))
+}
+
+// ExpiresBefore reports whether the lot's expiry day is before the given time
+func (l Lot) ExpiresBefore(t time.Time) bool {
+	return l.ExpiresAt.Before(t)
+}
+
+// Allergen identifies an allergen a product contains
+type Allergen string
+
+const (
+	AllergenGluten      Allergen = "gluten"
+	AllergenCrustaceans Allergen = "crustaceans"
+	AllergenEggs        Allergen = "eggs"
+	AllergenFish        Allergen = "fish"
+	AllergenPeanuts     Allergen = "peanuts"
+	AllergenNuts        Allergen = "nuts"
+	AllergenSoy         Allergen = "soy"
+	AllergenDairy       Allergen = "dairy"
+	AllergenCelery      Allergen = "celery"
+	AllergenMustard     Allergen = "mustard"
+	AllergenSesame      Allergen = "sesame"
+	AllergenSulphites   Allergen = "sulphites"
+	AllergenLupin       Allergen = "lupin"
+	AllergenMolluscs    Allergen = "molluscs"
+)
+
+func (a Allergen) IsValid() bool {
+	switch a {
+	case AllergenGluten, AllergenCrustaceans, AllergenEggs, AllergenFish,
+		AllergenPeanuts, AllergenNuts, AllergenSoy, AllergenDairy,
+		AllergenCelery, AllergenMustard, AllergenSesame, AllergenSulphites,
+		AllergenLupin, AllergenMolluscs:
+		return true
+	default:
+		return false
+	}
+}
+
+// DietaryLabel identifies a diet a product is suitable for
+type DietaryLabel string
+
+const (
+	DietaryVegan      DietaryLabel = "vegan"
+	DietaryVegetarian DietaryLabel = "vegetarian"
+	DietaryHalal      DietaryLabel = "halal"
+	DietaryKosher     DietaryLabel = "kosher"
+)
+
+func (d DietaryLabel) IsValid() bool {
+	switch d {
+	case DietaryVegan, DietaryVegetarian, DietaryHalal, DietaryKosher:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseAllergens converts raw values into validated, de-duplicated allergens
+func ParseAllergens(values []string) ([]Allergen, error) {
+	allergens := []Allergen{}
+	seen := map[Allergen]bool{}
+	for _, v := range values {
+		a := Allergen(v)
+		if !a.IsValid() {
+			return nil, shared.ErrInvalidInput
+		}
+		if !seen[a] {
+			seen[a] = true
+			allergens = append(allergens, a)
+		}
+	}
+	return allergens, nil
+}
+
+// ParseDietaryLabels converts raw values into validated, de-duplicated dietary labels
+func ParseDietaryLabels(values []string) ([]DietaryLabel, error) {
+	labels := []DietaryLabel{}
+	seen := map[DietaryLabel]bool{}
+	for _, v := range values {
+		d := DietaryLabel(v)
+		if !d.IsValid() {
+			return nil, shared.ErrInvalidInput
+		}
+		if !seen[d] {
+			seen[d] = true
+			labels = append(labels, d)
+		}
+	}
+	return labels, nil
+}
+
+// AllergenStrings converts allergens into plain strings
+func AllergenStrings(allergens []Allergen) []string {
+	values := []string{}
+	for _, a := range allergens {
+		values = append(values, string(a))
+	}
+	return values
+}
+
+// DietaryLabelStrings converts dietary labels into plain strings
+func DietaryLabelStrings(labels []DietaryLabel) []string {
+	values := []string{}
+	for _, d := range labels {
+		values = append(values, string(d))
+	}
+	return values
+}
+
+// ProductFilter narrows down product listings
+type ProductFilter struct {
+	// Query is free text matched by prefix against name, description, SKU and tags
+	Query string
+	// Category restricts results to a single category when set
+	Category Category
+	// Tags lists allergens or dietary labels a product must carry
+	Tags []string
+	// ExcludeAllergens lists allergens a product must not contain
+	ExcludeAllergens []Allergen
+	// Limit and Offset paginate the results; a zero Limit returns everything
+	Limit  int
+	Offset int
+}
+
+// CostRecord is a cost price of a product and the time it took effect
+type CostRecord struct {
+	Cost          shared.Money
+	EffectiveFrom time.Time
 }
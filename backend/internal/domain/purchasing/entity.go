@@ -0,0 +1,396 @@
+package purchasing
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"strings"
+	"time"
+)
+
+// Supplier is a company goods are purchased from
+type Supplier struct {
+	id          SupplierID
+	name        string
+	contactName string
+	email       string
+	phone       string
+	notes       string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+func NewSupplier(id SupplierID, name, contactName, email, phone, notes string) (*Supplier, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, shared.ErrInvalidInput
+	}
+
+	return &Supplier{
+		id:          id,
+		name:        name,
+		contactName: contactName,
+		email:       email,
+		phone:       phone,
+		notes:       notes,
+		createdAt:   time.Now(),
+		updatedAt:   time.Now(),
+	}, nil
+}
+
+// Getters
+func (s *Supplier) ID() SupplierID       { return s.id }
+func (s *Supplier) Name() string         { return s.name }
+func (s *Supplier) ContactName() string  { return s.contactName }
+func (s *Supplier) Email() string        { return s.email }
+func (s *Supplier) Phone() string        { return s.phone }
+func (s *Supplier) Notes() string        { return s.notes }
+func (s *Supplier) CreatedAt() time.Time { return s.createdAt }
+func (s *Supplier) UpdatedAt() time.Time { return s.updatedAt }
+
+func (s *Supplier) UpdateInfo(name, contactName, email, phone, notes string) error {
+	if strings.TrimSpace(name) == "" {
+		return shared.ErrInvalidInput
+	}
+
+	s.name = name
+	s.contactName = contactName
+	s.email = email
+	s.phone = phone
+	s.notes = notes
+	s.updatedAt = time.Now()
+	return nil
+}
+
+func ReconstructSupplier(
+	id SupplierID,
+	name, contactName, email, phone, notes string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Supplier {
+	return &Supplier{
+		id:          id,
+		name:        name,
+		contactName: contactName,
+		email:       email,
+		phone:       phone,
+		notes:       notes,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// PurchaseOrderLine is a product ordered from a supplier
+type PurchaseOrderLine struct {
+	productID        shared.ProductID
+	quantityOrdered  float64
+	quantityReceived float64
+	unitCost         shared.Money
+}
+
+func NewPurchaseOrderLine(productID shared.ProductID, quantity float64, unitCost shared.Money) (*PurchaseOrderLine, error) {
+	if quantity <= 0 {
+		return nil, shared.ErrInvalidQuantity
+	}
+	if unitCost.Amount < 0 {
+		return nil, shared.ErrInvalidPrice
+	}
+
+	return &PurchaseOrderLine{
+		productID:       productID,
+		quantityOrdered: quantity,
+		unitCost:        unitCost,
+	}, nil
+}
+
+// Getters
+func (l *PurchaseOrderLine) ProductID() shared.ProductID { return l.productID }
+func (l *PurchaseOrderLine) QuantityOrdered() float64    { return l.quantityOrdered }
+func (l *PurchaseOrderLine) QuantityReceived() float64   { return l.quantityReceived }
+func (l *PurchaseOrderLine) UnitCost() shared.Money      { return l.unitCost }
+
+// QuantityOutstanding is the quantity still to be delivered
+func (l *PurchaseOrderLine) QuantityOutstanding() float64 {
+	if l.quantityReceived >= l.quantityOrdered {
+		return 0
+	}
+	return shared.RoundQuantity(l.quantityOrdered - l.quantityReceived)
+}
+
+func (l *PurchaseOrderLine) IsFullyReceived() bool {
+	return l.quantityReceived >= l.quantityOrdered
+}
+
+// Total is the cost of the full ordered quantity
+func (l *PurchaseOrderLine) Total() shared.Money {
+	return l.unitCost.Multiply(l.quantityOrdered)
+}
+
+func ReconstructPurchaseOrderLine(
+	productID shared.ProductID,
+	quantityOrdered float64,
+	quantityReceived float64,
+	unitCost shared.Money,
+) *PurchaseOrderLine {
+	return &PurchaseOrderLine{
+		productID:        productID,
+		quantityOrdered:  quantityOrdered,
+		quantityReceived: quantityReceived,
+		unitCost:         unitCost,
+	}
+}
+
+// PurchaseOrder is an order of goods placed with a supplier
+type PurchaseOrder struct {
+	id           PurchaseOrderID
+	supplierID   SupplierID
+	lines        []*PurchaseOrderLine
+	status       PurchaseOrderStatus
+	expectedDate *time.Time
+	notes        string
+	sentAt       *time.Time
+	receivedAt   *time.Time
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+func NewPurchaseOrder(
+	id PurchaseOrderID,
+	supplierID SupplierID,
+	lines []PurchaseOrderLineRequest,
+	expectedDate *time.Time,
+	notes string,
+) (*PurchaseOrder, error) {
+	if supplierID == "" {
+		return nil, shared.ErrInvalidInput
+	}
+
+	po := &PurchaseOrder{
+		id:           id,
+		supplierID:   supplierID,
+		status:       StatusDraft,
+		expectedDate: expectedDate,
+		notes:        notes,
+		createdAt:    time.Now(),
+		updatedAt:    time.Now(),
+	}
+
+	if err := po.setLines(lines); err != nil {
+		return nil, err
+	}
+
+	return po, nil
+}
+
+// Getters
+func (p *PurchaseOrder) ID() PurchaseOrderID         { return p.id }
+func (p *PurchaseOrder) SupplierID() SupplierID      { return p.supplierID }
+func (p *PurchaseOrder) Lines() []*PurchaseOrderLine { return p.lines }
+func (p *PurchaseOrder) Status() PurchaseOrderStatus { return p.status }
+func (p *PurchaseOrder) ExpectedDate() *time.Time    { return p.expectedDate }
+func (p *PurchaseOrder) Notes() string               { return p.notes }
+func (p *PurchaseOrder) SentAt() *time.Time          { return p.sentAt }
+func (p *PurchaseOrder) ReceivedAt() *time.Time      { return p.receivedAt }
+func (p *PurchaseOrder) CreatedAt() time.Time        { return p.createdAt }
+func (p *PurchaseOrder) UpdatedAt() time.Time        { return p.updatedAt }
+
+// Business methods
+func (p *PurchaseOrder) IsOpen() bool {
+	return p.status.IsOpen()
+}
+
+// IsOverdue reports whether goods are still expected after the whole expected day has passed
+func (p *PurchaseOrder) IsOverdue(now time.Time) bool {
+	if !p.IsOpen() || p.status == StatusDraft || p.expectedDate == nil {
+		return false
+	}
+	return now.After(p.expectedDate.AddDate(0, 0, 1))
+}
+
+// Total is the cost of everything ordered
+func (p *PurchaseOrder) Total() shared.Money {
+	total := shared.Money{Amount: 0, Currency: "USD"}
+	for _, line := range p.lines {
+		total = total.Add(line.Total())
+	}
+	return total
+}
+
+// OutstandingValue is the cost of everything not delivered yet
+func (p *PurchaseOrder) OutstandingValue() shared.Money {
+	total := shared.Money{Amount: 0, Currency: "USD"}
+	if !p.IsOpen() {
+		return total
+	}
+	for _, line := range p.lines {
+		total = total.Add(line.unitCost.Multiply(line.QuantityOutstanding()))
+	}
+	return total
+}
+
+// UpdateDraft replaces the lines, expected date and notes of a draft
+func (p *PurchaseOrder) UpdateDraft(lines []PurchaseOrderLineRequest, expectedDate *time.Time, notes string) error {
+	if p.status != StatusDraft {
+		return shared.ErrOrderNotModifiable
+	}
+
+	if err := p.setLines(lines); err != nil {
+		return err
+	}
+
+	p.expectedDate = expectedDate
+	p.notes = notes
+	p.updatedAt = time.Now()
+	return nil
+}
+
+// MarkSent records that the order was sent to the supplier
+func (p *PurchaseOrder) MarkSent() error {
+	if err := p.transitionTo(StatusSent); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	p.sentAt = &now
+	return nil
+}
+
+func (p *PurchaseOrder) Cancel() error {
+	return p.transitionTo(StatusCancelled)
+}
+
+// Receive books delivered quantities against the order lines and returns
+// what was received per product. Cost overrides replace the line unit cost.
+func (p *PurchaseOrder) Receive(receipts []Receipt) ([]ReceivedLine, error) {
+	if len(receipts) == 0 {
+		return nil, shared.ErrInvalidInput
+	}
+
+	// Validate every receipt before changing anything
+	pending := make(map[shared.ProductID]float64)
+	for _, receipt := range receipts {
+		if receipt.Quantity <= 0 {
+			return nil, shared.ErrInvalidQuantity
+		}
+		if receipt.UnitCost != nil && receipt.UnitCost.Amount < 0 {
+			return nil, shared.ErrInvalidPrice
+		}
+
+		line := p.findLine(receipt.ProductID)
+		if line == nil {
+			return nil, shared.ErrInvalidInput
+		}
+
+		pending[receipt.ProductID] = shared.RoundQuantity(pending[receipt.ProductID] + receipt.Quantity)
+		if pending[receipt.ProductID] > line.QuantityOutstanding() {
+			return nil, shared.ErrInvalidQuantity
+		}
+	}
+
+	if !p.status.CanTransitionTo(StatusPartiallyReceived) {
+		return nil, shared.ErrOrderNotModifiable
+	}
+
+	now := time.Now()
+	var received []ReceivedLine
+	for _, receipt := range receipts {
+		line := p.findLine(receipt.ProductID)
+		line.quantityReceived = shared.RoundQuantity(line.quantityReceived + receipt.Quantity)
+		if receipt.UnitCost != nil {
+			line.unitCost = *receipt.UnitCost
+		}
+
+		received = append(received, ReceivedLine{
+			ProductID:  line.productID,
+			Quantity:   receipt.Quantity,
+			UnitCost:   line.unitCost,
+			Lot:        receipt.Lot,
+			ReceivedAt: now,
+		})
+	}
+
+	if p.isFullyReceived() {
+		p.status = StatusReceived
+		p.receivedAt = &now
+	} else {
+		p.status = StatusPartiallyReceived
+	}
+	p.updatedAt = now
+
+	return received, nil
+}
+
+func (p *PurchaseOrder) transitionTo(newStatus PurchaseOrderStatus) error {
+	if !p.status.CanTransitionTo(newStatus) {
+		return shared.ErrOrderNotModifiable
+	}
+
+	p.status = newStatus
+	p.updatedAt = time.Now()
+	return nil
+}
+
+func (p *PurchaseOrder) setLines(requests []PurchaseOrderLineRequest) error {
+	if len(requests) == 0 {
+		return shared.ErrInvalidInput
+	}
+
+	var lines []*PurchaseOrderLine
+	seen := make(map[shared.ProductID]bool)
+	for _, req := range requests {
+		if seen[req.ProductID] {
+			return shared.ErrInvalidInput
+		}
+		seen[req.ProductID] = true
+
+		line, err := NewPurchaseOrderLine(req.ProductID, req.Quantity, req.UnitCost)
+		if err != nil {
+			return err
+		}
+		lines = append(lines, line)
+	}
+
+	p.lines = lines
+	return nil
+}
+
+func (p *PurchaseOrder) findLine(productID shared.ProductID) *PurchaseOrderLine {
+	for _, line := range p.lines {
+		if line.productID == productID {
+			return line
+		}
+	}
+	return nil
+}
+
+func (p *PurchaseOrder) isFullyReceived() bool {
+	for _, line := range p.lines {
+		if !line.IsFullyReceived() {
+			return false
+		}
+	}
+	return true
+}
+
+func ReconstructPurchaseOrder(
+	id PurchaseOrderID,
+	supplierID SupplierID,
+	lines []*PurchaseOrderLine,
+	status PurchaseOrderStatus,
+	expectedDate *time.Time,
+	notes string,
+	sentAt *time.Time,
+	receivedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *PurchaseOrder {
+	return &PurchaseOrder{
+		id:           id,
+		supplierID:   supplierID,
+		lines:        lines,
+		status:       status,
+		expectedDate: expectedDate,
+		notes:        notes,
+		sentAt:       sentAt,
+		receivedAt:   receivedAt,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
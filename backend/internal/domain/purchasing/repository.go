@@ -0,0 +1,43 @@
+package purchasing
+
+import (
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+// SupplierRepository defines the interface for supplier persistence
+type SupplierRepository interface {
+	Save(supplier *Supplier) error
+	FindByID(id SupplierID) (*Supplier, error)
+	FindAll() ([]*Supplier, error)
+}
+
+// PurchaseOrderRepository defines the interface for purchase order persistence
+type PurchaseOrderRepository interface {
+	Save(po *PurchaseOrder) error
+	FindByID(id PurchaseOrderID) (*PurchaseOrder, error)
+	FindAll() ([]*PurchaseOrder, error)
+	FindBySupplier(supplierID SupplierID) ([]*PurchaseOrder, error)
+	FindByStatus(status PurchaseOrderStatus) ([]*PurchaseOrder, error)
+	// FindOpen returns draft, sent and partially received purchase orders
+	FindOpen() ([]*PurchaseOrder, error)
+	// ExistsByProduct reports whether any purchase order line references the product
+	ExistsByProduct(productID shared.ProductID) (bool, error)
+}
+
+// Stores are the repositories a delivery is received through, all bound to
+// the same transaction
+type Stores struct {
+	PurchaseOrders PurchaseOrderRepository
+	Products       product.ProductRepository
+	Locations      location.LocationRepository
+	StockLevels    location.StockLevelRepository
+	Transfers      location.TransferRepository
+}
+
+// UnitOfWork runs fn in a single transaction, committed only when fn
+// returns nil
+type UnitOfWork interface {
+	Do(fn func(stores Stores) error) error
+}
@@ -0,0 +1,128 @@
+package purchasing
+
+import (
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+)
+
+// ReceivingService contains domain logic for booking supplier deliveries into stock
+type ReceivingService struct {
+	uow               UnitOfWork
+	purchaseOrderRepo PurchaseOrderRepository
+	supplierRepo      SupplierRepository
+}
+
+func NewReceivingService(
+	uow UnitOfWork,
+	purchaseOrderRepo PurchaseOrderRepository,
+	supplierRepo SupplierRepository,
+) *ReceivingService {
+	return &ReceivingService{
+		uow:               uow,
+		purchaseOrderRepo: purchaseOrderRepo,
+		supplierRepo:      supplierRepo,
+	}
+}
+
+// Receive books delivered quantities against a purchase order, increases
+// product stock at the location by the received quantities and updates
+// product cost prices. Deliveries go to the default location when none is
+// given. The products, stock levels and purchase order are saved in one
+// transaction, so a failure leaves stock untouched.
+func (s *ReceivingService) Receive(id PurchaseOrderID, locationID location.LocationID, receipts []Receipt) (*PurchaseOrder, error) {
+	var po *PurchaseOrder
+	err := s.uow.Do(func(stores Stores) error {
+		var err error
+		po, err = stores.PurchaseOrders.FindByID(id)
+		if err != nil {
+			return err
+		}
+
+		stock := location.NewStockService(stores.Locations, stores.StockLevels, stores.Transfers, stores.Products)
+		loc, err := stock.LocationOrDefault(locationID)
+		if err != nil {
+			return err
+		}
+
+		received, err := po.Receive(receipts)
+		if err != nil {
+			return err
+		}
+
+		products := make(map[shared.ProductID]*product.Product)
+		var productIDs []shared.ProductID
+		var allocations []location.Allocation
+		for _, line := range received {
+			prod, ok := products[line.ProductID]
+			if !ok {
+				prod, err = stores.Products.FindByIDIncludingArchived(line.ProductID)
+				if err != nil {
+					return err
+				}
+				products[line.ProductID] = prod
+				productIDs = append(productIDs, line.ProductID)
+			}
+
+			// Deliveries are counted in purchase units; stock is kept in sale units
+			if err := prod.PurchaseUnit().ValidateQuantity(line.Quantity); err != nil {
+				return err
+			}
+			quantity := prod.ToSaleUnits(line.Quantity)
+			if line.Lot != nil {
+				if _, err := prod.ReceiveLot(line.Lot.ID, line.Lot.Code, quantity, line.Lot.ExpiresAt); err != nil {
+					return err
+				}
+			} else if err := prod.IncreaseStock(quantity); err != nil {
+				return err
+			}
+			if err := prod.UpdateCost(prod.SaleUnitCost(line.UnitCost)); err != nil {
+				return err
+			}
+			allocations = append(allocations, location.Allocation{LocationID: loc.ID(), ProductID: prod.ID(), Quantity: quantity})
+		}
+
+		for _, productID := range productIDs {
+			if err := stores.Products.Save(products[productID]); err != nil {
+				return err
+			}
+		}
+
+		if err := stock.Deposit(allocations); err != nil {
+			return err
+		}
+
+		return stores.PurchaseOrders.Save(po)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return po, nil
+}
+
+// GetOpenBySupplier groups open purchase orders by supplier
+func (s *ReceivingService) GetOpenBySupplier() ([]SupplierBacklog, error) {
+	orders, err := s.purchaseOrderRepo.FindOpen()
+	if err != nil {
+		return nil, err
+	}
+
+	var backlogs []SupplierBacklog
+	index := make(map[SupplierID]int)
+	for _, po := range orders {
+		i, ok := index[po.SupplierID()]
+		if !ok {
+			supplier, err := s.supplierRepo.FindByID(po.SupplierID())
+			if err != nil {
+				return nil, err
+			}
+			backlogs = append(backlogs, SupplierBacklog{Supplier: supplier})
+			i = len(backlogs) - 1
+			index[po.SupplierID()] = i
+		}
+		backlogs[i].PurchaseOrders = append(backlogs[i].PurchaseOrders, po)
+	}
+
+	return backlogs, nil
+}
@@ -0,0 +1,120 @@
+package purchasing
+
+import (
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"time"
+)
+
+type SupplierID string
+
+func (s SupplierID) String() string {
+	return string(s)
+}
+
+type PurchaseOrderID string
+
+func (p PurchaseOrderID) String() string {
+	return string(p)
+}
+
+type PurchaseOrderStatus string
+
+const (
+	StatusDraft             PurchaseOrderStatus = "draft"
+	StatusSent              PurchaseOrderStatus = "sent"
+	StatusPartiallyReceived PurchaseOrderStatus = "partially_received"
+	StatusReceived          PurchaseOrderStatus = "received"
+	StatusCancelled         PurchaseOrderStatus = "cancelled"
+)
+
+func (s PurchaseOrderStatus) IsValid() bool {
+	switch s {
+	case StatusDraft, StatusSent, StatusPartiallyReceived, StatusReceived, StatusCancelled:
+		return true
+	}
+	return false
+}
+
+// IsOpen reports whether goods are still expected for a purchase order in this status
+func (s PurchaseOrderStatus) IsOpen() bool {
+	return s == StatusDraft || s == StatusSent || s == StatusPartiallyReceived
+}
+
+func (s PurchaseOrderStatus) CanTransitionTo(newStatus PurchaseOrderStatus) bool {
+	transitions := map[PurchaseOrderStatus][]PurchaseOrderStatus{
+		StatusDraft:             {StatusSent, StatusCancelled},
+		StatusSent:              {StatusPartiallyReceived, StatusReceived, StatusCancelled},
+		StatusPartiallyReceived: {StatusPartiallyReceived, StatusReceived},
+		StatusReceived:          {},
+		StatusCancelled:         {},
+	}
+
+	allowedTransitions := transitions[s]
+	for _, allowed := range allowedTransitions {
+		if allowed == newStatus {
+			return true
+		}
+	}
+	return false
+}
+
+// PurchaseOrderLineRequest is the product, quantity and agreed unit cost of a line,
+// both expressed in the product's purchase unit
+type PurchaseOrderLineRequest struct {
+	ProductID shared.ProductID
+	Quantity  float64
+	UnitCost  shared.Money
+}
+
+// Receipt is a quantity of a product delivered against a purchase order.
+// UnitCost is the invoiced cost; nil keeps the cost agreed on the order line.
+// Perishable deliveries carry a Lot, which is received with its expiry date.
+type Receipt struct {
+	ProductID shared.ProductID
+	Quantity  float64
+	UnitCost  *shared.Money
+	Lot       *LotReceipt
+}
+
+// LotReceipt identifies the lot a delivered quantity belongs to
+type LotReceipt struct {
+	ID        product.LotID
+	Code      string
+	ExpiresAt time.Time
+}
+
+// ReceivedLine is what a receipt changed on a purchase order line
+type ReceivedLine struct {
+	ProductID  shared.ProductID
+	Quantity   float64
+	UnitCost   shared.Money
+	Lot        *LotReceipt
+	ReceivedAt time.Time
+}
+
+// SupplierBacklog groups the open purchase orders of one supplier
+type SupplierBacklog struct {
+	Supplier       *Supplier
+	PurchaseOrders []*PurchaseOrder
+}
+
+// OutstandingValue is the cost of everything still to be delivered
+func (b SupplierBacklog) OutstandingValue() shared.Money {
+	total := shared.Money{Amount: 0, Currency: "USD"}
+	for _, po := range b.PurchaseOrders {
+		total = total.Add(po.OutstandingValue())
+	}
+	return total
+}
+
+// OverdueCount is the number of open purchase orders past their expected date
+func (b SupplierBacklog) OverdueCount(now time.Time) int {
+	count := 0
+	for _, po := range b.PurchaseOrders {
+		if po.IsOverdue(now) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,190 @@
+package replenishment
+
+import (
+	"POSFlowBackend/internal/domain/ingredient"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/purchasing"
+	"POSFlowBackend/internal/domain/shared"
+	"math"
+	"sort"
+	"time"
+)
+
+// ReplenishmentService computes reorder suggestions from sales velocity
+type ReplenishmentService struct {
+	orderRepo         order.OrderRepository
+	productRepo       product.ProductRepository
+	purchaseOrderRepo purchasing.PurchaseOrderRepository
+	ingredientRepo    ingredient.IngredientRepository
+	inventory         *ingredient.InventoryService
+}
+
+func NewReplenishmentService(
+	orderRepo order.OrderRepository,
+	productRepo product.ProductRepository,
+	purchaseOrderRepo purchasing.PurchaseOrderRepository,
+	ingredientRepo ingredient.IngredientRepository,
+	inventory *ingredient.InventoryService,
+) *ReplenishmentService {
+	return &ReplenishmentService{
+		orderRepo:         orderRepo,
+		productRepo:       productRepo,
+		purchaseOrderRepo: purchaseOrderRepo,
+		ingredientRepo:    ingredientRepo,
+		inventory:         inventory,
+	}
+}
+
+// Suggest lists products whose stock plus open purchase orders will not
+// cover the policy's cover days at their average daily sales, most urgent first.
+// Products made from a recipe are skipped: their stock is held as ingredients,
+// which SuggestIngredients covers.
+func (s *ReplenishmentService) Suggest(policy Policy, now time.Time) ([]Suggestion, error) {
+	sold, err := s.soldInWindow(policy, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// Quantities already on order, in purchase units
+	openOrders, err := s.purchaseOrderRepo.FindOpen()
+	if err != nil {
+		return nil, err
+	}
+
+	onOrder := make(map[shared.ProductID]float64)
+	for _, po := range openOrders {
+		for _, line := range po.Lines() {
+			onOrder[line.ProductID()] = shared.RoundQuantity(onOrder[line.ProductID()] + line.QuantityOutstanding())
+		}
+	}
+
+	products, err := s.productRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var suggestions []Suggestion
+	for _, prod := range products {
+		if sold[prod.ID()] == 0 {
+			continue
+		}
+
+		recipe, err := s.inventory.FindRecipe(prod.ID())
+		if err != nil {
+			return nil, err
+		}
+		if recipe != nil {
+			continue
+		}
+
+		// Top up to the target in sale units, then order whole purchase units
+		average := sold[prod.ID()] / float64(policy.WindowDays)
+		target := average * float64(policy.CoverDays)
+		shortfall := target - prod.Stock() - prod.ToSaleUnits(onOrder[prod.ID()])
+		suggested := math.Ceil(shared.RoundQuantity(shortfall / prod.UnitsPerPurchase()))
+		if suggested <= 0 {
+			continue
+		}
+
+		suggestions = append(suggestions, Suggestion{
+			Product:           prod,
+			SoldQuantity:      sold[prod.ID()],
+			AverageDailySales: average,
+			OnOrder:           onOrder[prod.ID()],
+			SuggestedQuantity: suggested,
+		})
+	}
+
+	// Fewest days of cover first
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		di, _ := suggestions[i].DaysOfCover()
+		dj, _ := suggestions[j].DaysOfCover()
+		return di < dj
+	})
+
+	return suggestions, nil
+}
+
+// SuggestIngredients lists ingredients whose stock will not cover the policy's
+// cover days at the rate recipes used them up, most urgent first. Usage is
+// the recipe requirements of every recipe product sold in the window.
+func (s *ReplenishmentService) SuggestIngredients(policy Policy, now time.Time) ([]IngredientSuggestion, error) {
+	sold, err := s.soldInWindow(policy, now)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[ingredient.IngredientID]float64)
+	for productID, quantity := range sold {
+		recipe, err := s.inventory.FindRecipe(productID)
+		if err != nil {
+			return nil, err
+		}
+		if recipe == nil {
+			continue
+		}
+		for id, required := range recipe.Requirements(quantity) {
+			used[id] = shared.RoundQuantity(used[id] + required)
+		}
+	}
+
+	ingredients, err := s.ingredientRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var suggestions []IngredientSuggestion
+	for _, ing := range ingredients {
+		if used[ing.ID()] == 0 {
+			continue
+		}
+
+		// Top up to the target, rounded up to the unit's precision
+		average := used[ing.ID()] / float64(policy.WindowDays)
+		target := average * float64(policy.CoverDays)
+		scale := math.Pow10(ing.Unit().Precision())
+		suggested := math.Ceil(shared.RoundQuantity(target-ing.Stock())*scale) / scale
+		if suggested <= 0 {
+			continue
+		}
+
+		suggestions = append(suggestions, IngredientSuggestion{
+			Ingredient:        ing,
+			UsedQuantity:      used[ing.ID()],
+			AverageDailyUsage: average,
+			SuggestedQuantity: suggested,
+		})
+	}
+
+	// Fewest days of cover first
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		di, _ := suggestions[i].DaysOfCover()
+		dj, _ := suggestions[j].DaysOfCover()
+		return di < dj
+	})
+
+	return suggestions, nil
+}
+
+// soldInWindow totals the quantities sold per product on completed orders
+// in the policy's window
+func (s *ReplenishmentService) soldInWindow(policy Policy, now time.Time) (map[shared.ProductID]float64, error) {
+	windowStart := now.AddDate(0, 0, -policy.WindowDays)
+	orders, err := s.orderRepo.FindByDateRange(windowStart, now)
+	if err != nil {
+		return nil, err
+	}
+
+	sold := make(map[shared.ProductID]float64)
+	for _, ord := range orders {
+		if !ord.IsCompleted() {
+			continue
+		}
+		for _, item := range ord.Items() {
+			sold[item.ProductID()] = shared.RoundQuantity(sold[item.ProductID()] + item.Quantity())
+		}
+	}
+
+	return sold, nil
+}
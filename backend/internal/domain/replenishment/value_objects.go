@@ -0,0 +1,65 @@
+package replenishment
+
+import (
+	"POSFlowBackend/internal/domain/ingredient"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+)
+
+// Policy controls how reorder suggestions are computed: sales over the last
+// WindowDays give the velocity, and stock is topped up to last CoverDays
+type Policy struct {
+	WindowDays int
+	CoverDays  int
+}
+
+func NewPolicy(windowDays, coverDays int) (Policy, error) {
+	if windowDays <= 0 || coverDays <= 0 {
+		return Policy{}, fmt.Errorf("%w: window and cover days must be positive", shared.ErrInvalidInput)
+	}
+	return Policy{WindowDays: windowDays, CoverDays: coverDays}, nil
+}
+
+// Suggestion is the reorder advice for one product. Sales are in the
+// product's sale unit; on-order and suggested quantities in its purchase unit.
+type Suggestion struct {
+	Product           *product.Product
+	SoldQuantity      float64
+	AverageDailySales float64
+	OnOrder           float64
+	SuggestedQuantity float64
+}
+
+// DaysOfCover estimates how many days current stock lasts at the average
+// sales rate; ok is false when the product has not sold in the window
+func (s Suggestion) DaysOfCover() (days float64, ok bool) {
+	if s.AverageDailySales == 0 {
+		return 0, false
+	}
+	return s.Product.Stock() / s.AverageDailySales, true
+}
+
+// EstimatedCost values the suggested quantity at the current cost price
+func (s Suggestion) EstimatedCost() shared.Money {
+	cost := s.Product.PurchaseUnitCost()
+	return cost.Multiply(s.SuggestedQuantity)
+}
+
+// IngredientSuggestion is the reorder advice for one ingredient. All
+// quantities are in the ingredient's unit.
+type IngredientSuggestion struct {
+	Ingredient        *ingredient.Ingredient
+	UsedQuantity      float64
+	AverageDailyUsage float64
+	SuggestedQuantity float64
+}
+
+// DaysOfCover estimates how many days current stock lasts at the average
+// usage rate; ok is false when no recipe used the ingredient in the window
+func (s IngredientSuggestion) DaysOfCover() (days float64, ok bool) {
+	if s.AverageDailyUsage == 0 {
+		return 0, false
+	}
+	return s.Ingredient.Stock() / s.AverageDailyUsage, true
+}
@@ -1,14 +1,18 @@
 package sales
 
 import (
+	"POSFlowBackend/internal/domain/event"
 	"POSFlowBackend/internal/domain/shared"
 	"time"
 )
 
 type DailySales struct {
+	event.Recorder
+
 	id          SalesID
 	date        time.Time
 	totalSales  shared.Money
+	totalCost   shared.Money
 	totalOrders int
 	orderIDs    []shared.OrderID
 	closed      bool
@@ -22,6 +26,7 @@ func NewDailySales(id SalesID, date time.Time) *DailySales {
 		id:          id,
 		date:        date,
 		totalSales:  shared.Money{Amount: 0, Currency: "USD"},
+		totalCost:   shared.Money{Amount: 0, Currency: "USD"},
 		totalOrders: 0,
 		orderIDs:    []shared.OrderID{},
 		closed:      false,
@@ -35,6 +40,7 @@ func NewDailySales(id SalesID, date time.Time) *DailySales {
 func (s *DailySales) ID() SalesID                { return s.id }
 func (s *DailySales) Date() time.Time            { return s.date }
 func (s *DailySales) TotalSales() shared.Money   { return s.totalSales }
+func (s *DailySales) TotalCost() shared.Money    { return s.totalCost }
 func (s *DailySales) TotalOrders() int           { return s.totalOrders }
 func (s *DailySales) OrderIDs() []shared.OrderID { return s.orderIDs }
 func (s *DailySales) IsClosed() bool             { return s.closed }
@@ -43,19 +49,30 @@ func (s *DailySales) CreatedAt() time.Time       { return s.createdAt }
 func (s *DailySales) UpdatedAt() time.Time       { return s.updatedAt }
 
 // Business Logic
-func (s *DailySales) AddOrder(orderID shared.OrderID, amount shared.Money) error {
+func (s *DailySales) AddOrder(orderID shared.OrderID, amount, cost shared.Money) error {
 	if s.closed {
 		return shared.ErrInvalidInput // Day is closed for adding orders
 	}
 
 	s.orderIDs = append(s.orderIDs, orderID)
 	s.totalSales = s.totalSales.Add(amount)
+	s.totalSales = s.totalSales.Round()
+	s.totalCost = s.totalCost.Add(cost)
+	s.totalCost = s.totalCost.Round()
 	s.totalOrders++
 	s.updatedAt = time.Now()
 
 	return nil
 }
 
+// reset clears the totals of an open day so its orders can be counted again
+func (s *DailySales) reset() {
+	s.orderIDs = []shared.OrderID{}
+	s.totalSales = shared.Money{Amount: 0, Currency: s.totalSales.Currency}
+	s.totalCost = shared.Money{Amount: 0, Currency: s.totalCost.Currency}
+	s.totalOrders = 0
+}
+
 func (s *DailySales) CloseDay() error {
 	if s.closed {
 		return shared.ErrInvalidInput // Day is already closed
@@ -66,6 +83,14 @@ func (s *DailySales) CloseDay() error {
 	s.closedAt = &now
 	s.updatedAt = time.Now()
 
+	s.Record(DayClosed{
+		SalesID:     s.id.String(),
+		Date:        s.date,
+		TotalSales:  s.totalSales.Amount,
+		TotalCost:   s.totalCost.Amount,
+		TotalOrders: s.totalOrders,
+		ClosedAt:    now,
+	})
 	return nil
 }
 
@@ -76,10 +101,25 @@ func (s *DailySales) AverageSale() float64 {
 	return s.totalSales.Amount / float64(s.totalOrders)
 }
 
+// GrossProfit returns total sales minus cost of goods sold, to the cent
+func (s *DailySales) GrossProfit() shared.Money {
+	profit := shared.Money{Amount: s.totalSales.Amount - s.totalCost.Amount, Currency: s.totalSales.Currency}
+	return profit.Round()
+}
+
+// MarginPercent returns gross profit as a percentage of total sales
+func (s *DailySales) MarginPercent() float64 {
+	if s.totalSales.Amount == 0 {
+		return 0
+	}
+	return s.GrossProfit().Amount / s.totalSales.Amount * 100
+}
+
 func ReconstructDailySales(
 	id SalesID,
 	date time.Time,
 	totalSales shared.Money,
+	totalCost shared.Money,
 	totalOrders int,
 	orderIDs []shared.OrderID,
 	closed bool,
@@ -91,6 +131,7 @@ func ReconstructDailySales(
 		id:          id,
 		date:        date,
 		totalSales:  totalSales,
+		totalCost:   totalCost,
 		totalOrders: totalOrders,
 		orderIDs:    orderIDs,
 		closed:      closed,
@@ -0,0 +1,19 @@
+package sales
+
+import (
+	"POSFlowBackend/internal/domain/event"
+	"time"
+)
+
+// DayClosed is recorded when a business day is closed
+type DayClosed struct {
+	SalesID     string    `json:"sales_id"`
+	Date        time.Time `json:"date"`
+	TotalSales  float64   `json:"total_sales"`
+	TotalCost   float64   `json:"total_cost"`
+	TotalOrders int       `json:"total_orders"`
+	ClosedAt    time.Time `json:"closed_at"`
+}
+
+func (e DayClosed) EventName() event.Topic { return event.TopicDayClosed }
+func (e DayClosed) AggregateID() string    { return e.SalesID }
@@ -2,15 +2,19 @@ package sales
 
 import (
 	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
 	"errors"
+	"sort"
 
 	"time"
 )
 
 // SalesService contains domain logic related to sales
 type SalesService struct {
-	salesRepo SalesRepository
-	orderRepo order.OrderRepository
+	salesRepo   SalesRepository
+	orderRepo   order.OrderRepository
+	productRepo product.ProductRepository
 }
 
 // DateRange represents a range between two dates
@@ -19,28 +23,40 @@ type DateRange struct {
 	End   time.Time
 }
 
-func NewSalesService(salesRepo SalesRepository, orderRepo order.OrderRepository) *SalesService {
+func NewSalesService(
+	salesRepo SalesRepository,
+	orderRepo order.OrderRepository,
+	productRepo product.ProductRepository,
+) *SalesService {
 	return &SalesService{
-		salesRepo: salesRepo,
-		orderRepo: orderRepo,
+		salesRepo:   salesRepo,
+		orderRepo:   orderRepo,
+		productRepo: productRepo,
 	}
 }
 
-// CalculateDailySales calculates total sales for a given date
+// CalculateDailySales calculates total sales for a given date. A closed day
+// keeps the figures it was closed with; an open day is counted again, as
+// orders may have been completed since it was last calculated.
 func (s *SalesService) CalculateDailySales(date time.Time) (*DailySales, error) {
 	// Normalize date to midnight
 	normalizedDate := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
 
 	// see if sales record already exists
-	existing, err := s.salesRepo.FindByDate(normalizedDate)
-	if err == nil {
-		return existing, nil
+	dailySales, err := s.salesRepo.FindByDate(normalizedDate)
+	switch {
+	case err == nil && dailySales.IsClosed():
+		return dailySales, nil
+	case err == nil:
+		dailySales.reset()
+	case errors.Is(err, shared.ErrNotFound):
+		// Create new daily sales record
+		salesID := SalesID(normalizedDate.Format("2006-01-02"))
+		dailySales = NewDailySales(salesID, normalizedDate)
+	default:
+		return nil, err
 	}
 
-	// Create new daily sales record
-	salesID := SalesID(normalizedDate.Format("2006-01-02"))
-	dailySales := NewDailySales(salesID, normalizedDate)
-
 	// Fetch orders for the day
 	startOfDay := normalizedDate
 	endOfDay := startOfDay.Add(24 * time.Hour)
@@ -52,7 +68,7 @@ func (s *SalesService) CalculateDailySales(date time.Time) (*DailySales, error)
 	// add completed orders to daily sales
 	for _, ord := range orders {
 		if ord.IsCompleted() {
-			dailySales.AddOrder(ord.ID(), ord.Total())
+			dailySales.AddOrder(ord.ID(), ord.Total(), ord.TotalCost())
 		}
 	}
 
@@ -64,14 +80,105 @@ func (s *SalesService) CalculateDailySales(date time.Time) (*DailySales, error)
 	return dailySales, nil
 }
 
-// GetSalesReport generates a sales report for a given date range
-func (s *SalesService) GetSalesReport(start, end time.Time) ([]*DailySales, error) {
+// GetSalesReport returns the sales of every day in the range, latest first,
+// with their margin breakdown per product, category and day. Both are
+// counted from the same orders: a closed day keeps the orders it was closed
+// with, and open days count their completed orders.
+func (s *SalesService) GetSalesReport(start, end time.Time) ([]*DailySales, *MarginReport, error) {
 	dateRange, err := NewDateRange(start, end)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	records, err := s.salesRepo.FindByDateRange(dateRange.Start, dateRange.End)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	startOfRange := time.Date(dateRange.Start.Year(), dateRange.Start.Month(), dateRange.Start.Day(), 0, 0, 0, 0, dateRange.Start.Location())
+	endOfRange := time.Date(dateRange.End.Year(), dateRange.End.Month(), dateRange.End.Day(), 0, 0, 0, 0, dateRange.End.Location()).Add(24 * time.Hour)
+
+	orders, err := s.orderRepo.FindByDateRange(startOfRange, endOfRange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	days := map[string]*DailySales{}
+	closedOrders := map[shared.OrderID]bool{}
+	for _, ds := range records {
+		if ds.IsClosed() {
+			for _, id := range ds.OrderIDs() {
+				closedOrders[id] = true
+			}
+		} else {
+			ds.reset()
+		}
+		days[ds.Date().Format("2006-01-02")] = ds
+	}
+
+	report := NewMarginReport()
+	products := map[string]*product.Product{}
+
+	for _, ord := range orders {
+		createdAt := ord.CreatedAt()
+		day := createdAt.Format("2006-01-02")
+		ds, ok := days[day]
+		if ok && ds.IsClosed() {
+			if !closedOrders[ord.ID()] {
+				continue
+			}
+		} else {
+			if !ord.IsCompleted() {
+				continue
+			}
+			if !ok {
+				ds = NewDailySales(SalesID(day), time.Date(createdAt.Year(), createdAt.Month(), createdAt.Day(), 0, 0, 0, 0, createdAt.Location()))
+				days[day] = ds
+			}
+			ds.AddOrder(ord.ID(), ord.Total(), ord.TotalCost())
+		}
+
+		for _, item := range ord.Items() {
+			// Resolve product names and categories once, archived ones included
+			prod, ok := products[item.ProductID().String()]
+			if !ok {
+				prod, err = s.productRepo.FindByIDIncludingArchived(item.ProductID())
+				if err != nil {
+					return nil, nil, err
+				}
+				products[item.ProductID().String()] = prod
+			}
+
+			report.AddSale(
+				day,
+				item.ProductID(),
+				prod.Name(),
+				string(prod.Category()),
+				item.Quantity(),
+				item.Subtotal(),
+				item.CostTotal(),
+			)
+		}
+	}
+
+	dailySales := make([]*DailySales, 0, len(days))
+	for _, ds := range days {
+		dailySales = append(dailySales, ds)
 	}
+	sort.Slice(dailySales, func(i, j int) bool {
+		return dailySales[i].Date().After(dailySales[j].Date())
+	})
+
+	report.Sort()
+	return dailySales, report, nil
+}
 
-	return s.salesRepo.FindByDateRange(dateRange.Start, dateRange.End)
+// GetMarginReport breaks down cost of goods sold and gross profit of the
+// orders counted as sales from the start of the start day to the end of the
+// end day
+func (s *SalesService) GetMarginReport(start, end time.Time) (*MarginReport, error) {
+	_, report, err := s.GetSalesReport(start, end)
+	return report, err
 }
 
 func NewDateRange(start, end time.Time) (*DateRange, error) {
@@ -1,7 +1,117 @@
 package sales
 
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"sort"
+)
+
 type SalesID string
 
 func (s SalesID) String() string {
 	return string(s)
 }
+
+// MarginLine aggregates revenue and cost of goods sold for one product, category or day
+type MarginLine struct {
+	Key      string
+	Label    string
+	Quantity float64
+	Revenue  shared.Money
+	Cost     shared.Money
+}
+
+// GrossProfit returns revenue minus cost of goods sold, to the cent
+func (l *MarginLine) GrossProfit() shared.Money {
+	profit := shared.Money{Amount: l.Revenue.Amount - l.Cost.Amount, Currency: l.Revenue.Currency}
+	return profit.Round()
+}
+
+// MarginPercent returns gross profit as a percentage of revenue
+func (l *MarginLine) MarginPercent() float64 {
+	if l.Revenue.Amount == 0 {
+		return 0
+	}
+	return l.GrossProfit().Amount / l.Revenue.Amount * 100
+}
+
+func (l *MarginLine) add(quantity float64, revenue, cost shared.Money) {
+	l.Quantity = shared.RoundQuantity(l.Quantity + quantity)
+	l.Revenue = l.Revenue.Add(revenue)
+	l.Revenue = l.Revenue.Round()
+	l.Cost = l.Cost.Add(cost)
+	l.Cost = l.Cost.Round()
+}
+
+// MarginReport breaks down cost of goods sold and gross profit
+// per product, per category and per day
+type MarginReport struct {
+	Total      MarginLine
+	ByProduct  []*MarginLine
+	ByCategory []*MarginLine
+	ByDay      []*MarginLine
+
+	products   map[string]*MarginLine
+	categories map[string]*MarginLine
+	days       map[string]*MarginLine
+}
+
+func NewMarginReport() *MarginReport {
+	zero := shared.Money{Amount: 0, Currency: "USD"}
+	return &MarginReport{
+		Total:      MarginLine{Key: "total", Label: "Total", Revenue: zero, Cost: zero},
+		ByProduct:  []*MarginLine{},
+		ByCategory: []*MarginLine{},
+		ByDay:      []*MarginLine{},
+		products:   map[string]*MarginLine{},
+		categories: map[string]*MarginLine{},
+		days:       map[string]*MarginLine{},
+	}
+}
+
+// AddSale records a sold line in every breakdown of the report
+func (r *MarginReport) AddSale(
+	day string,
+	productID shared.ProductID,
+	productName string,
+	category string,
+	quantity float64,
+	revenue shared.Money,
+	cost shared.Money,
+) {
+	r.Total.add(quantity, revenue, cost)
+	r.ByProduct = addToBreakdown(r.products, r.ByProduct, productID.String(), productName, quantity, revenue, cost)
+	r.ByCategory = addToBreakdown(r.categories, r.ByCategory, category, category, quantity, revenue, cost)
+	r.ByDay = addToBreakdown(r.days, r.ByDay, day, day, quantity, revenue, cost)
+}
+
+// Sort orders products and categories by revenue and days chronologically
+func (r *MarginReport) Sort() {
+	byRevenue := func(lines []*MarginLine) {
+		sort.SliceStable(lines, func(i, j int) bool {
+			return lines[i].Revenue.Amount > lines[j].Revenue.Amount
+		})
+	}
+	byRevenue(r.ByProduct)
+	byRevenue(r.ByCategory)
+	sort.SliceStable(r.ByDay, func(i, j int) bool {
+		return r.ByDay[i].Key < r.ByDay[j].Key
+	})
+}
+
+func addToBreakdown(
+	index map[string]*MarginLine,
+	lines []*MarginLine,
+	key, label string,
+	quantity float64,
+	revenue, cost shared.Money,
+) []*MarginLine {
+	line, ok := index[key]
+	if !ok {
+		zero := shared.Money{Amount: 0, Currency: revenue.Currency}
+		line = &MarginLine{Key: key, Label: label, Revenue: zero, Cost: zero}
+		index[key] = line
+		lines = append(lines, line)
+	}
+	line.add(quantity, revenue, cost)
+	return lines
+}
@@ -3,10 +3,15 @@ package shared
 import "errors"
 
 var (
-	ErrNotFound           = errors.New("resource not found")
-	ErrInvalidInput       = errors.New("invalid input")
-	ErrInsufficientStock  = errors.New("insufficient stock")
-	ErrInvalidPrice       = errors.New("invalid price")
-	ErrInvalidQuantity    = errors.New("invalid quantity")
-	ErrOrderNotModifiable = errors.New("order cannot be modified in current status")
+	ErrNotFound            = errors.New("resource not found")
+	ErrInvalidInput        = errors.New("invalid input")
+	ErrInsufficientStock   = errors.New("insufficient stock")
+	ErrInvalidPrice        = errors.New("invalid price")
+	ErrInvalidQuantity     = errors.New("invalid quantity")
+	ErrOrderNotModifiable  = errors.New("order cannot be modified in current status")
+	ErrProductInUse        = errors.New("product is referenced by existing orders")
+	ErrStocktakeInProgress = errors.New("a stocktake is already in progress")
+	ErrDeviceUnavailable   = errors.New("device is not available")
+	ErrNoStableWeight      = errors.New("no stable weight on the scale")
+	ErrTableInUse          = errors.New("table has open orders")
 )
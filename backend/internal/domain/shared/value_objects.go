@@ -1,6 +1,9 @@
 package shared
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
 type Money struct {
 	Amount   float64
@@ -31,6 +34,14 @@ func (m *Money) Multiply(factor float64) Money {
 	}
 }
 
+// Round rounds the amount to whole cents
+func (m *Money) Round() Money {
+	return Money{
+		Amount:   math.Round(m.Amount*100) / 100,
+		Currency: m.Currency,
+	}
+}
+
 func (m *Money) String() string {
 	return fmt.Sprintf("%.2f %s", m.Amount, m.Currency)
 }
@@ -46,3 +57,91 @@ type OrderID string
 func (o OrderID) String() string {
 	return string(o)
 }
+
+// UnitOfMeasure is the unit a quantity is expressed in
+type UnitOfMeasure string
+
+const (
+	UnitPiece      UnitOfMeasure = "unit"
+	UnitGram       UnitOfMeasure = "g"
+	UnitKilogram   UnitOfMeasure = "kg"
+	UnitMilliliter UnitOfMeasure = "ml"
+	UnitLiter      UnitOfMeasure = "l"
+)
+
+func (u UnitOfMeasure) IsValid() bool {
+	switch u {
+	case UnitPiece, UnitGram, UnitKilogram, UnitMilliliter, UnitLiter:
+		return true
+	}
+	return false
+}
+
+// Precision returns the number of decimal places a quantity in this unit may have
+func (u UnitOfMeasure) Precision() int {
+	switch u {
+	case UnitKilogram, UnitLiter:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// ValidateQuantity checks a quantity is not negative and has no more
+// decimal places than the unit allows
+func (u UnitOfMeasure) ValidateQuantity(quantity float64) error {
+	if quantity < 0 || math.IsNaN(quantity) || math.IsInf(quantity, 0) {
+		return ErrInvalidQuantity
+	}
+	scale := math.Pow10(u.Precision())
+	if math.Abs(quantity*scale-math.Round(quantity*scale)) > 1e-6 {
+		return fmt.Errorf("%w: %s allows %d decimal places", ErrInvalidQuantity, u, u.Precision())
+	}
+	return nil
+}
+
+// Round rounds a quantity to the precision of the unit
+func (u UnitOfMeasure) Round(quantity float64) float64 {
+	scale := math.Pow10(u.Precision())
+	return math.Round(quantity*scale) / scale
+}
+
+// ConversionFactor returns how many of the other unit make up one of this
+// unit, when both measure the same dimension (mass, volume or pieces)
+func (u UnitOfMeasure) ConversionFactor(other UnitOfMeasure) (float64, bool) {
+	if u.dimension() != other.dimension() {
+		return 0, false
+	}
+	return u.baseFactor() / other.baseFactor(), true
+}
+
+// IsMass reports whether the unit measures weight
+func (u UnitOfMeasure) IsMass() bool {
+	return u.dimension() == "mass"
+}
+
+func (u UnitOfMeasure) dimension() string {
+	switch u {
+	case UnitGram, UnitKilogram:
+		return "mass"
+	case UnitMilliliter, UnitLiter:
+		return "volume"
+	default:
+		return "piece"
+	}
+}
+
+func (u UnitOfMeasure) baseFactor() float64 {
+	switch u {
+	case UnitKilogram, UnitLiter:
+		return 1000
+	default:
+		return 1
+	}
+}
+
+// RoundQuantity rounds away floating point error from quantity arithmetic.
+// Quantities keep at most three decimals, the finest precision of any unit.
+func RoundQuantity(quantity float64) float64 {
+	return math.Round(quantity*1000) / 1000
+}
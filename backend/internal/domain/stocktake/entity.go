@@ -0,0 +1,181 @@
+package stocktake
+
+import (
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"time"
+)
+
+// Stocktake is a physical count of the stock held at one location,
+// submitted by one or more terminals
+type Stocktake struct {
+	id          StocktakeID
+	name        string
+	locationID  location.LocationID
+	status      StocktakeStatus
+	counts      []Count
+	lines       []VarianceLine
+	openedAt    time.Time
+	finalizedAt *time.Time
+	updatedAt   time.Time
+}
+
+func NewStocktake(id StocktakeID, name string, locationID location.LocationID) (*Stocktake, error) {
+	if id == "" || locationID == "" {
+		return nil, shared.ErrInvalidInput
+	}
+
+	return &Stocktake{
+		id:         id,
+		name:       name,
+		locationID: locationID,
+		status:     StatusOpen,
+		openedAt:   time.Now(),
+		updatedAt:  time.Now(),
+	}, nil
+}
+
+// Getters
+func (s *Stocktake) ID() StocktakeID                 { return s.id }
+func (s *Stocktake) Name() string                    { return s.name }
+func (s *Stocktake) LocationID() location.LocationID { return s.locationID }
+func (s *Stocktake) Status() StocktakeStatus         { return s.status }
+func (s *Stocktake) Counts() []Count                 { return s.counts }
+func (s *Stocktake) OpenedAt() time.Time             { return s.openedAt }
+func (s *Stocktake) FinalizedAt() *time.Time         { return s.finalizedAt }
+func (s *Stocktake) UpdatedAt() time.Time            { return s.updatedAt }
+func (s *Stocktake) IsOpen() bool                    { return s.status == StatusOpen }
+func (s *Stocktake) IsFinalized() bool               { return s.status == StatusFinalized }
+
+// Business methods
+
+// SubmitCount records the quantity a terminal counted for a product.
+// A terminal that counts the same product again replaces its previous count;
+// counts from different terminals (e.g. shop floor and back room) add up.
+func (s *Stocktake) SubmitCount(productID shared.ProductID, terminalID string, counted, expected float64) error {
+	if !s.IsOpen() {
+		return fmt.Errorf("%w: stocktake is %s", shared.ErrInvalidInput, s.status)
+	}
+	if productID == "" || terminalID == "" {
+		return shared.ErrInvalidInput
+	}
+	if counted < 0 {
+		return shared.ErrInvalidQuantity
+	}
+
+	count := Count{
+		ProductID:  productID,
+		TerminalID: terminalID,
+		Counted:    counted,
+		Expected:   expected,
+		CountedAt:  time.Now(),
+	}
+
+	for i, existing := range s.counts {
+		if existing.ProductID == productID && existing.TerminalID == terminalID {
+			s.counts[i] = count
+			s.updatedAt = time.Now()
+			return nil
+		}
+	}
+
+	s.counts = append(s.counts, count)
+	s.updatedAt = time.Now()
+	return nil
+}
+
+// CountedProducts returns the IDs of every counted product, in count order
+func (s *Stocktake) CountedProducts() []shared.ProductID {
+	var ids []shared.ProductID
+	seen := make(map[shared.ProductID]bool)
+	for _, count := range s.counts {
+		if !seen[count.ProductID] {
+			seen[count.ProductID] = true
+			ids = append(ids, count.ProductID)
+		}
+	}
+	return ids
+}
+
+// VarianceLines compares counted and expected stock per product, valued at the given
+// unit costs. Once finalized, the lines recorded at finalization are returned.
+// The expected quantity is the system stock at the product's most recent count.
+func (s *Stocktake) VarianceLines(unitCosts map[shared.ProductID]shared.Money) []VarianceLine {
+	if s.IsFinalized() {
+		return s.lines
+	}
+
+	var lines []VarianceLine
+	for _, productID := range s.CountedProducts() {
+		line := VarianceLine{ProductID: productID, UnitCost: unitCosts[productID]}
+
+		var latest time.Time
+		for _, count := range s.counts {
+			if count.ProductID != productID {
+				continue
+			}
+			line.Counted = shared.RoundQuantity(line.Counted + count.Counted)
+			if !count.CountedAt.Before(latest) {
+				latest = count.CountedAt
+				line.Expected = count.Expected
+			}
+		}
+
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Finalize closes the stocktake and records the variance lines to apply to stock
+func (s *Stocktake) Finalize(unitCosts map[shared.ProductID]shared.Money) error {
+	if !s.IsOpen() {
+		return fmt.Errorf("%w: stocktake is %s", shared.ErrInvalidInput, s.status)
+	}
+	if len(s.counts) == 0 {
+		return fmt.Errorf("%w: nothing has been counted", shared.ErrInvalidInput)
+	}
+
+	s.lines = s.VarianceLines(unitCosts)
+	s.status = StatusFinalized
+
+	now := time.Now()
+	s.finalizedAt = &now
+	s.updatedAt = now
+	return nil
+}
+
+// Cancel discards the stocktake without touching stock
+func (s *Stocktake) Cancel() error {
+	if !s.IsOpen() {
+		return fmt.Errorf("%w: stocktake is %s", shared.ErrInvalidInput, s.status)
+	}
+
+	s.status = StatusCancelled
+	s.updatedAt = time.Now()
+	return nil
+}
+
+func ReconstructStocktake(
+	id StocktakeID,
+	name string,
+	locationID location.LocationID,
+	status StocktakeStatus,
+	counts []Count,
+	lines []VarianceLine,
+	openedAt time.Time,
+	finalizedAt *time.Time,
+	updatedAt time.Time,
+) *Stocktake {
+	return &Stocktake{
+		id:          id,
+		name:        name,
+		locationID:  locationID,
+		status:      status,
+		counts:      counts,
+		lines:       lines,
+		openedAt:    openedAt,
+		finalizedAt: finalizedAt,
+		updatedAt:   updatedAt,
+	}
+}
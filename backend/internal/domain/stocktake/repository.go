@@ -0,0 +1,31 @@
+package stocktake
+
+import (
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
+)
+
+// StocktakeRepository defines the interface for stocktake persistence
+type StocktakeRepository interface {
+	Save(stocktake *Stocktake) error
+	FindByID(id StocktakeID) (*Stocktake, error)
+	FindAll() ([]*Stocktake, error)
+	// FindOpen returns shared.ErrNotFound when no stocktake is open
+	FindOpen() (*Stocktake, error)
+}
+
+// Stores are the repositories a stocktake is finalized through, all bound
+// to the same transaction
+type Stores struct {
+	Stocktakes  StocktakeRepository
+	Products    product.ProductRepository
+	Locations   location.LocationRepository
+	StockLevels location.StockLevelRepository
+	Transfers   location.TransferRepository
+}
+
+// UnitOfWork runs fn in a single transaction, committed only when fn
+// returns nil
+type UnitOfWork interface {
+	Do(fn func(stores Stores) error) error
+}
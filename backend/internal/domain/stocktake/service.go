@@ -0,0 +1,219 @@
+package stocktake
+
+import (
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"errors"
+	"math"
+)
+
+// CountRequest is a counted quantity submitted by a terminal
+type CountRequest struct {
+	ProductID shared.ProductID
+	Counted   float64
+}
+
+// StocktakeService contains domain logic for physical stock counts
+type StocktakeService struct {
+	uow           UnitOfWork
+	stocktakeRepo StocktakeRepository
+	productRepo   product.ProductRepository
+	stock         *location.StockService
+}
+
+func NewStocktakeService(
+	uow UnitOfWork,
+	stocktakeRepo StocktakeRepository,
+	productRepo product.ProductRepository,
+	stock *location.StockService,
+) *StocktakeService {
+	return &StocktakeService{
+		uow:           uow,
+		stocktakeRepo: stocktakeRepo,
+		productRepo:   productRepo,
+		stock:         stock,
+	}
+}
+
+// Open starts a new stocktake of a location, the default one when none is
+// given. Only one stocktake can be open at a time.
+func (s *StocktakeService) Open(id StocktakeID, name string, locationID location.LocationID) (*Stocktake, error) {
+	_, err := s.stocktakeRepo.FindOpen()
+	if err == nil {
+		return nil, shared.ErrStocktakeInProgress
+	}
+	if !errors.Is(err, shared.ErrNotFound) {
+		return nil, err
+	}
+
+	loc, err := s.stock.LocationOrDefault(locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := NewStocktake(id, name, loc.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.stocktakeRepo.Save(st); err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+// SubmitCounts records counts from a terminal, snapshotting the current
+// system stock of each product at the stocktake's location
+func (s *StocktakeService) SubmitCounts(id StocktakeID, terminalID string, counts []CountRequest) (*Stocktake, error) {
+	st, err := s.stocktakeRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := s.stock.LocationOrDefault(st.LocationID())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, count := range counts {
+		prod, err := s.productRepo.FindByID(count.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := prod.Unit().ValidateQuantity(count.Counted); err != nil {
+			return nil, err
+		}
+
+		expected, err := s.stock.Available(loc, prod)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := st.SubmitCount(prod.ID(), terminalID, count.Counted, expected); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.stocktakeRepo.Save(st); err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+// VarianceReport compares expected and counted stock. Open stocktakes are
+// valued at current cost prices; finalized ones at the costs recorded when finalized.
+func (s *StocktakeService) VarianceReport(id StocktakeID) (*Stocktake, VarianceReport, error) {
+	st, err := s.stocktakeRepo.FindByID(id)
+	if err != nil {
+		return nil, VarianceReport{}, err
+	}
+
+	unitCosts, err := currentCosts(s.productRepo, st)
+	if err != nil {
+		return nil, VarianceReport{}, err
+	}
+
+	return st, NewVarianceReport(st.VarianceLines(unitCosts)), nil
+}
+
+// Finalize closes the stocktake and applies every adjustment to stock in a
+// single transaction. Variances are applied to the current stock at the
+// location, so sales made since each product was counted are kept.
+func (s *StocktakeService) Finalize(id StocktakeID) (*Stocktake, VarianceReport, error) {
+	var st *Stocktake
+	err := s.uow.Do(func(stores Stores) error {
+		var err error
+		st, err = stores.Stocktakes.FindByID(id)
+		if err != nil {
+			return err
+		}
+
+		unitCosts, err := currentCosts(stores.Products, st)
+		if err != nil {
+			return err
+		}
+
+		if err := st.Finalize(unitCosts); err != nil {
+			return err
+		}
+
+		stock := location.NewStockService(stores.Locations, stores.StockLevels, stores.Transfers, stores.Products)
+		loc, err := stock.LocationOrDefault(st.LocationID())
+		if err != nil {
+			return err
+		}
+
+		for _, line := range st.VarianceLines(nil) {
+			if line.Variance() == 0 {
+				continue
+			}
+			if err := adjustStock(stores.Products, stock, loc, line); err != nil {
+				return err
+			}
+		}
+
+		return stores.Stocktakes.Save(st)
+	})
+	if err != nil {
+		return nil, VarianceReport{}, err
+	}
+
+	return st, NewVarianceReport(st.VarianceLines(nil)), nil
+}
+
+// adjustStock applies a variance to the product's current stock at the
+// location, never taking it below zero. Going through the product keeps its
+// lots in step and records the stock change events.
+func adjustStock(
+	products product.ProductRepository,
+	stock *location.StockService,
+	loc *location.Location,
+	line VarianceLine,
+) error {
+	prod, err := products.FindByIDIncludingArchived(line.ProductID)
+	if err != nil {
+		return err
+	}
+
+	available, err := stock.Available(loc, prod)
+	if err != nil {
+		return err
+	}
+	delta := shared.RoundQuantity(math.Max(available+line.Variance(), 0) - available)
+	if delta == 0 {
+		return nil
+	}
+
+	if err := prod.UpdateStock(shared.RoundQuantity(prod.Stock() + delta)); err != nil {
+		return err
+	}
+	if err := products.Save(prod); err != nil {
+		return err
+	}
+
+	allocations := []location.Allocation{{LocationID: loc.ID(), ProductID: prod.ID(), Quantity: math.Abs(delta)}}
+	if delta > 0 {
+		return stock.Deposit(allocations)
+	}
+	return stock.Withdraw(allocations)
+}
+
+func currentCosts(products product.ProductRepository, st *Stocktake) (map[shared.ProductID]shared.Money, error) {
+	unitCosts := make(map[shared.ProductID]shared.Money)
+	if st.IsFinalized() {
+		return unitCosts, nil
+	}
+
+	for _, productID := range st.CountedProducts() {
+		prod, err := products.FindByIDIncludingArchived(productID)
+		if err != nil {
+			return nil, err
+		}
+		unitCosts[productID] = prod.Cost()
+	}
+	return unitCosts, nil
+}
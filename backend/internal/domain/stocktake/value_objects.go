@@ -0,0 +1,93 @@
+package stocktake
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"math"
+	"sort"
+	"time"
+)
+
+type StocktakeID string
+
+func (s StocktakeID) String() string {
+	return string(s)
+}
+
+type StocktakeStatus string
+
+const (
+	StatusOpen      StocktakeStatus = "open"
+	StatusFinalized StocktakeStatus = "finalized"
+	StatusCancelled StocktakeStatus = "cancelled"
+)
+
+func (s StocktakeStatus) IsValid() bool {
+	switch s {
+	case StatusOpen, StatusFinalized, StatusCancelled:
+		return true
+	}
+	return false
+}
+
+// Count is the quantity of a product counted by one terminal. Expected is the
+// system stock at the moment the count was submitted, so sales made after the
+// count are not mistaken for shrinkage.
+type Count struct {
+	ProductID  shared.ProductID
+	TerminalID string
+	Counted    float64
+	Expected   float64
+	CountedAt  time.Time
+}
+
+// VarianceLine compares expected and counted stock of one product
+type VarianceLine struct {
+	ProductID shared.ProductID
+	Expected  float64
+	Counted   float64
+	UnitCost  shared.Money
+}
+
+// Variance is the stock adjustment: negative for shrinkage, positive for surplus
+func (l VarianceLine) Variance() float64 {
+	return shared.RoundQuantity(l.Counted - l.Expected)
+}
+
+// VarianceValue is the variance valued at cost
+func (l VarianceLine) VarianceValue() shared.Money {
+	return l.UnitCost.Multiply(l.Variance())
+}
+
+// VarianceReport summarises variance lines by value
+type VarianceReport struct {
+	Lines          []VarianceLine
+	ShrinkageValue shared.Money
+	SurplusValue   shared.Money
+	NetValue       shared.Money
+}
+
+// NewVarianceReport totals the lines and sorts them by absolute variance value, largest first
+func NewVarianceReport(lines []VarianceLine) VarianceReport {
+	report := VarianceReport{
+		Lines:          lines,
+		ShrinkageValue: shared.Money{Amount: 0, Currency: "USD"},
+		SurplusValue:   shared.Money{Amount: 0, Currency: "USD"},
+		NetValue:       shared.Money{Amount: 0, Currency: "USD"},
+	}
+
+	for _, line := range lines {
+		value := line.VarianceValue()
+		if value.Amount < 0 {
+			report.ShrinkageValue = report.ShrinkageValue.Add(value)
+		} else {
+			report.SurplusValue = report.SurplusValue.Add(value)
+		}
+		report.NetValue = report.NetValue.Add(value)
+	}
+
+	sort.SliceStable(report.Lines, func(i, j int) bool {
+		return math.Abs(report.Lines[i].VarianceValue().Amount) > math.Abs(report.Lines[j].VarianceValue().Amount)
+	})
+
+	return report
+}
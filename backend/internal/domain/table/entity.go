@@ -0,0 +1,117 @@
+package table
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Table is a table on the floor plan that dine-in orders are placed at.
+// Orders refer to it by its number, so the number does not change once
+// the table is created.
+type Table struct {
+	id              TableID
+	number          string
+	area            string // section of the floor, such as the terrace
+	capacity        int
+	position        Position
+	billRequestedAt *time.Time
+	createdAt       time.Time
+	updatedAt       time.Time
+}
+
+func NewTable(id TableID, number string, area string, capacity int, position Position) (*Table, error) {
+	number = strings.TrimSpace(number)
+	if number == "" {
+		return nil, fmt.Errorf("%w: table number is required", shared.ErrInvalidInput)
+	}
+
+	t := &Table{
+		id:        id,
+		number:    number,
+		createdAt: time.Now(),
+		updatedAt: time.Now(),
+	}
+
+	if err := t.Arrange(area, capacity, position); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Getters
+func (t *Table) ID() TableID                 { return t.id }
+func (t *Table) Number() string              { return t.number }
+func (t *Table) Area() string                { return t.area }
+func (t *Table) Capacity() int               { return t.capacity }
+func (t *Table) Position() Position          { return t.position }
+func (t *Table) BillRequestedAt() *time.Time { return t.billRequestedAt }
+func (t *Table) CreatedAt() time.Time        { return t.createdAt }
+func (t *Table) UpdatedAt() time.Time        { return t.updatedAt }
+
+// Business methods
+
+// Arrange sets the table's area, seats and place on the floor plan
+func (t *Table) Arrange(area string, capacity int, position Position) error {
+	if capacity <= 0 {
+		return fmt.Errorf("%w: table capacity must be at least one seat", shared.ErrInvalidInput)
+	}
+	if position.X < 0 || position.Y < 0 {
+		return fmt.Errorf("%w: table position must not be negative", shared.ErrInvalidInput)
+	}
+
+	t.area = strings.TrimSpace(area)
+	t.capacity = capacity
+	t.position = position
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// RequestBill records that the guests asked for the bill
+func (t *Table) RequestBill(at time.Time) {
+	t.billRequestedAt = &at
+	t.updatedAt = at
+}
+
+// CancelBillRequest withdraws the guests' request for the bill
+func (t *Table) CancelBillRequest() {
+	t.billRequestedAt = nil
+	t.updatedAt = time.Now()
+}
+
+// Status derives the live status from the table's open orders. A bill
+// request only counts until another order is placed, and once every order
+// is paid the table is free again.
+func (t *Table) Status(openOrders int, lastOrderAt time.Time) Status {
+	if openOrders == 0 {
+		return StatusFree
+	}
+	if t.billRequestedAt != nil && !t.billRequestedAt.Before(lastOrderAt) {
+		return StatusNeedsBill
+	}
+	return StatusOccupied
+}
+
+func ReconstructTable(
+	id TableID,
+	number string,
+	area string,
+	capacity int,
+	position Position,
+	billRequestedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Table {
+	return &Table{
+		id:              id,
+		number:          number,
+		area:            area,
+		capacity:        capacity,
+		position:        position,
+		billRequestedAt: billRequestedAt,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+	}
+}
@@ -0,0 +1,11 @@
+package table
+
+// TableRepository defines the interface for table persistence
+type TableRepository interface {
+	Save(table *Table) error
+	FindByID(id TableID) (*Table, error)
+	// FindByNumber returns shared.ErrNotFound when no table has the number
+	FindByNumber(number string) (*Table, error)
+	FindAll() ([]*Table, error)
+	Delete(id TableID) error
+}
@@ -0,0 +1,36 @@
+package table
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"errors"
+	"fmt"
+)
+
+// FloorService checks orders against the floor plan
+type FloorService struct {
+	tableRepo TableRepository
+}
+
+func NewFloorService(tableRepo TableRepository) *FloorService {
+	return &FloorService{tableRepo: tableRepo}
+}
+
+// ValidateTable checks an order's table is on the floor plan. An empty
+// number is accepted only when the order does not need a table, such as
+// a takeaway.
+func (s *FloorService) ValidateTable(number string, required bool) error {
+	if number == "" {
+		if required {
+			return fmt.Errorf("%w: a table is required", shared.ErrInvalidInput)
+		}
+		return nil
+	}
+
+	if _, err := s.tableRepo.FindByNumber(number); err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return fmt.Errorf("%w: unknown table %s", shared.ErrInvalidInput, number)
+		}
+		return err
+	}
+	return nil
+}
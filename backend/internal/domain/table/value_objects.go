@@ -0,0 +1,22 @@
+package table
+
+type TableID string
+
+func (t TableID) String() string {
+	return string(t)
+}
+
+// Status is the live state of a table on the floor plan
+type Status string
+
+const (
+	StatusFree      Status = "free"
+	StatusOccupied  Status = "occupied"
+	StatusNeedsBill Status = "needs_bill"
+)
+
+// Position places a table on the floor plan, in the plan's own units
+type Position struct {
+	X float64
+	Y float64
+}
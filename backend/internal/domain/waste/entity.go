@@ -0,0 +1,87 @@
+package waste
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"strings"
+	"time"
+)
+
+// WasteEntry records stock that was thrown away instead of sold
+type WasteEntry struct {
+	id          WasteID
+	productID   shared.ProductID
+	quantity    float64
+	reason      Reason
+	staffMember string
+	notes       string
+	unitCost    shared.Money
+	recordedAt  time.Time
+}
+
+func NewWasteEntry(
+	id WasteID,
+	productID shared.ProductID,
+	quantity float64,
+	reason Reason,
+	staffMember string,
+	notes string,
+	unitCost shared.Money,
+) (*WasteEntry, error) {
+	if productID == "" || !reason.IsValid() || strings.TrimSpace(staffMember) == "" {
+		return nil, shared.ErrInvalidInput
+	}
+	if quantity <= 0 {
+		return nil, shared.ErrInvalidQuantity
+	}
+	if unitCost.Amount < 0 {
+		return nil, shared.ErrInvalidPrice
+	}
+
+	return &WasteEntry{
+		id:          id,
+		productID:   productID,
+		quantity:    quantity,
+		reason:      reason,
+		staffMember: staffMember,
+		notes:       notes,
+		unitCost:    unitCost,
+		recordedAt:  time.Now(),
+	}, nil
+}
+
+// Getters
+func (w *WasteEntry) ID() WasteID                 { return w.id }
+func (w *WasteEntry) ProductID() shared.ProductID { return w.productID }
+func (w *WasteEntry) Quantity() float64           { return w.quantity }
+func (w *WasteEntry) Reason() Reason              { return w.reason }
+func (w *WasteEntry) StaffMember() string         { return w.staffMember }
+func (w *WasteEntry) Notes() string               { return w.notes }
+func (w *WasteEntry) UnitCost() shared.Money      { return w.unitCost }
+func (w *WasteEntry) RecordedAt() time.Time       { return w.recordedAt }
+
+// CostValue is the wasted quantity valued at the cost price when it was recorded
+func (w *WasteEntry) CostValue() shared.Money {
+	return w.unitCost.Multiply(w.quantity)
+}
+
+func ReconstructWasteEntry(
+	id WasteID,
+	productID shared.ProductID,
+	quantity float64,
+	reason Reason,
+	staffMember string,
+	notes string,
+	unitCost shared.Money,
+	recordedAt time.Time,
+) *WasteEntry {
+	return &WasteEntry{
+		id:          id,
+		productID:   productID,
+		quantity:    quantity,
+		reason:      reason,
+		staffMember: staffMember,
+		notes:       notes,
+		unitCost:    unitCost,
+		recordedAt:  recordedAt,
+	}
+}
@@ -0,0 +1,15 @@
+package waste
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"time"
+)
+
+// WasteRepository defines the interface for waste log persistence
+type WasteRepository interface {
+	Save(entry *WasteEntry) error
+	FindByID(id WasteID) (*WasteEntry, error)
+	FindByDateRange(start, end time.Time) ([]*WasteEntry, error)
+	// ExistsByProduct reports whether any waste entry references the product
+	ExistsByProduct(productID shared.ProductID) (bool, error)
+}
@@ -0,0 +1,224 @@
+package waste
+
+import (
+	"POSFlowBackend/internal/domain/ingredient"
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/sales"
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"time"
+)
+
+// WasteService contains domain logic for recording and reporting waste
+type WasteService struct {
+	wasteRepo    WasteRepository
+	productRepo  product.ProductRepository
+	inventory    *ingredient.InventoryService
+	stock        *location.StockService
+	salesService *sales.SalesService
+}
+
+func NewWasteService(
+	wasteRepo WasteRepository,
+	productRepo product.ProductRepository,
+	inventory *ingredient.InventoryService,
+	stock *location.StockService,
+	salesService *sales.SalesService,
+) *WasteService {
+	return &WasteService{
+		wasteRepo:    wasteRepo,
+		productRepo:  productRepo,
+		inventory:    inventory,
+		stock:        stock,
+		salesService: salesService,
+	}
+}
+
+// Record logs wasted stock and takes it out of inventory at the location,
+// the default one when none is given. Products made from a recipe consume
+// their ingredients, like a sale would.
+func (s *WasteService) Record(
+	id WasteID,
+	productID shared.ProductID,
+	locationID location.LocationID,
+	quantity float64,
+	reason Reason,
+	staffMember string,
+	notes string,
+) (*WasteEntry, error) {
+	prod, err := s.productRepo.FindByID(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := prod.ValidateQuantity(quantity); err != nil {
+		return nil, err
+	}
+
+	entry, err := NewWasteEntry(id, prod.ID(), quantity, reason, staffMember, notes, prod.Cost())
+	if err != nil {
+		return nil, err
+	}
+
+	recipe, err := s.inventory.FindRecipe(prod.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	if recipe != nil {
+		if err := s.inventory.Consume(recipe.Requirements(quantity)); err != nil {
+			return nil, err
+		}
+	} else {
+		allocations, err := s.allocate(prod.ID(), locationID, quantity)
+		if err != nil {
+			return nil, err
+		}
+		if err := prod.DecreaseStock(quantity); err != nil {
+			return nil, err
+		}
+		if err := s.productRepo.Save(prod); err != nil {
+			return nil, err
+		}
+		if err := s.stock.Withdraw(allocations); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.wasteRepo.Save(entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// WriteOffLot records the remaining stock of a lot as waste and takes the lot
+// out of stock at the location, the default one when none is given
+func (s *WasteService) WriteOffLot(
+	id WasteID,
+	productID shared.ProductID,
+	locationID location.LocationID,
+	lotID product.LotID,
+	reason Reason,
+	staffMember string,
+) (*WasteEntry, error) {
+	prod, err := s.productRepo.FindByID(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	lot, err := prod.RemoveLot(lotID)
+	if err != nil {
+		return nil, err
+	}
+
+	label := lot.Code
+	if label == "" {
+		label = lot.ID.String()
+	}
+	notes := fmt.Sprintf("Lot %s, expiry %s", label, lot.ExpiresAt.Format("2006-01-02"))
+
+	entry, err := NewWasteEntry(id, prod.ID(), lot.Quantity, reason, staffMember, notes, prod.Cost())
+	if err != nil {
+		return nil, err
+	}
+
+	allocations, err := s.allocate(prod.ID(), locationID, lot.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.productRepo.Save(prod); err != nil {
+		return nil, err
+	}
+
+	if err := s.stock.Withdraw(allocations); err != nil {
+		return nil, err
+	}
+
+	if err := s.wasteRepo.Save(entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// allocate resolves the location, the default one when none is given, and
+// checks it holds the quantity of the product
+func (s *WasteService) allocate(
+	productID shared.ProductID,
+	locationID location.LocationID,
+	quantity float64,
+) ([]location.Allocation, error) {
+	loc, err := s.stock.LocationOrDefault(locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	allocations := []location.Allocation{{LocationID: loc.ID(), ProductID: productID, Quantity: quantity}}
+	if err := s.stock.CheckAvailability(allocations); err != nil {
+		return nil, err
+	}
+
+	return allocations, nil
+}
+
+// FindByDays returns waste entries from the start of the start day to the end of the end day
+func (s *WasteService) FindByDays(start, end time.Time) ([]*WasteEntry, error) {
+	startOfRange, endOfRange, err := wholeDays(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.wasteRepo.FindByDateRange(startOfRange, endOfRange)
+}
+
+// GetReport breaks down waste per reason, product and day and sets it
+// against the revenue and cost of goods sold of completed orders
+func (s *WasteService) GetReport(start, end time.Time) (*WasteReport, error) {
+	entries, err := s.FindByDays(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	margin, err := s.salesService.GetMarginReport(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	report := NewWasteReport()
+	names := map[string]string{}
+
+	for _, entry := range entries {
+		// Resolve product names once, archived ones included
+		name, ok := names[entry.ProductID().String()]
+		if !ok {
+			prod, err := s.productRepo.FindByIDIncludingArchived(entry.ProductID())
+			if err != nil {
+				return nil, err
+			}
+			name = prod.Name()
+			names[entry.ProductID().String()] = name
+		}
+
+		report.AddEntry(entry, name)
+	}
+
+	for _, day := range margin.ByDay {
+		report.AddSales(day.Key, day.Revenue, day.Cost)
+	}
+
+	report.Sort()
+	return report, nil
+}
+
+func wholeDays(start, end time.Time) (time.Time, time.Time, error) {
+	if start.After(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: start date cannot be after end date", shared.ErrInvalidInput)
+	}
+
+	startOfRange := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	endOfRange := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location()).Add(24 * time.Hour)
+	return startOfRange, endOfRange, nil
+}
@@ -0,0 +1,162 @@
+package waste
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"sort"
+)
+
+type WasteID string
+
+func (w WasteID) String() string {
+	return string(w)
+}
+
+// Reason categorises why stock was thrown away
+type Reason string
+
+const (
+	ReasonSpoiled          Reason = "spoiled"
+	ReasonExpired          Reason = "expired"
+	ReasonDamaged          Reason = "damaged"
+	ReasonOverproduction   Reason = "overproduction"
+	ReasonPreparationError Reason = "preparation_error"
+	ReasonCustomerReturn   Reason = "customer_return"
+	ReasonOther            Reason = "other"
+)
+
+func (r Reason) IsValid() bool {
+	switch r {
+	case ReasonSpoiled, ReasonExpired, ReasonDamaged, ReasonOverproduction,
+		ReasonPreparationError, ReasonCustomerReturn, ReasonOther:
+		return true
+	}
+	return false
+}
+
+// WasteLine aggregates wasted quantity and cost for one product or reason
+type WasteLine struct {
+	Key      string
+	Label    string
+	Entries  int
+	Quantity float64
+	Cost     shared.Money
+}
+
+func (l *WasteLine) add(quantity float64, cost shared.Money) {
+	l.Entries++
+	l.Quantity = shared.RoundQuantity(l.Quantity + quantity)
+	l.Cost = l.Cost.Add(cost)
+}
+
+// DailyWaste compares the cost of waste with sales on one day
+type DailyWaste struct {
+	Date         string
+	Entries      int
+	Quantity     float64
+	WasteCost    shared.Money
+	SalesRevenue shared.Money
+	SalesCost    shared.Money
+}
+
+// WastePercentOfSales returns waste cost as a percentage of sales revenue
+func (d *DailyWaste) WastePercentOfSales() float64 {
+	if d.SalesRevenue.Amount == 0 {
+		return 0
+	}
+	return d.WasteCost.Amount / d.SalesRevenue.Amount * 100
+}
+
+// WasteReport breaks down waste per reason, per product and per day,
+// with the day's sales alongside for comparison
+type WasteReport struct {
+	Total     DailyWaste
+	ByReason  []*WasteLine
+	ByProduct []*WasteLine
+	ByDay     []*DailyWaste
+
+	reasons  map[string]*WasteLine
+	products map[string]*WasteLine
+	days     map[string]*DailyWaste
+}
+
+func NewWasteReport() *WasteReport {
+	zero := shared.Money{Amount: 0, Currency: "USD"}
+	return &WasteReport{
+		Total:     DailyWaste{Date: "total", WasteCost: zero, SalesRevenue: zero, SalesCost: zero},
+		ByReason:  []*WasteLine{},
+		ByProduct: []*WasteLine{},
+		ByDay:     []*DailyWaste{},
+		reasons:   map[string]*WasteLine{},
+		products:  map[string]*WasteLine{},
+		days:      map[string]*DailyWaste{},
+	}
+}
+
+// AddEntry records a waste entry in every breakdown of the report
+func (r *WasteReport) AddEntry(entry *WasteEntry, productName string) {
+	cost := entry.CostValue()
+
+	r.Total.Entries++
+	r.Total.Quantity = shared.RoundQuantity(r.Total.Quantity + entry.Quantity())
+	r.Total.WasteCost = r.Total.WasteCost.Add(cost)
+
+	r.ByReason = addToBreakdown(r.reasons, r.ByReason, string(entry.Reason()), string(entry.Reason()), entry.Quantity(), cost)
+	r.ByProduct = addToBreakdown(r.products, r.ByProduct, entry.ProductID().String(), productName, entry.Quantity(), cost)
+
+	day := r.day(entry.RecordedAt().Format("2006-01-02"))
+	day.Entries++
+	day.Quantity = shared.RoundQuantity(day.Quantity + entry.Quantity())
+	day.WasteCost = day.WasteCost.Add(cost)
+}
+
+// AddSales records the revenue and cost of goods sold of a day
+func (r *WasteReport) AddSales(date string, revenue, cost shared.Money) {
+	r.Total.SalesRevenue = r.Total.SalesRevenue.Add(revenue)
+	r.Total.SalesCost = r.Total.SalesCost.Add(cost)
+
+	day := r.day(date)
+	day.SalesRevenue = day.SalesRevenue.Add(revenue)
+	day.SalesCost = day.SalesCost.Add(cost)
+}
+
+// Sort orders reasons and products by cost and days chronologically
+func (r *WasteReport) Sort() {
+	byCost := func(lines []*WasteLine) {
+		sort.SliceStable(lines, func(i, j int) bool {
+			return lines[i].Cost.Amount > lines[j].Cost.Amount
+		})
+	}
+	byCost(r.ByReason)
+	byCost(r.ByProduct)
+	sort.SliceStable(r.ByDay, func(i, j int) bool {
+		return r.ByDay[i].Date < r.ByDay[j].Date
+	})
+}
+
+func (r *WasteReport) day(date string) *DailyWaste {
+	day, ok := r.days[date]
+	if !ok {
+		zero := shared.Money{Amount: 0, Currency: "USD"}
+		day = &DailyWaste{Date: date, WasteCost: zero, SalesRevenue: zero, SalesCost: zero}
+		r.days[date] = day
+		r.ByDay = append(r.ByDay, day)
+	}
+	return day
+}
+
+func addToBreakdown(
+	index map[string]*WasteLine,
+	lines []*WasteLine,
+	key, label string,
+	quantity float64,
+	cost shared.Money,
+) []*WasteLine {
+	line, ok := index[key]
+	if !ok {
+		line = &WasteLine{Key: key, Label: label, Cost: shared.Money{Amount: 0, Currency: cost.Currency}}
+		index[key] = line
+		lines = append(lines, line)
+	}
+	line.add(quantity, cost)
+	return lines
+}
@@ -0,0 +1,277 @@
+package webhook
+
+import (
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/shared"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Subscription sends the selected domain events to an HTTP endpoint,
+// signed with the subscription's secret
+type Subscription struct {
+	id          SubscriptionID
+	url         string
+	secret      string
+	topics      []event.Topic
+	description string
+	active      bool
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+func NewSubscription(id SubscriptionID, endpoint, secret string, topics []event.Topic, description string) (*Subscription, error) {
+	if strings.TrimSpace(secret) == "" {
+		return nil, shared.ErrInvalidInput
+	}
+
+	sub := &Subscription{
+		id:        id,
+		secret:    secret,
+		active:    true,
+		createdAt: time.Now(),
+		updatedAt: time.Now(),
+	}
+
+	sub.Describe(description)
+	if err := sub.UpdateEndpoint(endpoint); err != nil {
+		return nil, err
+	}
+	if err := sub.Subscribe(topics); err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+// Getters
+func (s *Subscription) ID() SubscriptionID    { return s.id }
+func (s *Subscription) URL() string           { return s.url }
+func (s *Subscription) Secret() string        { return s.secret }
+func (s *Subscription) Topics() []event.Topic { return s.topics }
+func (s *Subscription) Description() string   { return s.description }
+func (s *Subscription) IsActive() bool        { return s.active }
+func (s *Subscription) CreatedAt() time.Time  { return s.createdAt }
+func (s *Subscription) UpdatedAt() time.Time  { return s.updatedAt }
+
+// Business methods
+
+// UpdateEndpoint changes the URL deliveries are posted to
+func (s *Subscription) UpdateEndpoint(endpoint string) error {
+	u, err := url.Parse(strings.TrimSpace(endpoint))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: webhook URL must be an absolute http(s) URL", shared.ErrInvalidInput)
+	}
+	s.url = u.String()
+	s.updatedAt = time.Now()
+	return nil
+}
+
+// Subscribe replaces the topics sent to the endpoint
+func (s *Subscription) Subscribe(topics []event.Topic) error {
+	if len(topics) == 0 {
+		return fmt.Errorf("%w: at least one topic is required", shared.ErrInvalidInput)
+	}
+
+	seen := make(map[event.Topic]bool)
+	unique := make([]event.Topic, 0, len(topics))
+	for _, topic := range topics {
+		if !IsSupportedTopic(topic) {
+			return fmt.Errorf("%w: unsupported topic %s", shared.ErrInvalidInput, topic)
+		}
+		if !seen[topic] {
+			seen[topic] = true
+			unique = append(unique, topic)
+		}
+	}
+
+	s.topics = unique
+	s.updatedAt = time.Now()
+	return nil
+}
+
+// Describe sets the free-text description shown to admins
+func (s *Subscription) Describe(description string) {
+	s.description = strings.TrimSpace(description)
+	s.updatedAt = time.Now()
+}
+
+// Activate resumes deliveries; Deactivate pauses them
+func (s *Subscription) Activate() {
+	s.active = true
+	s.updatedAt = time.Now()
+}
+
+func (s *Subscription) Deactivate() {
+	s.active = false
+	s.updatedAt = time.Now()
+}
+
+// Wants reports whether an event on the topic is sent to this subscription
+func (s *Subscription) Wants(topic event.Topic) bool {
+	if !s.active {
+		return false
+	}
+	for _, t := range s.topics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+func ReconstructSubscription(
+	id SubscriptionID,
+	endpoint string,
+	secret string,
+	topics []event.Topic,
+	description string,
+	active bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Subscription {
+	return &Subscription{
+		id:          id,
+		url:         endpoint,
+		secret:      secret,
+		topics:      topics,
+		description: description,
+		active:      active,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// Delivery is one event sent to one subscription, with the log of every
+// attempt made to deliver it
+type Delivery struct {
+	id             DeliveryID
+	subscriptionID SubscriptionID
+	eventID        uint64
+	eventName      event.Topic
+	payload        []byte // request body, identical on every attempt
+	status         DeliveryStatus
+	attempts       []Attempt
+	tries          int // attempts since the delivery was (re)scheduled
+	nextAttemptAt  *time.Time
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+// envelope is the body posted to the endpoint
+type envelope struct {
+	ID         uint64          `json:"id"`
+	Event      event.Topic     `json:"event"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// NewDelivery schedules an event for immediate delivery to a subscription
+func NewDelivery(sub *Subscription, msg event.Message) (*Delivery, error) {
+	payload, err := json.Marshal(envelope{
+		ID:         msg.ID,
+		Event:      msg.Name,
+		OccurredAt: msg.OccurredAt,
+		Data:       msg.Payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Delivery{
+		id:             NewDeliveryID(sub.id, msg.ID),
+		subscriptionID: sub.id,
+		eventID:        msg.ID,
+		eventName:      msg.Name,
+		payload:        payload,
+		status:         DeliveryPending,
+		attempts:       []Attempt{},
+		nextAttemptAt:  &now,
+		createdAt:      now,
+		updatedAt:      now,
+	}, nil
+}
+
+// Getters
+func (d *Delivery) ID() DeliveryID                 { return d.id }
+func (d *Delivery) SubscriptionID() SubscriptionID { return d.subscriptionID }
+func (d *Delivery) EventID() uint64                { return d.eventID }
+func (d *Delivery) EventName() event.Topic         { return d.eventName }
+func (d *Delivery) Payload() []byte                { return d.payload }
+func (d *Delivery) Status() DeliveryStatus         { return d.status }
+func (d *Delivery) Attempts() []Attempt            { return d.attempts }
+func (d *Delivery) Tries() int                     { return d.tries }
+func (d *Delivery) NextAttemptAt() *time.Time      { return d.nextAttemptAt }
+func (d *Delivery) CreatedAt() time.Time           { return d.createdAt }
+func (d *Delivery) UpdatedAt() time.Time           { return d.updatedAt }
+
+// LastAttempt returns the most recent attempt, if any
+func (d *Delivery) LastAttempt() *Attempt {
+	if len(d.attempts) == 0 {
+		return nil
+	}
+	return &d.attempts[len(d.attempts)-1]
+}
+
+// RecordAttempt logs an attempt. A failed one is retried after the policy's
+// backoff until the attempts run out, when the delivery is marked failed.
+func (d *Delivery) RecordAttempt(attempt Attempt, policy RetryPolicy) {
+	d.attempts = append(d.attempts, attempt)
+	d.tries++
+	d.updatedAt = attempt.At
+
+	switch {
+	case attempt.Succeeded():
+		d.status = DeliverySucceeded
+		d.nextAttemptAt = nil
+	case d.tries >= policy.MaxAttempts:
+		d.status = DeliveryFailed
+		d.nextAttemptAt = nil
+	default:
+		next := attempt.At.Add(policy.Delay(d.tries))
+		d.status = DeliveryPending
+		d.nextAttemptAt = &next
+	}
+}
+
+// Redeliver schedules the delivery again right away with a fresh set of
+// attempts, whatever its outcome so far
+func (d *Delivery) Redeliver() {
+	now := time.Now()
+	d.status = DeliveryPending
+	d.tries = 0
+	d.nextAttemptAt = &now
+	d.updatedAt = now
+}
+
+func ReconstructDelivery(
+	id DeliveryID,
+	subscriptionID SubscriptionID,
+	eventID uint64,
+	eventName event.Topic,
+	payload []byte,
+	status DeliveryStatus,
+	attempts []Attempt,
+	tries int,
+	nextAttemptAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Delivery {
+	return &Delivery{
+		id:             id,
+		subscriptionID: subscriptionID,
+		eventID:        eventID,
+		eventName:      eventName,
+		payload:        payload,
+		status:         status,
+		attempts:       attempts,
+		tries:          tries,
+		nextAttemptAt:  nextAttemptAt,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}
+}
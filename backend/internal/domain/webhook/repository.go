@@ -0,0 +1,23 @@
+package webhook
+
+import "time"
+
+// SubscriptionRepository defines the interface for webhook subscription persistence
+type SubscriptionRepository interface {
+	Save(sub *Subscription) error
+	FindByID(id SubscriptionID) (*Subscription, error)
+	FindAll() ([]*Subscription, error)
+	// Delete removes a subscription along with its delivery log
+	Delete(id SubscriptionID) error
+}
+
+// DeliveryRepository defines the interface for webhook delivery persistence
+type DeliveryRepository interface {
+	Save(delivery *Delivery) error
+	FindByID(id DeliveryID) (*Delivery, error)
+	// FindBySubscription returns a subscription's deliveries, newest first,
+	// optionally only those with the given status
+	FindBySubscription(id SubscriptionID, status DeliveryStatus, limit int) ([]*Delivery, error)
+	// FindDue returns pending deliveries whose next attempt is due, oldest first
+	FindDue(now time.Time, limit int) ([]*Delivery, error)
+}
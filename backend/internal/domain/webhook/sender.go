@@ -0,0 +1,8 @@
+package webhook
+
+// Sender defines the interface for posting webhook payloads
+type Sender interface {
+	// Post sends the body as JSON with the given headers and returns the
+	// response status code
+	Post(url string, headers map[string]string, body []byte) (int, error)
+}
@@ -0,0 +1,148 @@
+package webhook
+
+import (
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/shared"
+	"errors"
+	"log"
+	"time"
+)
+
+// deliveryBatchSize caps how many due deliveries one pass attempts
+const deliveryBatchSize = 50
+
+// WebhookService turns domain events into deliveries and sends them
+type WebhookService struct {
+	subscriptionRepo SubscriptionRepository
+	deliveryRepo     DeliveryRepository
+	sender           Sender
+	policy           RetryPolicy
+}
+
+func NewWebhookService(
+	subscriptionRepo SubscriptionRepository,
+	deliveryRepo DeliveryRepository,
+	sender Sender,
+	policy RetryPolicy,
+) *WebhookService {
+	return &WebhookService{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		sender:           sender,
+		policy:           policy,
+	}
+}
+
+// Enqueue implements event.Handler, scheduling a delivery of the event to
+// every active subscription that wants it
+func (s *WebhookService) Enqueue(msg event.Message) error {
+	subs, err := s.subscriptionRepo.FindAll()
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range subs {
+		if !sub.Wants(msg.Name) {
+			continue
+		}
+
+		// Already scheduled when the event was dispatched before
+		_, err := s.deliveryRepo.FindByID(NewDeliveryID(sub.ID(), msg.ID))
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, shared.ErrNotFound) {
+			return err
+		}
+
+		delivery, err := NewDelivery(sub, msg)
+		if err != nil {
+			return err
+		}
+		if err := s.deliveryRepo.Save(delivery); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeliverDue attempts the deliveries whose next attempt is due and returns
+// how many were attempted
+func (s *WebhookService) DeliverDue() (int, error) {
+	deliveries, err := s.deliveryRepo.FindDue(time.Now(), deliveryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	attempted := 0
+	for _, delivery := range deliveries {
+		if err := s.attempt(delivery); err != nil {
+			log.Printf("Error attempting webhook delivery %s: %v", delivery.ID(), err)
+			continue
+		}
+		attempted++
+	}
+	return attempted, nil
+}
+
+// Redeliver sends a delivery again right away and returns its updated log
+func (s *WebhookService) Redeliver(id DeliveryID) (*Delivery, error) {
+	delivery, err := s.deliveryRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.Redeliver()
+	if err := s.attempt(delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// Run delivers due webhooks every interval until stop is closed
+func (s *WebhookService) Run(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if _, err := s.DeliverDue(); err != nil {
+				log.Printf("Error delivering webhooks: %v", err)
+			}
+		}
+	}
+}
+
+// attempt posts the payload once, signed with the subscription's current
+// secret, and saves the outcome
+func (s *WebhookService) attempt(delivery *Delivery) error {
+	sub, err := s.subscriptionRepo.FindByID(delivery.SubscriptionID())
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	headers := map[string]string{
+		SignatureHeader: Sign(sub.Secret(), start, delivery.Payload()),
+		EventHeader:     delivery.EventName().String(),
+		DeliveryHeader:  delivery.ID().String(),
+	}
+	statusCode, err := s.sender.Post(sub.URL(), headers, delivery.Payload())
+
+	attempt := Attempt{
+		At:         start,
+		StatusCode: statusCode,
+		Duration:   time.Since(start),
+	}
+	if err != nil {
+		attempt.Error = err.Error()
+	} else if !attempt.Succeeded() {
+		attempt.Error = "unexpected response status"
+	}
+
+	delivery.RecordAttempt(attempt, s.policy)
+	return s.deliveryRepo.Save(delivery)
+}
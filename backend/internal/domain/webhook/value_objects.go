@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/shared"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SubscriptionID string
+
+func (s SubscriptionID) String() string {
+	return string(s)
+}
+
+type DeliveryID string
+
+func (d DeliveryID) String() string {
+	return string(d)
+}
+
+// NewDeliveryID derives the ID of the delivery of an event to a subscription,
+// so an event dispatched twice is still delivered once
+func NewDeliveryID(subscriptionID SubscriptionID, eventID uint64) DeliveryID {
+	return DeliveryID(fmt.Sprintf("%d-%s", eventID, subscriptionID))
+}
+
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+func (s DeliveryStatus) IsValid() bool {
+	switch s {
+	case DeliveryPending, DeliverySucceeded, DeliveryFailed:
+		return true
+	}
+	return false
+}
+
+// SupportedTopics lists the domain events that can be sent to webhooks
+var SupportedTopics = []event.Topic{
+	event.TopicOrderCreated,
+	event.TopicOrderStatusChanged,
+	event.TopicOrderCompleted,
+	event.TopicOrderMoved,
+	event.TopicOrdersMerged,
+	event.TopicOrderSplit,
+	event.TopicStockLow,
+	event.TopicStockChanged,
+	event.TopicDayClosed,
+}
+
+func IsSupportedTopic(topic event.Topic) bool {
+	for _, t := range SupportedTopics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// Attempt is one try at delivering a webhook
+type Attempt struct {
+	At         time.Time     `json:"at"`
+	StatusCode int           `json:"status_code,omitempty"`
+	Error      string        `json:"error,omitempty"`
+	Duration   time.Duration `json:"duration"`
+}
+
+// Succeeded reports whether the receiver accepted the payload with a 2xx status
+func (a Attempt) Succeeded() bool {
+	return a.Error == "" && a.StatusCode >= 200 && a.StatusCode < 300
+}
+
+// RetryPolicy spaces out retries of a failing delivery with exponential
+// backoff: BaseDelay after the first failure, doubling up to MaxDelay
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+func NewRetryPolicy(maxAttempts int, baseDelay, maxDelay time.Duration) (RetryPolicy, error) {
+	if maxAttempts <= 0 || baseDelay <= 0 || maxDelay < baseDelay {
+		return RetryPolicy{}, fmt.Errorf("%w: attempts and delays must be positive, max delay at least the base delay", shared.ErrInvalidInput)
+	}
+	return RetryPolicy{MaxAttempts: maxAttempts, BaseDelay: baseDelay, MaxDelay: maxDelay}, nil
+}
+
+// Delay returns how long to wait after the given number of failed attempts
+func (p RetryPolicy) Delay(failures int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return delay
+}
+
+// Headers sent with every delivery
+const (
+	SignatureHeader = "X-POSFlow-Signature"
+	EventHeader     = "X-POSFlow-Event"
+	DeliveryHeader  = "X-POSFlow-Delivery"
+)
+
+// Sign computes the signature header for a payload: the send time and an
+// HMAC-SHA256 of "<unix time>.<body>" keyed with the subscription secret
+func Sign(secret string, at time.Time, body []byte) string {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", timestamp, computeMAC(secret, timestamp, body))
+}
+
+// Verify checks a signature header against the payload, rejecting
+// signatures older than the tolerance to prevent replays
+func Verify(secret, header string, body []byte, tolerance time.Duration, now time.Time) error {
+	var timestamp, mac string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			mac = value
+		}
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || mac == "" {
+		return fmt.Errorf("%w: malformed signature header", shared.ErrInvalidInput)
+	}
+	if age := now.Sub(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+		return fmt.Errorf("%w: signature timestamp outside tolerance", shared.ErrInvalidInput)
+	}
+	if !hmac.Equal([]byte(mac), []byte(computeMAC(secret, timestamp, body))) {
+		return fmt.Errorf("%w: signature mismatch", shared.ErrInvalidInput)
+	}
+	return nil
+}
+
+func computeMAC(secret, timestamp string, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp))
+	h.Write([]byte("."))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
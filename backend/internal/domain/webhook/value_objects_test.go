@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	at := time.Unix(1760788800, 0)
+	body := []byte(`{"id":"evt_1"}`)
+
+	// HMAC-SHA256 of "1760788800.{"id":"evt_1"}" keyed with "whsec_test"
+	want := "t=1760788800,v1=6138567c5bc1a7e1958ae09b956efdebb9460925b6a6f7ce6f3007fcf45f217f"
+	if got := Sign("whsec_test", at, body); got != want {
+		t.Errorf("Sign() = %q, want %q", got, want)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	secret := "whsec_test"
+	body := []byte(`{"id":"evt_1"}`)
+	signedAt := time.Unix(1760788800, 0)
+	header := Sign(secret, signedAt, body)
+	tolerance := 5 * time.Minute
+
+	tests := []struct {
+		name    string
+		secret  string
+		header  string
+		body    []byte
+		now     time.Time
+		wantErr bool
+	}{
+		{name: "valid", secret: secret, header: header, body: body, now: signedAt},
+		{name: "within tolerance", secret: secret, header: header, body: body, now: signedAt.Add(tolerance)},
+		{name: "clock slightly behind", secret: secret, header: header, body: body, now: signedAt.Add(-time.Minute)},
+		{name: "spaces between parts", secret: secret, header: "t=1760788800, v1=6138567c5bc1a7e1958ae09b956efdebb9460925b6a6f7ce6f3007fcf45f217f", body: body, now: signedAt},
+		{name: "tampered body", secret: secret, header: header, body: []byte(`{"id":"evt_2"}`), now: signedAt, wantErr: true},
+		{name: "wrong secret", secret: "whsec_other", header: header, body: body, now: signedAt, wantErr: true},
+		{name: "replayed too late", secret: secret, header: header, body: body, now: signedAt.Add(tolerance + time.Second), wantErr: true},
+		{name: "timestamp in the future", secret: secret, header: header, body: body, now: signedAt.Add(-tolerance - time.Second), wantErr: true},
+		{name: "missing timestamp", secret: secret, header: "v1=6138567c5bc1a7e1958ae09b956efdebb9460925b6a6f7ce6f3007fcf45f217f", body: body, now: signedAt, wantErr: true},
+		{name: "missing mac", secret: secret, header: "t=1760788800", body: body, now: signedAt, wantErr: true},
+		{name: "timestamp not a number", secret: secret, header: "t=yesterday,v1=6138567c5bc1a7e1958ae09b956efdebb9460925b6a6f7ce6f3007fcf45f217f", body: body, now: signedAt, wantErr: true},
+		{name: "empty header", secret: secret, header: "", body: body, now: signedAt, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.secret, tt.header, tt.body, tolerance, tt.now)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("Verify() unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, shared.ErrInvalidInput) {
+				t.Fatalf("Verify() error = %v, want %v", err, shared.ErrInvalidInput)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package weighing
+
+// Scale defines the interface for reading a connected weighing scale
+type Scale interface {
+	// Read returns the weight currently on the scale, stable or not
+	Read() (Reading, error)
+}
@@ -0,0 +1,89 @@
+package weighing
+
+import (
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"time"
+)
+
+// pollInterval is how often the scale is read while waiting for it to settle
+const pollInterval = 100 * time.Millisecond
+
+// WeighingService reads stable weights and prices weighed products
+type WeighingService struct {
+	scale       Scale
+	productRepo product.ProductRepository
+	timeout     time.Duration
+}
+
+// NewWeighingService creates the service; scale may be nil when no scale is
+// configured, in which case every read fails with ErrDeviceUnavailable
+func NewWeighingService(scale Scale, productRepo product.ProductRepository, timeout time.Duration) *WeighingService {
+	return &WeighingService{
+		scale:       scale,
+		productRepo: productRepo,
+		timeout:     timeout,
+	}
+}
+
+// StableWeight polls the scale until it reports a stable weight, giving up
+// with ErrNoStableWeight once the timeout has passed
+func (s *WeighingService) StableWeight() (Reading, error) {
+	if s.scale == nil {
+		return Reading{}, fmt.Errorf("%w: no scale configured", shared.ErrDeviceUnavailable)
+	}
+
+	deadline := time.Now().Add(s.timeout)
+	for {
+		reading, err := s.scale.Read()
+		if err != nil {
+			return Reading{}, err
+		}
+		if reading.Stable {
+			return reading, nil
+		}
+		if time.Now().After(deadline) {
+			return Reading{}, fmt.Errorf("%w: weight did not settle within %s", shared.ErrNoStableWeight, s.timeout)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// Weigh reads a stable weight and prices it for a weighed product
+func (s *WeighingService) Weigh(productID shared.ProductID) (*WeighedLine, error) {
+	prod, err := s.productRepo.FindByID(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !prod.IsWeighed() {
+		return nil, fmt.Errorf("%w: %s is not sold by weight", shared.ErrInvalidInput, prod.Name())
+	}
+
+	reading, err := s.StableWeight()
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := reading.In(prod.Unit())
+	if err != nil {
+		return nil, err
+	}
+
+	if quantity <= 0 {
+		return nil, fmt.Errorf("%w: the scale is empty", shared.ErrNoStableWeight)
+	}
+
+	price := prod.Price()
+	linePrice := price.Multiply(quantity)
+
+	return &WeighedLine{
+		ProductID: prod.ID(),
+		Reading:   reading,
+		Quantity:  quantity,
+		Unit:      prod.Unit(),
+		UnitPrice: price,
+		LinePrice: linePrice.Round(),
+	}, nil
+}
@@ -0,0 +1,36 @@
+package weighing
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"time"
+)
+
+// Reading is one weight reported by the scale. Stable is false while
+// the load is still settling.
+type Reading struct {
+	Weight float64
+	Unit   shared.UnitOfMeasure
+	Stable bool
+	ReadAt time.Time
+}
+
+// In converts the weight to another mass unit, rounded to its precision
+func (r Reading) In(unit shared.UnitOfMeasure) (float64, error) {
+	factor, ok := r.Unit.ConversionFactor(unit)
+	if !ok || !unit.IsMass() {
+		return 0, fmt.Errorf("%w: cannot convert %s to %s", shared.ErrInvalidInput, r.Unit, unit)
+	}
+	return unit.Round(r.Weight * factor), nil
+}
+
+// WeighedLine is a stable weight priced for a weighed product, ready to be
+// added to an order with the weight as quantity
+type WeighedLine struct {
+	ProductID shared.ProductID
+	Reading   Reading
+	Quantity  float64 // weight in the product's sale unit
+	Unit      shared.UnitOfMeasure
+	UnitPrice shared.Money
+	LinePrice shared.Money
+}
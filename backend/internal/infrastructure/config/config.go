@@ -3,11 +3,112 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
 	DatabasePath string
 	ServerPort   string
+
+	// Reorder suggestions: days of sales history used to compute velocity,
+	// and days of cover to order up to
+	ReorderWindowDays int
+	ReorderCoverDays  int
+
+	// Lots expiring within this many days are listed as expiring soon
+	ExpiryWarningDays int
+
+	// Weighing scale: driver is none, serial, tcp or simulator. Serial scales
+	// are opened through ScaleDevice, networked ones at ScaleAddress (host:port).
+	// Reads wait up to ScaleTimeout for a reply and for the weight to settle.
+	ScaleDriver  string
+	ScaleDevice  string
+	ScaleAddress string
+	ScaleTimeout time.Duration
+
+	// Real-time events: how many recent events are kept for clients
+	// resuming from their last event ID
+	EventHistorySize int
+
+	// Domain event outbox: how often it is polled for events to dispatch,
+	// and how many failed deliveries an event gets before it is given up on
+	OutboxPollInterval time.Duration
+	OutboxMaxAttempts  int
+
+	// Outgoing webhooks: request timeout per attempt, attempts before a
+	// delivery is marked failed, backoff bounds between attempts, and how
+	// often due deliveries are sent
+	WebhookTimeout      time.Duration
+	WebhookMaxAttempts  int
+	WebhookRetryBase    time.Duration
+	WebhookRetryMax     time.Duration
+	WebhookPollInterval time.Duration
+
+	// Receipt printer: driver is none, tcp, file or memory. Network printers
+	// are reached at PrinterAddress (host:9100), others through PrinterDevice,
+	// a device such as /dev/usb/lp0 or a file capturing the raw output.
+	// Network printers, kitchen ones included, time out after PrinterTimeout.
+	PrinterDriver  string
+	PrinterAddress string
+	PrinterDevice  string
+	PrinterPaper   string
+	PrinterTimeout time.Duration
+
+	// Customer receipts: store details printed in the header, and the
+	// footer text, where a literal \n starts a new line
+	StoreName     string
+	StoreAddress  string
+	StorePhone    string
+	StoreTaxID    string
+	ReceiptFooter string
+
+	// Kitchen chits: how often the print queue is worked, and how often and
+	// how many times a chit is retried while its printer is offline
+	PrintPollInterval  time.Duration
+	PrintRetryInterval time.Duration
+	PrintMaxAttempts   int
+
+	// Cash drawer: driver is none, printer (wired to the receipt printer's
+	// drawer port) or simulator
+	DrawerDriver string
+
+	// Customer pole display: driver is none, serial, tcp or simulator. Serial
+	// displays are opened through DisplayDevice, networked ones at
+	// DisplayAddress; DisplayGreeting is shown once a sale is paid.
+	DisplayDriver   string
+	DisplayDevice   string
+	DisplayAddress  string
+	DisplayColumns  int
+	DisplayGreeting string
+
+	// Emailed receipts: driver is none or smtp. SMTPSecurity is none,
+	// starttls or tls. MailTemplateDir optionally replaces the built-in
+	// receipt.html and receipt.txt templates; the logo and accent colour
+	// brand the HTML receipt. Emails the server doesn't take are retried
+	// with exponential backoff between MailRetryBase and MailRetryMax.
+	MailDriver       string
+	SMTPHost         string
+	SMTPPort         int
+	SMTPSecurity     string
+	SMTPUsername     string
+	SMTPPassword     string
+	MailFrom         string
+	MailFromName     string
+	MailTimeout      time.Duration
+	MailTemplateDir  string
+	StoreLogoURL     string
+	StoreAccentColor string
+	MailMaxAttempts  int
+	MailRetryBase    time.Duration
+	MailRetryMax     time.Duration
+	MailPollInterval time.Duration
+
+	// Ticket numbers restart each business day. TicketPrefixes gives the
+	// channels counting on their own, as channel=letters pairs such as
+	// "takeaway=T,delivery=D"; the rest get plain numbers, none for all.
+	TicketPrefixes string
 }
 
 func LoadConfig() *Config {
@@ -31,7 +132,75 @@ func LoadConfig() *Config {
 	}
 
 	return &Config{
-		DatabasePath: dbPath,
-		ServerPort:   port,
+		DatabasePath:        dbPath,
+		ServerPort:          port,
+		ReorderWindowDays:   getEnvInt("REORDER_WINDOW_DAYS", 28),
+		ReorderCoverDays:    getEnvInt("REORDER_COVER_DAYS", 14),
+		ExpiryWarningDays:   getEnvInt("EXPIRY_WARNING_DAYS", 3),
+		ScaleDriver:         getEnv("SCALE_DRIVER", "none"),
+		ScaleDevice:         getEnv("SCALE_DEVICE", "/dev/ttyUSB0"),
+		ScaleAddress:        getEnv("SCALE_ADDRESS", "127.0.0.1:4001"),
+		ScaleTimeout:        time.Duration(getEnvInt("SCALE_TIMEOUT_MS", 2000)) * time.Millisecond,
+		EventHistorySize:    getEnvInt("EVENT_HISTORY_SIZE", 1000),
+		OutboxPollInterval:  time.Duration(getEnvInt("OUTBOX_POLL_MS", 500)) * time.Millisecond,
+		OutboxMaxAttempts:   getEnvInt("OUTBOX_MAX_ATTEMPTS", 10),
+		WebhookTimeout:      time.Duration(getEnvInt("WEBHOOK_TIMEOUT_MS", 5000)) * time.Millisecond,
+		WebhookMaxAttempts:  getEnvInt("WEBHOOK_MAX_ATTEMPTS", 8),
+		WebhookRetryBase:    time.Duration(getEnvInt("WEBHOOK_RETRY_BASE_MS", 10000)) * time.Millisecond,
+		WebhookRetryMax:     time.Duration(getEnvInt("WEBHOOK_RETRY_MAX_MS", 3600000)) * time.Millisecond,
+		WebhookPollInterval: time.Duration(getEnvInt("WEBHOOK_POLL_MS", 1000)) * time.Millisecond,
+		PrinterDriver:       getEnv("PRINTER_DRIVER", "none"),
+		PrinterAddress:      getEnv("PRINTER_ADDRESS", "127.0.0.1:9100"),
+		PrinterDevice:       getEnv("PRINTER_DEVICE", "/dev/usb/lp0"),
+		PrinterPaper:        getEnv("PRINTER_PAPER", "80mm"),
+		PrinterTimeout:      time.Duration(getEnvInt("PRINTER_TIMEOUT_MS", 3000)) * time.Millisecond,
+		StoreName:           getEnv("STORE_NAME", "POSFlow"),
+		StoreAddress:        getEnv("STORE_ADDRESS", ""),
+		StorePhone:          getEnv("STORE_PHONE", ""),
+		StoreTaxID:          getEnv("STORE_TAX_ID", ""),
+		PrintPollInterval:   time.Duration(getEnvInt("PRINT_POLL_MS", 1000)) * time.Millisecond,
+		PrintRetryInterval:  time.Duration(getEnvInt("PRINT_RETRY_MS", 5000)) * time.Millisecond,
+		PrintMaxAttempts:    getEnvInt("PRINT_MAX_ATTEMPTS", 60),
+		ReceiptFooter:       strings.ReplaceAll(getEnv("RECEIPT_FOOTER", "Thank you for your visit!"), `\n`, "\n"),
+		DrawerDriver:        getEnv("DRAWER_DRIVER", "none"),
+		DisplayDriver:       getEnv("DISPLAY_DRIVER", "none"),
+		DisplayDevice:       getEnv("DISPLAY_DEVICE", "/dev/ttyUSB1"),
+		DisplayAddress:      getEnv("DISPLAY_ADDRESS", "127.0.0.1:4002"),
+		DisplayColumns:      getEnvInt("DISPLAY_COLUMNS", 20),
+		DisplayGreeting:     getEnv("DISPLAY_GREETING", "Thank you!"),
+		MailDriver:          getEnv("MAIL_DRIVER", "none"),
+		SMTPHost:            getEnv("SMTP_HOST", "127.0.0.1"),
+		SMTPPort:            getEnvInt("SMTP_PORT", 587),
+		SMTPSecurity:        getEnv("SMTP_SECURITY", "starttls"),
+		SMTPUsername:        getEnv("SMTP_USERNAME", ""),
+		SMTPPassword:        getEnv("SMTP_PASSWORD", ""),
+		MailFrom:            getEnv("MAIL_FROM", ""),
+		MailFromName:        getEnv("MAIL_FROM_NAME", ""),
+		MailTimeout:         time.Duration(getEnvInt("MAIL_TIMEOUT_MS", 10000)) * time.Millisecond,
+		MailTemplateDir:     getEnv("MAIL_TEMPLATE_DIR", ""),
+		StoreLogoURL:        getEnv("STORE_LOGO_URL", ""),
+		StoreAccentColor:    getEnv("STORE_ACCENT_COLOR", "#2a6f97"),
+		MailMaxAttempts:     getEnvInt("MAIL_MAX_ATTEMPTS", 8),
+		MailRetryBase:       time.Duration(getEnvInt("MAIL_RETRY_BASE_MS", 30000)) * time.Millisecond,
+		MailRetryMax:        time.Duration(getEnvInt("MAIL_RETRY_MAX_MS", 3600000)) * time.Millisecond,
+		MailPollInterval:    time.Duration(getEnvInt("MAIL_POLL_MS", 2000)) * time.Millisecond,
+		TicketPrefixes:      getEnv("TICKET_PREFIXES", "takeaway=T,delivery=D,online=W"),
+	}
+}
+
+// getEnv reads a string from the environment, falling back to the default
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// getEnvInt reads a positive integer from the environment, falling back to the default
+func getEnvInt(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return fallback
 	}
+	return value
 }
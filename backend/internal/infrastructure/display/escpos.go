@@ -0,0 +1,86 @@
+package display
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Dialer opens the connection to a pole display for one update
+type Dialer func() (io.WriteCloser, error)
+
+// Customer display commands in the Epson ESC/POS (DM-D) command set, which
+// most two-line VFD and LCD pole displays understand
+var (
+	cmdInit  = []byte{0x1b, '@'}       // ESC @: reset
+	cmdClear = []byte{0x0c}            // CLR: clear and move the cursor home
+	cmdLine1 = []byte{0x1f, '$', 1, 1} // US $ x y: move the cursor to column x, row y
+	cmdLine2 = []byte{0x1f, '$', 1, 2}
+)
+
+// ESCPOSDisplay drives a two-line pole display. Each update rewrites both
+// lines, so the display always ends up showing exactly what was sent.
+type ESCPOSDisplay struct {
+	dial    Dialer
+	columns int
+
+	mu sync.Mutex
+}
+
+func NewESCPOSDisplay(dial Dialer, columns int) *ESCPOSDisplay {
+	return &ESCPOSDisplay{dial: dial, columns: columns}
+}
+
+// Show implements peripheral.PoleDisplay
+func (d *ESCPOSDisplay) Show(top, bottom string) error {
+	var buf bytes.Buffer
+	buf.Write(cmdInit)
+	buf.Write(cmdClear)
+	buf.Write(cmdLine1)
+	buf.WriteString(fit(top, d.columns))
+	buf.Write(cmdLine2)
+	buf.WriteString(fit(bottom, d.columns))
+	return d.send(buf.Bytes())
+}
+
+// Clear implements peripheral.PoleDisplay
+func (d *ESCPOSDisplay) Clear() error {
+	return d.send(append(append([]byte{}, cmdInit...), cmdClear...))
+}
+
+func (d *ESCPOSDisplay) send(data []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	conn, err := d.dial()
+	if err != nil {
+		return fmt.Errorf("%w: %v", shared.ErrDeviceUnavailable, err)
+	}
+	if _, err := conn.Write(data); err != nil {
+		conn.Close()
+		return fmt.Errorf("%w: %v", shared.ErrDeviceUnavailable, err)
+	}
+	if err := conn.Close(); err != nil {
+		return fmt.Errorf("%w: %v", shared.ErrDeviceUnavailable, err)
+	}
+	return nil
+}
+
+// fit cuts text to the display width and replaces characters outside
+// ASCII, which the displays' default character tables don't agree on
+func fit(text string, columns int) string {
+	var b strings.Builder
+	for _, r := range strings.TrimSpace(text) {
+		if b.Len() == columns {
+			break
+		}
+		if r < 0x20 || r > 0x7e {
+			r = '?'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,74 @@
+package display
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"time"
+)
+
+// Simulator is an in-memory pole display for development and tests without
+// hardware. Its Dial method plugs into ESCPOSDisplay and interprets the
+// commands it receives, so the protocol is exercised as with a real display.
+type Simulator struct {
+	mu        sync.Mutex
+	lines     [2]string
+	updatedAt time.Time
+}
+
+func NewSimulator() *Simulator {
+	return &Simulator{}
+}
+
+// Dial implements Dialer; the update is applied when the connection is closed
+func (s *Simulator) Dial() (io.WriteCloser, error) {
+	return &simulatorConn{simulator: s}, nil
+}
+
+// Lines returns what the display currently shows and when it last changed
+func (s *Simulator) Lines() (top, bottom string, updatedAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lines[0], s.lines[1], s.updatedAt
+}
+
+// apply interprets the command stream, keeping track of the cursor row
+func (s *Simulator) apply(data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	row := 0
+	for i := 0; i < len(data); i++ {
+		switch c := data[i]; {
+		case c == 0x1b && i+1 < len(data) && data[i+1] == '@':
+			i++
+		case c == 0x0c:
+			s.lines = [2]string{}
+			row = 0
+		case c == 0x1f && i+3 < len(data) && data[i+1] == '$':
+			if data[i+3] == 2 {
+				row = 1
+			} else {
+				row = 0
+			}
+			i += 3
+		case c >= 0x20 && c < 0x7f:
+			s.lines[row] += string(c)
+		}
+	}
+	s.updatedAt = time.Now()
+}
+
+type simulatorConn struct {
+	simulator *Simulator
+	buf       bytes.Buffer
+}
+
+func (c *simulatorConn) Write(p []byte) (int, error) {
+	return c.buf.Write(p)
+}
+
+func (c *simulatorConn) Close() error {
+	c.simulator.apply(c.buf.Bytes())
+	return nil
+}
@@ -0,0 +1,33 @@
+package display
+
+import (
+	"io"
+	"net"
+	"os"
+	"time"
+)
+
+// SerialDialer opens a serial pole display through its device file, e.g.
+// /dev/ttyUSB0 or COM4. Line settings (usually 9600 baud, 8N1) are taken
+// from the operating system, configured with stty or the device manager.
+func SerialDialer(device string) Dialer {
+	return func() (io.WriteCloser, error) {
+		return os.OpenFile(device, os.O_WRONLY, 0)
+	}
+}
+
+// TCPDialer connects to a display behind a serial-to-Ethernet converter,
+// given as host:port. Writes fail after the timeout.
+func TCPDialer(address string, timeout time.Duration) Dialer {
+	return func() (io.WriteCloser, error) {
+		conn, err := net.DialTimeout("tcp", address, timeout)
+		if err != nil {
+			return nil, err
+		}
+		if err := conn.SetWriteDeadline(time.Now().Add(timeout)); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return conn, nil
+	}
+}
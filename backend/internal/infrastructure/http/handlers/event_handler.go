@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/infrastructure/realtime"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+// heartbeatInterval keeps idle event streams from being closed by proxies
+const heartbeatInterval = 15 * time.Second
+
+// EventHandler streams real-time events to clients
+type EventHandler struct {
+	broker *realtime.Broker
+}
+
+// NewEventHandler creates a new event handler
+func NewEventHandler(broker *realtime.Broker) *EventHandler {
+	return &EventHandler{broker: broker}
+}
+
+// StreamEvents streams events as Server-Sent Events
+// GET /api/v1/events?topics=order,stock.low&last_event_id=...
+func (h *EventHandler) StreamEvents(c *gin.Context) {
+	sub := h.broker.Subscribe(event.ParseTopics(c.Query("topics")), lastEventID(c))
+	defer h.broker.Unsubscribe(sub)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	// Ask the browser to reconnect quickly, then catch the client up
+	fmt.Fprint(c.Writer, "retry: 3000\n\n")
+	for _, ev := range sub.Replay {
+		writeSSE(c.Writer, ev)
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case ev, ok := <-sub.Events():
+			if !ok {
+				// Dropped for falling behind; the client resumes from its last ID
+				return
+			}
+			writeSSE(c.Writer, ev)
+			c.Writer.Flush()
+		case <-heartbeat.C:
+			fmt.Fprint(c.Writer, ": ping\n\n")
+			c.Writer.Flush()
+		}
+	}
+}
+
+// StreamEventsWS streams events over a WebSocket, one JSON message per event
+// GET /api/v1/events/ws?topics=order,stock.low&last_event_id=...
+func (h *EventHandler) StreamEventsWS(c *gin.Context) {
+	filters := event.ParseTopics(c.Query("topics"))
+	lastID := lastEventID(c)
+
+	server := websocket.Server{
+		// Accept any origin, as the CORS middleware does for plain requests
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(ws *websocket.Conn) {
+			sub := h.broker.Subscribe(filters, lastID)
+			defer h.broker.Unsubscribe(sub)
+
+			// The stream is one-way; reading only notices the client leaving
+			closed := make(chan struct{})
+			go func() {
+				defer close(closed)
+				var discard []byte
+				for websocket.Message.Receive(ws, &discard) == nil {
+				}
+			}()
+
+			for _, ev := range sub.Replay {
+				if websocket.JSON.Send(ws, ev) != nil {
+					return
+				}
+			}
+
+			for {
+				select {
+				case <-closed:
+					return
+				case ev, ok := <-sub.Events():
+					if !ok || websocket.JSON.Send(ws, ev) != nil {
+						return
+					}
+				}
+			}
+		},
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// lastEventID reads the ID a reconnecting client last saw, from the
+// Last-Event-ID header browsers send or the last_event_id query parameter
+func lastEventID(c *gin.Context) uint64 {
+	value := c.GetHeader("Last-Event-ID")
+	if value == "" {
+		value = c.Query("last_event_id")
+	}
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+func writeSSE(w gin.ResponseWriter, ev realtime.Event) {
+	fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", ev.ID, ev.Topic, ev.Data)
+}
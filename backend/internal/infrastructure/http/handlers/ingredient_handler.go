@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"POSFlowBackend/internal/application/ingredient/commands"
+	"POSFlowBackend/internal/application/ingredient/dto"
+	"POSFlowBackend/internal/application/ingredient/queries"
+	"POSFlowBackend/internal/infrastructure/http/request"
+	"POSFlowBackend/internal/infrastructure/http/response"
+	"log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// IngredientHandler handles HTTP requests for ingredients and recipes
+type IngredientHandler struct {
+	createCommand      *commands.CreateIngredientCommand
+	updateCommand      *commands.UpdateIngredientCommand
+	updateStockCommand *commands.UpdateIngredientStockCommand
+	setRecipeCommand   *commands.SetRecipeCommand
+	deleteRecipeCmd    *commands.DeleteRecipeCommand
+	listQuery          *queries.ListIngredientsQuery
+	getQuery           *queries.GetIngredientQuery
+	getLowStockQuery   *queries.GetLowStockIngredientsQuery
+	getRecipeQuery     *queries.GetRecipeQuery
+}
+
+// NewIngredientHandler creates a new ingredient handler
+func NewIngredientHandler(
+	createCommand *commands.CreateIngredientCommand,
+	updateCommand *commands.UpdateIngredientCommand,
+	updateStockCommand *commands.UpdateIngredientStockCommand,
+	setRecipeCommand *commands.SetRecipeCommand,
+	deleteRecipeCmd *commands.DeleteRecipeCommand,
+	listQuery *queries.ListIngredientsQuery,
+	getQuery *queries.GetIngredientQuery,
+	getLowStockQuery *queries.GetLowStockIngredientsQuery,
+	getRecipeQuery *queries.GetRecipeQuery,
+) *IngredientHandler {
+	return &IngredientHandler{
+		createCommand:      createCommand,
+		updateCommand:      updateCommand,
+		updateStockCommand: updateStockCommand,
+		setRecipeCommand:   setRecipeCommand,
+		deleteRecipeCmd:    deleteRecipeCmd,
+		listQuery:          listQuery,
+		getQuery:           getQuery,
+		getLowStockQuery:   getLowStockQuery,
+		getRecipeQuery:     getRecipeQuery,
+	}
+}
+
+// CreateIngredient creates a new ingredient
+// POST /api/v1/ingredients
+func (h *IngredientHandler) CreateIngredient(c *gin.Context) {
+	var req dto.CreateIngredientRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	ingredient, err := h.createCommand.Execute(req)
+	if err != nil {
+		log.Printf("Error creating ingredient: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, ingredient, "Ingredient created successfully")
+}
+
+// GetIngredient retrieves an ingredient by ID
+// GET /api/v1/ingredients/:id
+func (h *IngredientHandler) GetIngredient(c *gin.Context) {
+	ingredientID := request.GetPathParam(c, "id")
+
+	// Execute query
+	ingredient, err := h.getQuery.Execute(ingredientID)
+	if err != nil {
+		log.Printf("Error getting ingredient: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, ingredient, "Ingredient retrieved successfully")
+}
+
+// ListIngredients retrieves all ingredients
+// GET /api/v1/ingredients
+func (h *IngredientHandler) ListIngredients(c *gin.Context) {
+	// Execute query
+	ingredients, err := h.listQuery.Execute()
+	if err != nil {
+		log.Printf("Error listing ingredients: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, ingredients, "Ingredients retrieved successfully")
+}
+
+// GetLowStockIngredients retrieves ingredients at or below their low stock level
+// GET /api/v1/ingredients/low-stock
+func (h *IngredientHandler) GetLowStockIngredients(c *gin.Context) {
+	// Execute query
+	ingredients, err := h.getLowStockQuery.Execute()
+	if err != nil {
+		log.Printf("Error getting low stock ingredients: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, ingredients, "Low stock ingredients retrieved successfully")
+}
+
+// UpdateIngredient updates an existing ingredient
+// PUT /api/v1/ingredients/:id
+func (h *IngredientHandler) UpdateIngredient(c *gin.Context) {
+	ingredientID := request.GetPathParam(c, "id")
+
+	var req dto.UpdateIngredientRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	ingredient, err := h.updateCommand.Execute(ingredientID, req)
+	if err != nil {
+		log.Printf("Error updating ingredient: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, ingredient, "Ingredient updated successfully")
+}
+
+// UpdateIngredientStock updates ingredient stock
+// POST /api/v1/ingredients/:id/stock
+func (h *IngredientHandler) UpdateIngredientStock(c *gin.Context) {
+	ingredientID := request.GetPathParam(c, "id")
+
+	var req dto.UpdateIngredientStockRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	ingredient, err := h.updateStockCommand.Execute(ingredientID, req)
+	if err != nil {
+		log.Printf("Error updating ingredient stock: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, ingredient, "Ingredient stock updated successfully")
+}
+
+// GetRecipe retrieves the recipe of a product
+// GET /api/v1/products/:id/recipe
+func (h *IngredientHandler) GetRecipe(c *gin.Context) {
+	productID := request.GetPathParam(c, "id")
+
+	// Execute query
+	recipe, err := h.getRecipeQuery.Execute(productID)
+	if err != nil {
+		log.Printf("Error getting recipe: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, recipe, "Recipe retrieved successfully")
+}
+
+// SetRecipe replaces the recipe of a product
+// PUT /api/v1/products/:id/recipe
+func (h *IngredientHandler) SetRecipe(c *gin.Context) {
+	productID := request.GetPathParam(c, "id")
+
+	var req dto.SetRecipeRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	recipe, err := h.setRecipeCommand.Execute(productID, req)
+	if err != nil {
+		log.Printf("Error setting recipe: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, recipe, "Recipe saved successfully")
+}
+
+// DeleteRecipe removes the recipe of a product
+// DELETE /api/v1/products/:id/recipe
+func (h *IngredientHandler) DeleteRecipe(c *gin.Context) {
+	productID := request.GetPathParam(c, "id")
+
+	// Execute command
+	if err := h.deleteRecipeCmd.Execute(productID); err != nil {
+		log.Printf("Error deleting recipe: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, nil, "Recipe deleted successfully")
+}
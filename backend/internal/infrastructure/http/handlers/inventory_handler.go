@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	productCommands "POSFlowBackend/internal/application/product/commands"
+	productDTO "POSFlowBackend/internal/application/product/dto"
+	productQueries "POSFlowBackend/internal/application/product/queries"
+	purchasingCommands "POSFlowBackend/internal/application/purchasing/commands"
+	purchasingDTO "POSFlowBackend/internal/application/purchasing/dto"
+	"POSFlowBackend/internal/application/replenishment/dto"
+	"POSFlowBackend/internal/application/replenishment/queries"
+	"POSFlowBackend/internal/infrastructure/http/request"
+	"POSFlowBackend/internal/infrastructure/http/response"
+	"log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// InventoryHandler handles HTTP requests for inventory planning
+type InventoryHandler struct {
+	reorderSuggestionsQuery *queries.GetReorderSuggestionsQuery
+	createDraftCommand      *purchasingCommands.CreateDraftFromSuggestionsCommand
+	receiveLotCommand       *productCommands.ReceiveLotCommand
+	listLotsQuery           *productQueries.ListLotsQuery
+	expiringLotsQuery       *productQueries.GetExpiringLotsQuery
+}
+
+// NewInventoryHandler creates a new inventory handler
+func NewInventoryHandler(
+	reorderSuggestionsQuery *queries.GetReorderSuggestionsQuery,
+	createDraftCommand *purchasingCommands.CreateDraftFromSuggestionsCommand,
+	receiveLotCommand *productCommands.ReceiveLotCommand,
+	listLotsQuery *productQueries.ListLotsQuery,
+	expiringLotsQuery *productQueries.GetExpiringLotsQuery,
+) *InventoryHandler {
+	return &InventoryHandler{
+		reorderSuggestionsQuery: reorderSuggestionsQuery,
+		createDraftCommand:      createDraftCommand,
+		receiveLotCommand:       receiveLotCommand,
+		listLotsQuery:           listLotsQuery,
+		expiringLotsQuery:       expiringLotsQuery,
+	}
+}
+
+// GetReorderSuggestions lists products to reorder based on sales velocity
+// GET /api/v1/inventory/reorder-suggestions?window_days=28&cover_days=14
+func (h *InventoryHandler) GetReorderSuggestions(c *gin.Context) {
+	var req dto.ReorderSuggestionsRequest
+
+	// Bind query parameters
+	if err := request.BindQuery(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute query
+	suggestions, err := h.reorderSuggestionsQuery.Execute(req)
+	if err != nil {
+		log.Printf("Error getting reorder suggestions: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, suggestions, "Reorder suggestions retrieved successfully")
+}
+
+// CreateDraftPurchaseOrder exports the reorder suggestions as a draft purchase order
+// POST /api/v1/inventory/reorder-suggestions/purchase-order
+func (h *InventoryHandler) CreateDraftPurchaseOrder(c *gin.Context) {
+	var req purchasingDTO.CreateDraftFromSuggestionsRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	po, err := h.createDraftCommand.Execute(req)
+	if err != nil {
+		log.Printf("Error creating draft purchase order: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, po, "Draft purchase order created successfully")
+}
+
+// ReceiveLot adds stock to a product in a lot with an expiry date
+// POST /api/v1/products/:id/lots
+func (h *InventoryHandler) ReceiveLot(c *gin.Context) {
+	productID := request.GetPathParam(c, "id")
+
+	var req productDTO.ReceiveLotRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	lot, err := h.receiveLotCommand.Execute(productID, req)
+	if err != nil {
+		log.Printf("Error receiving lot: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, lot, "Lot received successfully")
+}
+
+// ListLots retrieves the lots of a product, earliest expiry first
+// GET /api/v1/products/:id/lots
+func (h *InventoryHandler) ListLots(c *gin.Context) {
+	productID := request.GetPathParam(c, "id")
+
+	// Execute query
+	lots, err := h.listLotsQuery.Execute(productID)
+	if err != nil {
+		log.Printf("Error listing lots: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, lots, "Lots retrieved successfully")
+}
+
+// GetExpiringLots lists lots expiring within the next N days, expired ones included
+// GET /api/v1/inventory/lots/expiring?days=3
+func (h *InventoryHandler) GetExpiringLots(c *gin.Context) {
+	var req productDTO.ExpiringLotsRequest
+
+	// Bind query parameters
+	if err := request.BindQuery(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute query
+	lots, err := h.expiringLotsQuery.Execute(req)
+	if err != nil {
+		log.Printf("Error getting expiring lots: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, lots, "Expiring lots retrieved successfully")
+}
@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"POSFlowBackend/internal/application/printing/commands"
+	"POSFlowBackend/internal/application/printing/dto"
+	"POSFlowBackend/internal/application/printing/queries"
+	"POSFlowBackend/internal/infrastructure/http/request"
+	"POSFlowBackend/internal/infrastructure/http/response"
+	"log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// KitchenPrinterHandler handles HTTP requests for kitchen printers and their print queue
+type KitchenPrinterHandler struct {
+	createPrinterCommand *commands.CreateKitchenPrinterCommand
+	updatePrinterCommand *commands.UpdateKitchenPrinterCommand
+	deletePrinterCommand *commands.DeleteKitchenPrinterCommand
+	reprintCommand       *commands.ReprintKitchenTicketsCommand
+	retryPrintJobCommand *commands.RetryPrintJobCommand
+	listPrintersQuery    *queries.ListKitchenPrintersQuery
+	getPrinterQuery      *queries.GetKitchenPrinterQuery
+	listPrintJobsQuery   *queries.ListPrintJobsQuery
+	getPrintJobQuery     *queries.GetPrintJobQuery
+}
+
+// NewKitchenPrinterHandler creates a new kitchen printer handler
+func NewKitchenPrinterHandler(
+	createPrinterCommand *commands.CreateKitchenPrinterCommand,
+	updatePrinterCommand *commands.UpdateKitchenPrinterCommand,
+	deletePrinterCommand *commands.DeleteKitchenPrinterCommand,
+	reprintCommand *commands.ReprintKitchenTicketsCommand,
+	retryPrintJobCommand *commands.RetryPrintJobCommand,
+	listPrintersQuery *queries.ListKitchenPrintersQuery,
+	getPrinterQuery *queries.GetKitchenPrinterQuery,
+	listPrintJobsQuery *queries.ListPrintJobsQuery,
+	getPrintJobQuery *queries.GetPrintJobQuery,
+) *KitchenPrinterHandler {
+	return &KitchenPrinterHandler{
+		createPrinterCommand: createPrinterCommand,
+		updatePrinterCommand: updatePrinterCommand,
+		deletePrinterCommand: deletePrinterCommand,
+		reprintCommand:       reprintCommand,
+		retryPrintJobCommand: retryPrintJobCommand,
+		listPrintersQuery:    listPrintersQuery,
+		getPrinterQuery:      getPrinterQuery,
+		listPrintJobsQuery:   listPrintJobsQuery,
+		getPrintJobQuery:     getPrintJobQuery,
+	}
+}
+
+// CreatePrinter adds a kitchen printer
+// POST /api/v1/kitchen-printers
+func (h *KitchenPrinterHandler) CreatePrinter(c *gin.Context) {
+	var req dto.CreateKitchenPrinterRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	printer, err := h.createPrinterCommand.Execute(req)
+	if err != nil {
+		log.Printf("Error creating kitchen printer: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, printer, "Kitchen printer created successfully")
+}
+
+// ListPrinters retrieves all kitchen printers
+// GET /api/v1/kitchen-printers
+func (h *KitchenPrinterHandler) ListPrinters(c *gin.Context) {
+	// Execute query
+	printers, err := h.listPrintersQuery.Execute()
+	if err != nil {
+		log.Printf("Error listing kitchen printers: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, printers, "Kitchen printers retrieved successfully")
+}
+
+// GetPrinter retrieves a kitchen printer by ID
+// GET /api/v1/kitchen-printers/:id
+func (h *KitchenPrinterHandler) GetPrinter(c *gin.Context) {
+	printerID := request.GetPathParam(c, "id")
+
+	// Execute query
+	printer, err := h.getPrinterQuery.Execute(printerID)
+	if err != nil {
+		log.Printf("Error getting kitchen printer: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, printer, "Kitchen printer retrieved successfully")
+}
+
+// UpdatePrinter changes a kitchen printer's connection or the stations it serves
+// PUT /api/v1/kitchen-printers/:id
+func (h *KitchenPrinterHandler) UpdatePrinter(c *gin.Context) {
+	printerID := request.GetPathParam(c, "id")
+
+	var req dto.UpdateKitchenPrinterRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	printer, err := h.updatePrinterCommand.Execute(printerID, req)
+	if err != nil {
+		log.Printf("Error updating kitchen printer: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, printer, "Kitchen printer updated successfully")
+}
+
+// DeletePrinter removes a kitchen printer
+// DELETE /api/v1/kitchen-printers/:id
+func (h *KitchenPrinterHandler) DeletePrinter(c *gin.Context) {
+	printerID := request.GetPathParam(c, "id")
+
+	// Execute command
+	if err := h.deletePrinterCommand.Execute(printerID); err != nil {
+		log.Printf("Error deleting kitchen printer: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, nil, "Kitchen printer deleted successfully")
+}
+
+// Reprint queues copies of an order's kitchen chits
+// POST /api/v1/orders/:id/kitchen-tickets/reprint
+func (h *KitchenPrinterHandler) Reprint(c *gin.Context) {
+	orderID := request.GetPathParam(c, "id")
+
+	var req dto.ReprintRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	jobs, err := h.reprintCommand.Execute(orderID, req)
+	if err != nil {
+		log.Printf("Error reprinting kitchen chits: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, jobs, "Kitchen chits queued for reprint")
+}
+
+// ListPrintJobs retrieves the print queue, e.g. ?status=failed
+// GET /api/v1/print-jobs
+func (h *KitchenPrinterHandler) ListPrintJobs(c *gin.Context) {
+	var req dto.ListPrintJobsRequest
+
+	// Bind and validate query parameters
+	if err := request.BindQuery(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute query
+	jobs, err := h.listPrintJobsQuery.Execute(req)
+	if err != nil {
+		log.Printf("Error listing print jobs: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, jobs, "Print jobs retrieved successfully")
+}
+
+// GetPrintJob retrieves a print job with its chit as plain text
+// GET /api/v1/print-jobs/:id
+func (h *KitchenPrinterHandler) GetPrintJob(c *gin.Context) {
+	jobID := request.GetPathParam(c, "id")
+
+	// Execute query
+	job, err := h.getPrintJobQuery.Execute(jobID)
+	if err != nil {
+		log.Printf("Error getting print job: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, job, "Print job retrieved successfully")
+}
+
+// RetryPrintJob prints a job again right away, e.g. once a failed printer is back
+// POST /api/v1/print-jobs/:id/retry
+func (h *KitchenPrinterHandler) RetryPrintJob(c *gin.Context) {
+	jobID := request.GetPathParam(c, "id")
+
+	// Execute command
+	job, err := h.retryPrintJobCommand.Execute(jobID)
+	if err != nil {
+		log.Printf("Error retrying print job: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, job, "Print job retried")
+}
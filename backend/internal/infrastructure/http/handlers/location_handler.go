@@ -0,0 +1,276 @@
+package handlers
+
+import (
+	"POSFlowBackend/internal/application/location/commands"
+	"POSFlowBackend/internal/application/location/dto"
+	"POSFlowBackend/internal/application/location/queries"
+	"POSFlowBackend/internal/infrastructure/http/request"
+	"POSFlowBackend/internal/infrastructure/http/response"
+	"log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LocationHandler handles HTTP requests for stock locations and transfers
+type LocationHandler struct {
+	createLocationCommand    *commands.CreateLocationCommand
+	updateLocationCommand    *commands.UpdateLocationCommand
+	setLowStockLevelCommand  *commands.SetLowStockLevelCommand
+	createTransferCommand    *commands.CreateTransferCommand
+	listLocationsQuery       *queries.ListLocationsQuery
+	getLocationQuery         *queries.GetLocationQuery
+	getStockQuery            *queries.GetLocationStockQuery
+	getLowStockQuery         *queries.GetLocationLowStockQuery
+	getProductLocationsQuery *queries.GetProductLocationsQuery
+	listTransfersQuery       *queries.ListTransfersQuery
+	getTransferQuery         *queries.GetTransferQuery
+}
+
+// NewLocationHandler creates a new location handler
+func NewLocationHandler(
+	createLocationCommand *commands.CreateLocationCommand,
+	updateLocationCommand *commands.UpdateLocationCommand,
+	setLowStockLevelCommand *commands.SetLowStockLevelCommand,
+	createTransferCommand *commands.CreateTransferCommand,
+	listLocationsQuery *queries.ListLocationsQuery,
+	getLocationQuery *queries.GetLocationQuery,
+	getStockQuery *queries.GetLocationStockQuery,
+	getLowStockQuery *queries.GetLocationLowStockQuery,
+	getProductLocationsQuery *queries.GetProductLocationsQuery,
+	listTransfersQuery *queries.ListTransfersQuery,
+	getTransferQuery *queries.GetTransferQuery,
+) *LocationHandler {
+	return &LocationHandler{
+		createLocationCommand:    createLocationCommand,
+		updateLocationCommand:    updateLocationCommand,
+		setLowStockLevelCommand:  setLowStockLevelCommand,
+		createTransferCommand:    createTransferCommand,
+		listLocationsQuery:       listLocationsQuery,
+		getLocationQuery:         getLocationQuery,
+		getStockQuery:            getStockQuery,
+		getLowStockQuery:         getLowStockQuery,
+		getProductLocationsQuery: getProductLocationsQuery,
+		listTransfersQuery:       listTransfersQuery,
+		getTransferQuery:         getTransferQuery,
+	}
+}
+
+// CreateLocation creates a new stock location
+// POST /api/v1/locations
+func (h *LocationHandler) CreateLocation(c *gin.Context) {
+	var req dto.CreateLocationRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	loc, err := h.createLocationCommand.Execute(req)
+	if err != nil {
+		log.Printf("Error creating location: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, loc, "Location created successfully")
+}
+
+// ListLocations retrieves all stock locations
+// GET /api/v1/locations
+func (h *LocationHandler) ListLocations(c *gin.Context) {
+	// Execute query
+	locations, err := h.listLocationsQuery.Execute()
+	if err != nil {
+		log.Printf("Error listing locations: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, locations, "Locations retrieved successfully")
+}
+
+// GetLocation retrieves a stock location by ID
+// GET /api/v1/locations/:id
+func (h *LocationHandler) GetLocation(c *gin.Context) {
+	locationID := request.GetPathParam(c, "id")
+
+	// Execute query
+	loc, err := h.getLocationQuery.Execute(locationID)
+	if err != nil {
+		log.Printf("Error getting location: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, loc, "Location retrieved successfully")
+}
+
+// UpdateLocation updates a location's name and routing
+// PUT /api/v1/locations/:id
+func (h *LocationHandler) UpdateLocation(c *gin.Context) {
+	locationID := request.GetPathParam(c, "id")
+
+	var req dto.UpdateLocationRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	loc, err := h.updateLocationCommand.Execute(locationID, req)
+	if err != nil {
+		log.Printf("Error updating location: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, loc, "Location updated successfully")
+}
+
+// GetLocationStock retrieves the stock held at a location
+// GET /api/v1/locations/:id/stock
+func (h *LocationHandler) GetLocationStock(c *gin.Context) {
+	locationID := request.GetPathParam(c, "id")
+
+	// Execute query
+	stock, err := h.getStockQuery.Execute(locationID)
+	if err != nil {
+		log.Printf("Error getting location stock: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, stock, "Location stock retrieved successfully")
+}
+
+// GetLocationLowStock retrieves products at or below their low stock level at a location
+// GET /api/v1/locations/:id/low-stock
+func (h *LocationHandler) GetLocationLowStock(c *gin.Context) {
+	locationID := request.GetPathParam(c, "id")
+
+	// Execute query
+	stock, err := h.getLowStockQuery.Execute(locationID)
+	if err != nil {
+		log.Printf("Error getting location low stock: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, stock, "Low stock products retrieved successfully")
+}
+
+// SetLowStockLevel sets the low stock threshold of a product at a location
+// PUT /api/v1/locations/:id/stock/:product_id
+func (h *LocationHandler) SetLowStockLevel(c *gin.Context) {
+	locationID := request.GetPathParam(c, "id")
+	productID := request.GetPathParam(c, "product_id")
+
+	var req dto.SetLowStockLevelRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	position, err := h.setLowStockLevelCommand.Execute(locationID, productID, req)
+	if err != nil {
+		log.Printf("Error setting low stock level: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, position, "Low stock level updated successfully")
+}
+
+// GetProductLocations retrieves the stock of a product at every location
+// GET /api/v1/products/:id/locations
+func (h *LocationHandler) GetProductLocations(c *gin.Context) {
+	productID := request.GetPathParam(c, "id")
+
+	// Execute query
+	stock, err := h.getProductLocationsQuery.Execute(productID)
+	if err != nil {
+		log.Printf("Error getting product locations: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, stock, "Product stock by location retrieved successfully")
+}
+
+// CreateTransfer moves stock from one location to another
+// POST /api/v1/transfers
+func (h *LocationHandler) CreateTransfer(c *gin.Context) {
+	var req dto.CreateTransferRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	transfer, err := h.createTransferCommand.Execute(req)
+	if err != nil {
+		log.Printf("Error creating transfer: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, transfer, "Transfer created successfully")
+}
+
+// ListTransfers retrieves transfer history, optionally for one location
+// GET /api/v1/transfers
+func (h *LocationHandler) ListTransfers(c *gin.Context) {
+	var req dto.ListTransfersRequest
+
+	// Bind query parameters
+	if err := request.BindQuery(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute query
+	transfers, err := h.listTransfersQuery.Execute(req)
+	if err != nil {
+		log.Printf("Error listing transfers: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, transfers, "Transfers retrieved successfully")
+}
+
+// GetTransfer retrieves a transfer document by ID
+// GET /api/v1/transfers/:id
+func (h *LocationHandler) GetTransfer(c *gin.Context) {
+	transferID := request.GetPathParam(c, "id")
+
+	// Execute query
+	transfer, err := h.getTransferQuery.Execute(transferID)
+	if err != nil {
+		log.Printf("Error getting transfer: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, transfer, "Transfer retrieved successfully")
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"POSFlowBackend/internal/application/mail/commands"
+	"POSFlowBackend/internal/application/mail/dto"
+	"POSFlowBackend/internal/application/mail/queries"
+	"POSFlowBackend/internal/infrastructure/http/request"
+	"POSFlowBackend/internal/infrastructure/http/response"
+	"log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MailHandler handles HTTP requests for emailed receipts and the outgoing email queue
+type MailHandler struct {
+	sendReceiptCommand  *commands.SendReceiptEmailCommand
+	retryEmailCommand   *commands.RetryEmailCommand
+	previewReceiptQuery *queries.PreviewReceiptEmailQuery
+	listEmailsQuery     *queries.ListEmailsQuery
+	getEmailQuery       *queries.GetEmailQuery
+}
+
+// NewMailHandler creates a new mail handler
+func NewMailHandler(
+	sendReceiptCommand *commands.SendReceiptEmailCommand,
+	retryEmailCommand *commands.RetryEmailCommand,
+	previewReceiptQuery *queries.PreviewReceiptEmailQuery,
+	listEmailsQuery *queries.ListEmailsQuery,
+	getEmailQuery *queries.GetEmailQuery,
+) *MailHandler {
+	return &MailHandler{
+		sendReceiptCommand:  sendReceiptCommand,
+		retryEmailCommand:   retryEmailCommand,
+		previewReceiptQuery: previewReceiptQuery,
+		listEmailsQuery:     listEmailsQuery,
+		getEmailQuery:       getEmailQuery,
+	}
+}
+
+// SendReceipt queues an order's receipt for emailing
+// POST /api/v1/orders/:id/receipt/email
+func (h *MailHandler) SendReceipt(c *gin.Context) {
+	orderID := request.GetPathParam(c, "id")
+
+	var req dto.SendReceiptEmailRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	email, err := h.sendReceiptCommand.Execute(orderID, req)
+	if err != nil {
+		log.Printf("Error emailing receipt: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, email, "Receipt email queued successfully")
+}
+
+// PreviewReceipt renders an order's receipt email without sending it
+// GET /api/v1/orders/:id/receipt/email?email=...
+func (h *MailHandler) PreviewReceipt(c *gin.Context) {
+	orderID := request.GetPathParam(c, "id")
+
+	var req dto.PreviewReceiptEmailRequest
+
+	// Bind and validate query parameters
+	if err := request.BindQuery(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute query
+	msg, err := h.previewReceiptQuery.Execute(orderID, req)
+	if err != nil {
+		log.Printf("Error previewing receipt email: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, msg, "Receipt email rendered successfully")
+}
+
+// ListEmails retrieves the outgoing email queue, e.g. ?status=failed
+// GET /api/v1/emails
+func (h *MailHandler) ListEmails(c *gin.Context) {
+	var req dto.ListEmailsRequest
+
+	// Bind and validate query parameters
+	if err := request.BindQuery(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute query
+	emails, err := h.listEmailsQuery.Execute(req)
+	if err != nil {
+		log.Printf("Error listing emails: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, emails, "Emails retrieved successfully")
+}
+
+// GetEmail retrieves a queued email
+// GET /api/v1/emails/:id
+func (h *MailHandler) GetEmail(c *gin.Context) {
+	emailID := request.GetPathParam(c, "id")
+
+	// Execute query
+	email, err := h.getEmailQuery.Execute(emailID)
+	if err != nil {
+		log.Printf("Error getting email: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, email, "Email retrieved successfully")
+}
+
+// RetryEmail sends an unsent email again right away
+// POST /api/v1/emails/:id/retry
+func (h *MailHandler) RetryEmail(c *gin.Context) {
+	emailID := request.GetPathParam(c, "id")
+
+	// Execute command
+	email, err := h.retryEmailCommand.Execute(emailID)
+	if err != nil {
+		log.Printf("Error retrying email: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, email, "Email retried")
+}
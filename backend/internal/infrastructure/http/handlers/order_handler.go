@@ -4,10 +4,13 @@ import (
 	"POSFlowBackend/internal/application/order/commands"
 	"POSFlowBackend/internal/application/order/dto"
 	queries "POSFlowBackend/internal/application/order/querys"
+	"POSFlowBackend/internal/domain/shared"
 
 	"POSFlowBackend/internal/infrastructure/http/request"
 	"POSFlowBackend/internal/infrastructure/http/response"
+	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
@@ -16,25 +19,40 @@ import (
 type OrderHandler struct {
 	createCommand       *commands.CreateOrderCommand
 	updateStatusCommand *commands.UpdateOrderStatusCommand
+	updateItemCommand   *commands.UpdateItemStatusCommand
+	moveCommand         *commands.MoveOrderCommand
+	mergeCommand        *commands.MergeOrdersCommand
+	splitCommand        *commands.SplitOrderCommand
 	listQuery           *queries.ListOrdersQuery
 	getQuery            *queries.GetOrderQuery
 	getPendingQuery     *queries.GetPendingOrdersQuery
+	getAuditQuery       *queries.GetOrderAuditQuery
 }
 
 // NewOrderHandler creates a new order handler
 func NewOrderHandler(
 	createCommand *commands.CreateOrderCommand,
 	updateStatusCommand *commands.UpdateOrderStatusCommand,
+	updateItemCommand *commands.UpdateItemStatusCommand,
+	moveCommand *commands.MoveOrderCommand,
+	mergeCommand *commands.MergeOrdersCommand,
+	splitCommand *commands.SplitOrderCommand,
 	listQuery *queries.ListOrdersQuery,
 	getQuery *queries.GetOrderQuery,
 	getPendingQuery *queries.GetPendingOrdersQuery,
+	getAuditQuery *queries.GetOrderAuditQuery,
 ) *OrderHandler {
 	return &OrderHandler{
 		createCommand:       createCommand,
 		updateStatusCommand: updateStatusCommand,
+		updateItemCommand:   updateItemCommand,
+		moveCommand:         moveCommand,
+		mergeCommand:        mergeCommand,
+		splitCommand:        splitCommand,
 		listQuery:           listQuery,
 		getQuery:            getQuery,
 		getPendingQuery:     getPendingQuery,
+		getAuditQuery:       getAuditQuery,
 	}
 }
 
@@ -78,11 +96,20 @@ func (h *OrderHandler) GetOrder(c *gin.Context) {
 	response.OK(c, order, "Order retrieved successfully")
 }
 
-// ListOrders retrieves all orders
-// GET /api/v1/orders
+// ListOrders retrieves all orders, optionally those of a business day or
+// ticket number
+// GET /api/v1/orders?ticket=T-42&business_day=12
 func (h *OrderHandler) ListOrders(c *gin.Context) {
+	var req dto.ListOrdersRequest
+
+	// Bind query parameters
+	if err := request.BindQuery(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
 	// Execute query
-	orders, err := h.listQuery.Execute()
+	orders, err := h.listQuery.Execute(req)
 	if err != nil {
 		log.Printf("Error listing orders: %v", err)
 		response.HandleError(c, err)
@@ -132,3 +159,126 @@ func (h *OrderHandler) UpdateOrderStatus(c *gin.Context) {
 	// Return success response
 	response.OK(c, order, "Order status updated successfully")
 }
+
+// UpdateItemStatus updates the preparation status of one order item
+// PATCH /api/v1/orders/:id/items/:line/status
+func (h *OrderHandler) UpdateItemStatus(c *gin.Context) {
+	orderID := request.GetPathParam(c, "id")
+
+	line, err := strconv.Atoi(request.GetPathParam(c, "line"))
+	if err != nil {
+		response.HandleError(c, fmt.Errorf("%w: item line must be a number", shared.ErrInvalidInput))
+		return
+	}
+
+	var req dto.UpdateItemStatusRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	order, err := h.updateItemCommand.Execute(orderID, line, req)
+	if err != nil {
+		log.Printf("Error updating item status: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, order, "Item status updated successfully")
+}
+
+// MoveOrder moves an open order to another table
+// POST /api/v1/orders/:id/move
+func (h *OrderHandler) MoveOrder(c *gin.Context) {
+	orderID := request.GetPathParam(c, "id")
+
+	var req dto.MoveOrderRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	order, err := h.moveCommand.Execute(orderID, req)
+	if err != nil {
+		log.Printf("Error moving order: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, order, "Order moved successfully")
+}
+
+// MergeOrders merges other open orders into an order
+// POST /api/v1/orders/:id/merge
+func (h *OrderHandler) MergeOrders(c *gin.Context) {
+	orderID := request.GetPathParam(c, "id")
+
+	var req dto.MergeOrdersRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	orders, err := h.mergeCommand.Execute(orderID, req)
+	if err != nil {
+		log.Printf("Error merging orders: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, orders, "Orders merged successfully")
+}
+
+// SplitOrder splits an order by items or evenly into separate bills
+// POST /api/v1/orders/:id/split
+func (h *OrderHandler) SplitOrder(c *gin.Context) {
+	orderID := request.GetPathParam(c, "id")
+
+	var req dto.SplitOrderRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	orders, err := h.splitCommand.Execute(orderID, req)
+	if err != nil {
+		log.Printf("Error splitting order: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, orders, "Order split successfully")
+}
+
+// GetOrderAudit retrieves the moves, merges and splits of an order
+// GET /api/v1/orders/:id/audit
+func (h *OrderHandler) GetOrderAudit(c *gin.Context) {
+	orderID := request.GetPathParam(c, "id")
+
+	// Execute query
+	audit, err := h.getAuditQuery.Execute(orderID)
+	if err != nil {
+		log.Printf("Error getting order audit: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, audit, "Order audit trail retrieved successfully")
+}
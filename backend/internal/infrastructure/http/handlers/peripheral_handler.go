@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"POSFlowBackend/internal/application/peripheral/commands"
+	"POSFlowBackend/internal/application/peripheral/dto"
+	"POSFlowBackend/internal/application/peripheral/queries"
+	"POSFlowBackend/internal/infrastructure/display"
+	"POSFlowBackend/internal/infrastructure/http/request"
+	"POSFlowBackend/internal/infrastructure/http/response"
+	"POSFlowBackend/internal/infrastructure/printer"
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PeripheralHandler handles HTTP requests for the cash drawer and pole display
+type PeripheralHandler struct {
+	openDrawerCommand    *commands.OpenDrawerCommand
+	updateDisplayCommand *commands.UpdateDisplayCommand
+	listOpeningsQuery    *queries.ListDrawerOpeningsQuery
+	drawerSimulator      *printer.DrawerSimulator // nil unless the simulated drawer is configured
+	displaySimulator     *display.Simulator       // nil unless the simulated display is configured
+}
+
+// NewPeripheralHandler creates a new peripheral handler
+func NewPeripheralHandler(
+	openDrawerCommand *commands.OpenDrawerCommand,
+	updateDisplayCommand *commands.UpdateDisplayCommand,
+	listOpeningsQuery *queries.ListDrawerOpeningsQuery,
+	drawerSimulator *printer.DrawerSimulator,
+	displaySimulator *display.Simulator,
+) *PeripheralHandler {
+	return &PeripheralHandler{
+		openDrawerCommand:    openDrawerCommand,
+		updateDisplayCommand: updateDisplayCommand,
+		listOpeningsQuery:    listOpeningsQuery,
+		drawerSimulator:      drawerSimulator,
+		displaySimulator:     displaySimulator,
+	}
+}
+
+// OpenDrawer opens the cash drawer without a sale; every attempt is audited
+// POST /api/v1/drawer/open
+func (h *PeripheralHandler) OpenDrawer(c *gin.Context) {
+	var req dto.OpenDrawerRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	opening, err := h.openDrawerCommand.Execute(req)
+	if err != nil {
+		log.Printf("Error opening cash drawer: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, opening, "Cash drawer opened successfully")
+}
+
+// ListDrawerOpenings retrieves the drawer audit log, today by default
+// GET /api/v1/drawer/openings?start=2026-01-01&end=2026-01-31&reason=no_sale
+func (h *PeripheralHandler) ListDrawerOpenings(c *gin.Context) {
+	// Parse optional date range
+	startDate := time.Now()
+	endDate := startDate
+
+	if startStr := c.Query("start"); startStr != "" {
+		parsed, err := time.Parse("2006-01-02", startStr)
+		if err != nil {
+			log.Printf("Invalid start date: %v", err)
+			response.BadRequest(c, err, "Invalid start date format. Use YYYY-MM-DD")
+			return
+		}
+		startDate = parsed
+		endDate = parsed
+	}
+
+	if endStr := c.Query("end"); endStr != "" {
+		parsed, err := time.Parse("2006-01-02", endStr)
+		if err != nil {
+			log.Printf("Invalid end date: %v", err)
+			response.BadRequest(c, err, "Invalid end date format. Use YYYY-MM-DD")
+			return
+		}
+		endDate = parsed
+	}
+
+	// Execute query
+	openings, err := h.listOpeningsQuery.Execute(startDate, endDate, c.Query("reason"))
+	if err != nil {
+		log.Printf("Error listing drawer openings: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, openings, "Drawer openings retrieved successfully")
+}
+
+// GetDrawerSimulator shows how often the simulated drawer has fired
+// GET /api/v1/drawer/simulator
+func (h *PeripheralHandler) GetDrawerSimulator(c *gin.Context) {
+	if h.drawerSimulator == nil {
+		response.NotFound(c, "Drawer simulator is not enabled")
+		return
+	}
+
+	kicks, lastKickAt := h.drawerSimulator.Kicks()
+	state := &dto.DrawerSimulatorResponse{Kicks: kicks}
+	if !lastKickAt.IsZero() {
+		state.LastKickAt = &lastKickAt
+	}
+
+	// Return success response
+	response.OK(c, state, "Drawer simulator retrieved successfully")
+}
+
+// ShowDisplay puts text on the customer pole display
+// PUT /api/v1/display
+func (h *PeripheralHandler) ShowDisplay(c *gin.Context) {
+	var req dto.ShowDisplayRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	if err := h.updateDisplayCommand.Show(req); err != nil {
+		log.Printf("Error updating pole display: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, req, "Display updated successfully")
+}
+
+// ClearDisplay blanks the customer pole display
+// DELETE /api/v1/display
+func (h *PeripheralHandler) ClearDisplay(c *gin.Context) {
+	// Execute command
+	if err := h.updateDisplayCommand.Clear(); err != nil {
+		log.Printf("Error clearing pole display: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, nil, "Display cleared successfully")
+}
+
+// GetDisplaySimulator shows what the simulated display currently reads
+// GET /api/v1/display/simulator
+func (h *PeripheralHandler) GetDisplaySimulator(c *gin.Context) {
+	if h.displaySimulator == nil {
+		response.NotFound(c, "Display simulator is not enabled")
+		return
+	}
+
+	top, bottom, updatedAt := h.displaySimulator.Lines()
+	state := &dto.DisplaySimulatorResponse{Top: top, Bottom: bottom}
+	if !updatedAt.IsZero() {
+		state.UpdatedAt = &updatedAt
+	}
+
+	// Return success response
+	response.OK(c, state, "Display simulator retrieved successfully")
+}
@@ -20,6 +20,10 @@ type ProductHandler struct {
 	listQuery          *queries.ListProductsQuery
 	getQuery           *queries.GetProductQuery
 	getLowStockQuery   *queries.GetLowStockQuery
+	restoreCommand     *commands.RestoreProductCommand
+	purgeCommand       *commands.PurgeProductCommand
+	listArchivedQuery  *queries.ListArchivedProductsQuery
+	costHistoryQuery   *queries.GetCostHistoryQuery
 }
 
 // NewProductHandler creates a new product handler
@@ -31,6 +35,10 @@ func NewProductHandler(
 	listQuery *queries.ListProductsQuery,
 	getQuery *queries.GetProductQuery,
 	getLowStockQuery *queries.GetLowStockQuery,
+	restoreCommand *commands.RestoreProductCommand,
+	purgeCommand *commands.PurgeProductCommand,
+	listArchivedQuery *queries.ListArchivedProductsQuery,
+	costHistoryQuery *queries.GetCostHistoryQuery,
 ) *ProductHandler {
 	return &ProductHandler{
 		createCommand:      createCommand,
@@ -40,6 +48,10 @@ func NewProductHandler(
 		listQuery:          listQuery,
 		getQuery:           getQuery,
 		getLowStockQuery:   getLowStockQuery,
+		restoreCommand:     restoreCommand,
+		purgeCommand:       purgeCommand,
+		listArchivedQuery:  listArchivedQuery,
+		costHistoryQuery:   costHistoryQuery,
 	}
 }
 
@@ -83,11 +95,19 @@ func (h *ProductHandler) GetProduct(c *gin.Context) {
 	response.OK(c, product, "Product retrieved successfully")
 }
 
-// ListProducts retrieves all products
-// GET /api/v1/products
+// ListProducts searches products by text, category and tags, with optional pagination
+// GET /api/v1/products?q=burg&category=Food&tags=vegan&allergen_free=gluten,nuts&page=1&page_size=20
 func (h *ProductHandler) ListProducts(c *gin.Context) {
+	var req dto.ListProductsRequest
+
+	// Bind query parameters
+	if err := request.BindQuery(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
 	// Execute query
-	products, err := h.listQuery.Execute()
+	products, err := h.listQuery.Execute(req)
 	if err != nil {
 		log.Printf("Error listing products: %v", err)
 		response.HandleError(c, err)
@@ -123,7 +143,7 @@ func (h *ProductHandler) UpdateProduct(c *gin.Context) {
 	response.OK(c, product, "Product updated successfully")
 }
 
-// DeleteProduct deletes a product (soft delete)
+// DeleteProduct archives a product (soft delete)
 // DELETE /api/v1/products/:id
 func (h *ProductHandler) DeleteProduct(c *gin.Context) {
 	productID := request.GetPathParam(c, "id")
@@ -136,7 +156,55 @@ func (h *ProductHandler) DeleteProduct(c *gin.Context) {
 	}
 
 	// Return success response
-	response.OK(c, nil, "Product deleted successfully")
+	response.OK(c, nil, "Product archived successfully")
+}
+
+// ListArchivedProducts retrieves archived products
+// GET /api/v1/products/archived
+func (h *ProductHandler) ListArchivedProducts(c *gin.Context) {
+	// Execute query
+	products, err := h.listArchivedQuery.Execute()
+	if err != nil {
+		log.Printf("Error listing archived products: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, products, "Archived products retrieved successfully")
+}
+
+// RestoreProduct brings an archived product back into the catalog
+// POST /api/v1/products/:id/restore
+func (h *ProductHandler) RestoreProduct(c *gin.Context) {
+	productID := request.GetPathParam(c, "id")
+
+	// Execute command
+	product, err := h.restoreCommand.Execute(productID)
+	if err != nil {
+		log.Printf("Error restoring product: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, product, "Product restored successfully")
+}
+
+// PurgeProduct permanently deletes an archived product that was never sold
+// DELETE /api/v1/products/:id/purge
+func (h *ProductHandler) PurgeProduct(c *gin.Context) {
+	productID := request.GetPathParam(c, "id")
+
+	// Execute command
+	if err := h.purgeCommand.Execute(productID); err != nil {
+		log.Printf("Error purging product: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, nil, "Product purged successfully")
 }
 
 // UpdateStock updates product stock
@@ -178,3 +246,20 @@ func (h *ProductHandler) GetLowStockProducts(c *gin.Context) {
 	// Return success response
 	response.OK(c, products, "Low stock products retrieved successfully")
 }
+
+// GetCostHistory retrieves the cost price history of a product
+// GET /api/v1/products/:id/cost-history
+func (h *ProductHandler) GetCostHistory(c *gin.Context) {
+	productID := request.GetPathParam(c, "id")
+
+	// Execute query
+	history, err := h.costHistoryQuery.Execute(productID)
+	if err != nil {
+		log.Printf("Error getting cost history: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, history, "Cost history retrieved successfully")
+}
@@ -0,0 +1,289 @@
+package handlers
+
+import (
+	"POSFlowBackend/internal/application/purchasing/commands"
+	"POSFlowBackend/internal/application/purchasing/dto"
+	"POSFlowBackend/internal/application/purchasing/queries"
+	"POSFlowBackend/internal/infrastructure/http/request"
+	"POSFlowBackend/internal/infrastructure/http/response"
+	"log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PurchasingHandler handles HTTP requests for suppliers and purchase orders
+type PurchasingHandler struct {
+	createSupplierCommand      *commands.CreateSupplierCommand
+	updateSupplierCommand      *commands.UpdateSupplierCommand
+	createPurchaseOrderCommand *commands.CreatePurchaseOrderCommand
+	updatePurchaseOrderCommand *commands.UpdatePurchaseOrderCommand
+	updateStatusCommand        *commands.UpdatePurchaseOrderStatusCommand
+	receiveCommand             *commands.ReceivePurchaseOrderCommand
+	listSuppliersQuery         *queries.ListSuppliersQuery
+	getSupplierQuery           *queries.GetSupplierQuery
+	listPurchaseOrdersQuery    *queries.ListPurchaseOrdersQuery
+	getPurchaseOrderQuery      *queries.GetPurchaseOrderQuery
+	getOpenPurchaseOrdersQuery *queries.GetOpenPurchaseOrdersQuery
+}
+
+// NewPurchasingHandler creates a new purchasing handler
+func NewPurchasingHandler(
+	createSupplierCommand *commands.CreateSupplierCommand,
+	updateSupplierCommand *commands.UpdateSupplierCommand,
+	createPurchaseOrderCommand *commands.CreatePurchaseOrderCommand,
+	updatePurchaseOrderCommand *commands.UpdatePurchaseOrderCommand,
+	updateStatusCommand *commands.UpdatePurchaseOrderStatusCommand,
+	receiveCommand *commands.ReceivePurchaseOrderCommand,
+	listSuppliersQuery *queries.ListSuppliersQuery,
+	getSupplierQuery *queries.GetSupplierQuery,
+	listPurchaseOrdersQuery *queries.ListPurchaseOrdersQuery,
+	getPurchaseOrderQuery *queries.GetPurchaseOrderQuery,
+	getOpenPurchaseOrdersQuery *queries.GetOpenPurchaseOrdersQuery,
+) *PurchasingHandler {
+	return &PurchasingHandler{
+		createSupplierCommand:      createSupplierCommand,
+		updateSupplierCommand:      updateSupplierCommand,
+		createPurchaseOrderCommand: createPurchaseOrderCommand,
+		updatePurchaseOrderCommand: updatePurchaseOrderCommand,
+		updateStatusCommand:        updateStatusCommand,
+		receiveCommand:             receiveCommand,
+		listSuppliersQuery:         listSuppliersQuery,
+		getSupplierQuery:           getSupplierQuery,
+		listPurchaseOrdersQuery:    listPurchaseOrdersQuery,
+		getPurchaseOrderQuery:      getPurchaseOrderQuery,
+		getOpenPurchaseOrdersQuery: getOpenPurchaseOrdersQuery,
+	}
+}
+
+// CreateSupplier creates a new supplier
+// POST /api/v1/suppliers
+func (h *PurchasingHandler) CreateSupplier(c *gin.Context) {
+	var req dto.CreateSupplierRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	supplier, err := h.createSupplierCommand.Execute(req)
+	if err != nil {
+		log.Printf("Error creating supplier: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, supplier, "Supplier created successfully")
+}
+
+// ListSuppliers retrieves all suppliers
+// GET /api/v1/suppliers
+func (h *PurchasingHandler) ListSuppliers(c *gin.Context) {
+	// Execute query
+	suppliers, err := h.listSuppliersQuery.Execute()
+	if err != nil {
+		log.Printf("Error listing suppliers: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, suppliers, "Suppliers retrieved successfully")
+}
+
+// GetSupplier retrieves a supplier by ID
+// GET /api/v1/suppliers/:id
+func (h *PurchasingHandler) GetSupplier(c *gin.Context) {
+	supplierID := request.GetPathParam(c, "id")
+
+	// Execute query
+	supplier, err := h.getSupplierQuery.Execute(supplierID)
+	if err != nil {
+		log.Printf("Error getting supplier: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, supplier, "Supplier retrieved successfully")
+}
+
+// UpdateSupplier updates an existing supplier
+// PUT /api/v1/suppliers/:id
+func (h *PurchasingHandler) UpdateSupplier(c *gin.Context) {
+	supplierID := request.GetPathParam(c, "id")
+
+	var req dto.UpdateSupplierRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	supplier, err := h.updateSupplierCommand.Execute(supplierID, req)
+	if err != nil {
+		log.Printf("Error updating supplier: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, supplier, "Supplier updated successfully")
+}
+
+// CreatePurchaseOrder creates a draft purchase order
+// POST /api/v1/purchase-orders
+func (h *PurchasingHandler) CreatePurchaseOrder(c *gin.Context) {
+	var req dto.CreatePurchaseOrderRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	po, err := h.createPurchaseOrderCommand.Execute(req)
+	if err != nil {
+		log.Printf("Error creating purchase order: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, po, "Purchase order created successfully")
+}
+
+// ListPurchaseOrders retrieves purchase orders, optionally filtered by supplier or status
+// GET /api/v1/purchase-orders?supplier_id=...&status=sent
+func (h *PurchasingHandler) ListPurchaseOrders(c *gin.Context) {
+	var req dto.ListPurchaseOrdersRequest
+
+	// Bind query parameters
+	if err := request.BindQuery(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute query
+	orders, err := h.listPurchaseOrdersQuery.Execute(req)
+	if err != nil {
+		log.Printf("Error listing purchase orders: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, orders, "Purchase orders retrieved successfully")
+}
+
+// GetOpenPurchaseOrders reports open purchase orders grouped per supplier
+// GET /api/v1/purchase-orders/open
+func (h *PurchasingHandler) GetOpenPurchaseOrders(c *gin.Context) {
+	// Execute query
+	report, err := h.getOpenPurchaseOrdersQuery.Execute()
+	if err != nil {
+		log.Printf("Error getting open purchase orders: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, report, "Open purchase orders retrieved successfully")
+}
+
+// GetPurchaseOrder retrieves a purchase order by ID
+// GET /api/v1/purchase-orders/:id
+func (h *PurchasingHandler) GetPurchaseOrder(c *gin.Context) {
+	purchaseOrderID := request.GetPathParam(c, "id")
+
+	// Execute query
+	po, err := h.getPurchaseOrderQuery.Execute(purchaseOrderID)
+	if err != nil {
+		log.Printf("Error getting purchase order: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, po, "Purchase order retrieved successfully")
+}
+
+// UpdatePurchaseOrder replaces the contents of a draft purchase order
+// PUT /api/v1/purchase-orders/:id
+func (h *PurchasingHandler) UpdatePurchaseOrder(c *gin.Context) {
+	purchaseOrderID := request.GetPathParam(c, "id")
+
+	var req dto.UpdatePurchaseOrderRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	po, err := h.updatePurchaseOrderCommand.Execute(purchaseOrderID, req)
+	if err != nil {
+		log.Printf("Error updating purchase order: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, po, "Purchase order updated successfully")
+}
+
+// UpdatePurchaseOrderStatus sends or cancels a purchase order
+// PATCH /api/v1/purchase-orders/:id/status
+func (h *PurchasingHandler) UpdatePurchaseOrderStatus(c *gin.Context) {
+	purchaseOrderID := request.GetPathParam(c, "id")
+
+	var req dto.UpdatePurchaseOrderStatusRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	po, err := h.updateStatusCommand.Execute(purchaseOrderID, req)
+	if err != nil {
+		log.Printf("Error updating purchase order status: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, po, "Purchase order status updated successfully")
+}
+
+// ReceivePurchaseOrder books delivered goods into stock
+// POST /api/v1/purchase-orders/:id/receive
+func (h *PurchasingHandler) ReceivePurchaseOrder(c *gin.Context) {
+	purchaseOrderID := request.GetPathParam(c, "id")
+
+	var req dto.ReceivePurchaseOrderRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	po, err := h.receiveCommand.Execute(purchaseOrderID, req)
+	if err != nil {
+		log.Printf("Error receiving purchase order: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, po, "Goods received successfully")
+}
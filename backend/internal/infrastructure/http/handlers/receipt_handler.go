@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"POSFlowBackend/internal/application/printing/commands"
+	"POSFlowBackend/internal/application/printing/dto"
+	"POSFlowBackend/internal/application/printing/queries"
+	"POSFlowBackend/internal/infrastructure/http/request"
+	"POSFlowBackend/internal/infrastructure/http/response"
+	"POSFlowBackend/internal/infrastructure/printer"
+	"log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReceiptHandler handles HTTP requests for customer receipts
+type ReceiptHandler struct {
+	previewReceiptQuery *queries.PreviewReceiptQuery
+	printReceiptCommand *commands.PrintReceiptCommand
+	memoryPrinter       *printer.MemoryPrinter // shared by every printer using the memory driver
+}
+
+// NewReceiptHandler creates a new receipt handler
+func NewReceiptHandler(
+	previewReceiptQuery *queries.PreviewReceiptQuery,
+	printReceiptCommand *commands.PrintReceiptCommand,
+	memoryPrinter *printer.MemoryPrinter,
+) *ReceiptHandler {
+	return &ReceiptHandler{
+		previewReceiptQuery: previewReceiptQuery,
+		printReceiptCommand: printReceiptCommand,
+		memoryPrinter:       memoryPrinter,
+	}
+}
+
+// PreviewReceipt renders an order's receipt as plain text
+// GET /api/v1/orders/:id/receipt?paper=58mm
+func (h *ReceiptHandler) PreviewReceipt(c *gin.Context) {
+	orderID := request.GetPathParam(c, "id")
+
+	var req dto.ReceiptRequest
+
+	// Bind and validate query parameters
+	if err := request.BindQuery(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute query
+	receipt, err := h.previewReceiptQuery.Execute(orderID, req)
+	if err != nil {
+		log.Printf("Error previewing receipt: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, receipt, "Receipt rendered successfully")
+}
+
+// PrintReceipt prints an order's receipt on the receipt printer
+// POST /api/v1/orders/:id/receipt/print
+func (h *ReceiptHandler) PrintReceipt(c *gin.Context) {
+	orderID := request.GetPathParam(c, "id")
+
+	// Execute command
+	receipt, err := h.printReceiptCommand.Execute(orderID)
+	if err != nil {
+		log.Printf("Error printing receipt: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, receipt, "Receipt printed successfully")
+}
+
+// ListMemoryJobs shows what the memory printer has printed
+// GET /api/v1/printer/memory/jobs
+func (h *ReceiptHandler) ListMemoryJobs(c *gin.Context) {
+	jobs := h.memoryPrinter.Jobs()
+	responses := make([]*dto.MemoryJobResponse, 0, len(jobs))
+	for i, job := range jobs {
+		responses = append(responses, &dto.MemoryJobResponse{
+			Index: i,
+			Bytes: len(job),
+			Text:  printer.PlainText(job),
+		})
+	}
+
+	// Return success response
+	response.OK(c, &dto.MemoryJobListResponse{Jobs: responses, Total: len(responses)}, "Printer jobs retrieved successfully")
+}
+
+// ClearMemoryJobs discards what the memory printer has printed
+// DELETE /api/v1/printer/memory/jobs
+func (h *ReceiptHandler) ClearMemoryJobs(c *gin.Context) {
+	h.memoryPrinter.Clear()
+
+	// Return success response
+	response.OK(c, nil, "Printer jobs cleared successfully")
+}
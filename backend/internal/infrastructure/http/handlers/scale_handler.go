@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"POSFlowBackend/internal/application/weighing/dto"
+	"POSFlowBackend/internal/application/weighing/queries"
+	"POSFlowBackend/internal/infrastructure/http/request"
+	"POSFlowBackend/internal/infrastructure/http/response"
+	"POSFlowBackend/internal/infrastructure/scale"
+	"log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ScaleHandler handles HTTP requests for the weighing scale
+type ScaleHandler struct {
+	getWeightQuery *queries.GetWeightQuery
+	simulator      *scale.Simulator // nil unless the simulated scale is configured
+}
+
+// NewScaleHandler creates a new scale handler
+func NewScaleHandler(
+	getWeightQuery *queries.GetWeightQuery,
+	simulator *scale.Simulator,
+) *ScaleHandler {
+	return &ScaleHandler{
+		getWeightQuery: getWeightQuery,
+		simulator:      simulator,
+	}
+}
+
+// GetWeight waits for a stable weight on the scale, priced when a product is given
+// GET /api/v1/scale/weight?product_id=...
+func (h *ScaleHandler) GetWeight(c *gin.Context) {
+	var req dto.GetWeightRequest
+
+	// Bind query parameters
+	if err := request.BindQuery(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute query
+	weight, err := h.getWeightQuery.Execute(req)
+	if err != nil {
+		log.Printf("Error reading scale: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, weight, "Weight read successfully")
+}
+
+// SimulateWeight places a load on the simulated scale
+// PUT /api/v1/scale/simulator
+func (h *ScaleHandler) SimulateWeight(c *gin.Context) {
+	if h.simulator == nil {
+		response.NotFound(c, "Scale simulator is not enabled")
+		return
+	}
+
+	var req dto.SimulateWeightRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	stable := true
+	if req.Stable != nil {
+		stable = *req.Stable
+	}
+	h.simulator.SetWeight(*req.Weight, stable)
+	req.Stable = &stable
+
+	// Return success response
+	response.OK(c, req, "Simulated weight set successfully")
+}
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"POSFlowBackend/internal/application/kitchen/commands"
+	"POSFlowBackend/internal/application/kitchen/dto"
+	"POSFlowBackend/internal/application/kitchen/queries"
+	"POSFlowBackend/internal/infrastructure/http/request"
+	"POSFlowBackend/internal/infrastructure/http/response"
+	"log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StationHandler handles HTTP requests for kitchen stations and their queues
+type StationHandler struct {
+	createStationCommand *commands.CreateStationCommand
+	updateStationCommand *commands.UpdateStationCommand
+	bumpStationCommand   *commands.BumpStationCommand
+	listStationsQuery    *queries.ListStationsQuery
+	getStationQuery      *queries.GetStationQuery
+	getQueueQuery        *queries.GetStationQueueQuery
+}
+
+// NewStationHandler creates a new station handler
+func NewStationHandler(
+	createStationCommand *commands.CreateStationCommand,
+	updateStationCommand *commands.UpdateStationCommand,
+	bumpStationCommand *commands.BumpStationCommand,
+	listStationsQuery *queries.ListStationsQuery,
+	getStationQuery *queries.GetStationQuery,
+	getQueueQuery *queries.GetStationQueueQuery,
+) *StationHandler {
+	return &StationHandler{
+		createStationCommand: createStationCommand,
+		updateStationCommand: updateStationCommand,
+		bumpStationCommand:   bumpStationCommand,
+		listStationsQuery:    listStationsQuery,
+		getStationQuery:      getStationQuery,
+		getQueueQuery:        getQueueQuery,
+	}
+}
+
+// CreateStation creates a new kitchen station
+// POST /api/v1/stations
+func (h *StationHandler) CreateStation(c *gin.Context) {
+	var req dto.CreateStationRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	station, err := h.createStationCommand.Execute(req)
+	if err != nil {
+		log.Printf("Error creating station: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, station, "Station created successfully")
+}
+
+// ListStations retrieves all kitchen stations
+// GET /api/v1/stations
+func (h *StationHandler) ListStations(c *gin.Context) {
+	// Execute query
+	stations, err := h.listStationsQuery.Execute()
+	if err != nil {
+		log.Printf("Error listing stations: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, stations, "Stations retrieved successfully")
+}
+
+// GetStation retrieves a kitchen station by ID
+// GET /api/v1/stations/:id
+func (h *StationHandler) GetStation(c *gin.Context) {
+	stationID := request.GetPathParam(c, "id")
+
+	// Execute query
+	station, err := h.getStationQuery.Execute(stationID)
+	if err != nil {
+		log.Printf("Error getting station: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, station, "Station retrieved successfully")
+}
+
+// UpdateStation updates a station's name and routing
+// PUT /api/v1/stations/:id
+func (h *StationHandler) UpdateStation(c *gin.Context) {
+	stationID := request.GetPathParam(c, "id")
+
+	var req dto.UpdateStationRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	station, err := h.updateStationCommand.Execute(stationID, req)
+	if err != nil {
+		log.Printf("Error updating station: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, station, "Station updated successfully")
+}
+
+// GetQueue retrieves the orders a station still has to prepare, with only its items
+// GET /api/v1/stations/:id/queue
+func (h *StationHandler) GetQueue(c *gin.Context) {
+	stationID := request.GetPathParam(c, "id")
+
+	// Execute query
+	queue, err := h.getQueueQuery.Execute(stationID)
+	if err != nil {
+		log.Printf("Error getting station queue: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, queue, "Station queue retrieved successfully")
+}
+
+// BumpOrder marks the station's part of an order as done
+// POST /api/v1/stations/:id/queue/:order_id/bump
+func (h *StationHandler) BumpOrder(c *gin.Context) {
+	stationID := request.GetPathParam(c, "id")
+	orderID := request.GetPathParam(c, "order_id")
+
+	// Execute command
+	bump, err := h.bumpStationCommand.Execute(stationID, orderID)
+	if err != nil {
+		log.Printf("Error bumping order: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, bump, "Order bumped successfully")
+}
@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"POSFlowBackend/internal/application/stocktake/commands"
+	"POSFlowBackend/internal/application/stocktake/dto"
+	"POSFlowBackend/internal/application/stocktake/queries"
+	"POSFlowBackend/internal/infrastructure/http/request"
+	"POSFlowBackend/internal/infrastructure/http/response"
+	"log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StocktakeHandler handles HTTP requests for stocktakes
+type StocktakeHandler struct {
+	openCommand         *commands.OpenStocktakeCommand
+	submitCountsCommand *commands.SubmitCountsCommand
+	finalizeCommand     *commands.FinalizeStocktakeCommand
+	cancelCommand       *commands.CancelStocktakeCommand
+	listQuery           *queries.ListStocktakesQuery
+	getQuery            *queries.GetStocktakeQuery
+	varianceQuery       *queries.GetVarianceReportQuery
+}
+
+// NewStocktakeHandler creates a new stocktake handler
+func NewStocktakeHandler(
+	openCommand *commands.OpenStocktakeCommand,
+	submitCountsCommand *commands.SubmitCountsCommand,
+	finalizeCommand *commands.FinalizeStocktakeCommand,
+	cancelCommand *commands.CancelStocktakeCommand,
+	listQuery *queries.ListStocktakesQuery,
+	getQuery *queries.GetStocktakeQuery,
+	varianceQuery *queries.GetVarianceReportQuery,
+) *StocktakeHandler {
+	return &StocktakeHandler{
+		openCommand:         openCommand,
+		submitCountsCommand: submitCountsCommand,
+		finalizeCommand:     finalizeCommand,
+		cancelCommand:       cancelCommand,
+		listQuery:           listQuery,
+		getQuery:            getQuery,
+		varianceQuery:       varianceQuery,
+	}
+}
+
+// OpenStocktake opens a new stocktake
+// POST /api/v1/stocktakes
+func (h *StocktakeHandler) OpenStocktake(c *gin.Context) {
+	var req dto.OpenStocktakeRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	st, err := h.openCommand.Execute(req)
+	if err != nil {
+		log.Printf("Error opening stocktake: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, st, "Stocktake opened successfully")
+}
+
+// ListStocktakes retrieves all stocktakes
+// GET /api/v1/stocktakes
+func (h *StocktakeHandler) ListStocktakes(c *gin.Context) {
+	// Execute query
+	stocktakes, err := h.listQuery.Execute()
+	if err != nil {
+		log.Printf("Error listing stocktakes: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, stocktakes, "Stocktakes retrieved successfully")
+}
+
+// GetStocktake retrieves a stocktake with its counts
+// GET /api/v1/stocktakes/:id
+func (h *StocktakeHandler) GetStocktake(c *gin.Context) {
+	stocktakeID := request.GetPathParam(c, "id")
+
+	// Execute query
+	st, err := h.getQuery.Execute(stocktakeID)
+	if err != nil {
+		log.Printf("Error getting stocktake: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, st, "Stocktake retrieved successfully")
+}
+
+// SubmitCounts records quantities counted by a terminal
+// POST /api/v1/stocktakes/:id/counts
+func (h *StocktakeHandler) SubmitCounts(c *gin.Context) {
+	stocktakeID := request.GetPathParam(c, "id")
+
+	var req dto.SubmitCountsRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	st, err := h.submitCountsCommand.Execute(stocktakeID, req)
+	if err != nil {
+		log.Printf("Error submitting stocktake counts: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, st, "Counts submitted successfully")
+}
+
+// GetVarianceReport compares expected and counted stock by value
+// GET /api/v1/stocktakes/:id/variance
+func (h *StocktakeHandler) GetVarianceReport(c *gin.Context) {
+	stocktakeID := request.GetPathParam(c, "id")
+
+	// Execute query
+	report, err := h.varianceQuery.Execute(stocktakeID)
+	if err != nil {
+		log.Printf("Error getting variance report: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, report, "Variance report retrieved successfully")
+}
+
+// FinalizeStocktake applies the stocktake adjustments to stock
+// POST /api/v1/stocktakes/:id/finalize
+func (h *StocktakeHandler) FinalizeStocktake(c *gin.Context) {
+	stocktakeID := request.GetPathParam(c, "id")
+
+	// Execute command
+	report, err := h.finalizeCommand.Execute(stocktakeID)
+	if err != nil {
+		log.Printf("Error finalizing stocktake: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, report, "Stocktake finalized successfully")
+}
+
+// CancelStocktake discards an open stocktake
+// POST /api/v1/stocktakes/:id/cancel
+func (h *StocktakeHandler) CancelStocktake(c *gin.Context) {
+	stocktakeID := request.GetPathParam(c, "id")
+
+	// Execute command
+	st, err := h.cancelCommand.Execute(stocktakeID)
+	if err != nil {
+		log.Printf("Error cancelling stocktake: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, st, "Stocktake cancelled successfully")
+}
@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"POSFlowBackend/internal/application/table/commands"
+	"POSFlowBackend/internal/application/table/dto"
+	"POSFlowBackend/internal/application/table/queries"
+	"POSFlowBackend/internal/infrastructure/http/request"
+	"POSFlowBackend/internal/infrastructure/http/response"
+	"log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TableHandler handles HTTP requests for the tables on the floor plan
+type TableHandler struct {
+	createTableCommand *commands.CreateTableCommand
+	updateTableCommand *commands.UpdateTableCommand
+	deleteTableCommand *commands.DeleteTableCommand
+	requestBillCommand *commands.RequestBillCommand
+	listTablesQuery    *queries.ListTablesQuery
+	getTableQuery      *queries.GetTableQuery
+}
+
+// NewTableHandler creates a new table handler
+func NewTableHandler(
+	createTableCommand *commands.CreateTableCommand,
+	updateTableCommand *commands.UpdateTableCommand,
+	deleteTableCommand *commands.DeleteTableCommand,
+	requestBillCommand *commands.RequestBillCommand,
+	listTablesQuery *queries.ListTablesQuery,
+	getTableQuery *queries.GetTableQuery,
+) *TableHandler {
+	return &TableHandler{
+		createTableCommand: createTableCommand,
+		updateTableCommand: updateTableCommand,
+		deleteTableCommand: deleteTableCommand,
+		requestBillCommand: requestBillCommand,
+		listTablesQuery:    listTablesQuery,
+		getTableQuery:      getTableQuery,
+	}
+}
+
+// CreateTable adds a table to the floor plan
+// POST /api/v1/tables
+func (h *TableHandler) CreateTable(c *gin.Context) {
+	var req dto.CreateTableRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	table, err := h.createTableCommand.Execute(req)
+	if err != nil {
+		log.Printf("Error creating table: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, table, "Table created successfully")
+}
+
+// ListTables retrieves the floor plan with each table's status, open orders and running total
+// GET /api/v1/tables?area=Terrace&status=occupied
+func (h *TableHandler) ListTables(c *gin.Context) {
+	var req dto.ListTablesRequest
+
+	// Bind query parameters
+	if err := request.BindQuery(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute query
+	tables, err := h.listTablesQuery.Execute(req)
+	if err != nil {
+		log.Printf("Error listing tables: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, tables, "Tables retrieved successfully")
+}
+
+// GetTable retrieves a table with its status and open orders
+// GET /api/v1/tables/:id
+func (h *TableHandler) GetTable(c *gin.Context) {
+	tableID := request.GetPathParam(c, "id")
+
+	// Execute query
+	table, err := h.getTableQuery.Execute(tableID)
+	if err != nil {
+		log.Printf("Error getting table: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, table, "Table retrieved successfully")
+}
+
+// UpdateTable moves a table or changes its area and seats
+// PUT /api/v1/tables/:id
+func (h *TableHandler) UpdateTable(c *gin.Context) {
+	tableID := request.GetPathParam(c, "id")
+
+	var req dto.UpdateTableRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	table, err := h.updateTableCommand.Execute(tableID, req)
+	if err != nil {
+		log.Printf("Error updating table: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, table, "Table updated successfully")
+}
+
+// DeleteTable removes a table without open orders from the floor plan
+// DELETE /api/v1/tables/:id
+func (h *TableHandler) DeleteTable(c *gin.Context) {
+	tableID := request.GetPathParam(c, "id")
+
+	// Execute command
+	if err := h.deleteTableCommand.Execute(tableID); err != nil {
+		log.Printf("Error deleting table: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, nil, "Table deleted successfully")
+}
+
+// RequestBill marks that the guests at a table asked for the bill
+// POST /api/v1/tables/:id/bill-request
+func (h *TableHandler) RequestBill(c *gin.Context) {
+	tableID := request.GetPathParam(c, "id")
+
+	// Execute command
+	table, err := h.requestBillCommand.Execute(tableID, true)
+	if err != nil {
+		log.Printf("Error requesting bill: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, table, "Bill requested successfully")
+}
+
+// CancelBillRequest withdraws a table's request for the bill
+// DELETE /api/v1/tables/:id/bill-request
+func (h *TableHandler) CancelBillRequest(c *gin.Context) {
+	tableID := request.GetPathParam(c, "id")
+
+	// Execute command
+	table, err := h.requestBillCommand.Execute(tableID, false)
+	if err != nil {
+		log.Printf("Error cancelling bill request: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, table, "Bill request cancelled successfully")
+}
@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"POSFlowBackend/internal/application/waste/commands"
+	"POSFlowBackend/internal/application/waste/dto"
+	"POSFlowBackend/internal/application/waste/queries"
+	"POSFlowBackend/internal/infrastructure/http/request"
+	"POSFlowBackend/internal/infrastructure/http/response"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WasteHandler handles HTTP requests for the waste log
+type WasteHandler struct {
+	recordCommand          *commands.RecordWasteCommand
+	writeOffLotCommand     *commands.WriteOffLotCommand
+	writeOffExpiredCommand *commands.WriteOffExpiredLotsCommand
+	listQuery              *queries.ListWasteQuery
+	reportQuery            *queries.GetWasteReportQuery
+}
+
+// NewWasteHandler creates a new waste handler
+func NewWasteHandler(
+	recordCommand *commands.RecordWasteCommand,
+	writeOffLotCommand *commands.WriteOffLotCommand,
+	writeOffExpiredCommand *commands.WriteOffExpiredLotsCommand,
+	listQuery *queries.ListWasteQuery,
+	reportQuery *queries.GetWasteReportQuery,
+) *WasteHandler {
+	return &WasteHandler{
+		recordCommand:          recordCommand,
+		writeOffLotCommand:     writeOffLotCommand,
+		writeOffExpiredCommand: writeOffExpiredCommand,
+		listQuery:              listQuery,
+		reportQuery:            reportQuery,
+	}
+}
+
+// RecordWaste logs wasted stock and removes it from inventory
+// POST /api/v1/waste
+func (h *WasteHandler) RecordWaste(c *gin.Context) {
+	var req dto.RecordWasteRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	entry, err := h.recordCommand.Execute(req)
+	if err != nil {
+		log.Printf("Error recording waste: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, entry, "Waste recorded successfully")
+}
+
+// WriteOffLot writes off the remaining stock of a lot and logs it as waste
+// POST /api/v1/waste/lots
+func (h *WasteHandler) WriteOffLot(c *gin.Context) {
+	var req dto.WriteOffLotRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	entry, err := h.writeOffLotCommand.Execute(req)
+	if err != nil {
+		log.Printf("Error writing off lot: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, entry, "Lot written off successfully")
+}
+
+// WriteOffExpiredLots writes off every expired lot, logging a waste entry per lot
+// POST /api/v1/waste/lots/expired
+func (h *WasteHandler) WriteOffExpiredLots(c *gin.Context) {
+	var req dto.WriteOffExpiredLotsRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	entries, err := h.writeOffExpiredCommand.Execute(req)
+	if err != nil {
+		log.Printf("Error writing off expired lots: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, entries, "Expired lots written off successfully")
+}
+
+// ListWaste retrieves waste entries, today by default
+// GET /api/v1/waste?start=2026-01-01&end=2026-01-31
+func (h *WasteHandler) ListWaste(c *gin.Context) {
+	// Parse optional date range
+	startDate := time.Now()
+	endDate := startDate
+
+	if startStr := c.Query("start"); startStr != "" {
+		parsed, err := time.Parse("2006-01-02", startStr)
+		if err != nil {
+			log.Printf("Invalid start date: %v", err)
+			response.BadRequest(c, err, "Invalid start date format. Use YYYY-MM-DD")
+			return
+		}
+		startDate = parsed
+		endDate = parsed
+	}
+
+	if endStr := c.Query("end"); endStr != "" {
+		parsed, err := time.Parse("2006-01-02", endStr)
+		if err != nil {
+			log.Printf("Invalid end date: %v", err)
+			response.BadRequest(c, err, "Invalid end date format. Use YYYY-MM-DD")
+			return
+		}
+		endDate = parsed
+	}
+
+	// Execute query
+	entries, err := h.listQuery.Execute(startDate, endDate)
+	if err != nil {
+		log.Printf("Error listing waste: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, entries, "Waste entries retrieved successfully")
+}
+
+// GetWasteReport compares waste against sales for a date range
+// GET /api/v1/waste/report?start=2026-01-01&end=2026-01-31
+func (h *WasteHandler) GetWasteReport(c *gin.Context) {
+	// Parse required date parameters
+	startStr := c.Query("start")
+	endStr := c.Query("end")
+
+	if startStr == "" || endStr == "" {
+		response.BadRequest(c, errors.New("missing required parameters"), "start and end dates are required")
+		return
+	}
+
+	startDate, err := time.Parse("2006-01-02", startStr)
+	if err != nil {
+		log.Printf("Invalid start date: %v", err)
+		response.BadRequest(c, err, "Invalid start date format. Use YYYY-MM-DD")
+		return
+	}
+
+	endDate, err := time.Parse("2006-01-02", endStr)
+	if err != nil {
+		log.Printf("Invalid end date: %v", err)
+		response.BadRequest(c, err, "Invalid end date format. Use YYYY-MM-DD")
+		return
+	}
+
+	// Execute query
+	report, err := h.reportQuery.Execute(startDate, endDate)
+	if err != nil {
+		log.Printf("Error getting waste report: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, report, "Waste report retrieved successfully")
+}
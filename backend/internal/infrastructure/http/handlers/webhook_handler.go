@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"POSFlowBackend/internal/application/webhook/commands"
+	"POSFlowBackend/internal/application/webhook/dto"
+	"POSFlowBackend/internal/application/webhook/queries"
+	"POSFlowBackend/internal/infrastructure/http/request"
+	"POSFlowBackend/internal/infrastructure/http/response"
+	"log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookHandler handles HTTP requests for webhook subscriptions and their delivery log
+type WebhookHandler struct {
+	createSubscriptionCommand *commands.CreateSubscriptionCommand
+	updateSubscriptionCommand *commands.UpdateSubscriptionCommand
+	deleteSubscriptionCommand *commands.DeleteSubscriptionCommand
+	redeliverCommand          *commands.RedeliverCommand
+	listSubscriptionsQuery    *queries.ListSubscriptionsQuery
+	getSubscriptionQuery      *queries.GetSubscriptionQuery
+	listDeliveriesQuery       *queries.ListDeliveriesQuery
+	getDeliveryQuery          *queries.GetDeliveryQuery
+}
+
+// NewWebhookHandler creates a new webhook handler
+func NewWebhookHandler(
+	createSubscriptionCommand *commands.CreateSubscriptionCommand,
+	updateSubscriptionCommand *commands.UpdateSubscriptionCommand,
+	deleteSubscriptionCommand *commands.DeleteSubscriptionCommand,
+	redeliverCommand *commands.RedeliverCommand,
+	listSubscriptionsQuery *queries.ListSubscriptionsQuery,
+	getSubscriptionQuery *queries.GetSubscriptionQuery,
+	listDeliveriesQuery *queries.ListDeliveriesQuery,
+	getDeliveryQuery *queries.GetDeliveryQuery,
+) *WebhookHandler {
+	return &WebhookHandler{
+		createSubscriptionCommand: createSubscriptionCommand,
+		updateSubscriptionCommand: updateSubscriptionCommand,
+		deleteSubscriptionCommand: deleteSubscriptionCommand,
+		redeliverCommand:          redeliverCommand,
+		listSubscriptionsQuery:    listSubscriptionsQuery,
+		getSubscriptionQuery:      getSubscriptionQuery,
+		listDeliveriesQuery:       listDeliveriesQuery,
+		getDeliveryQuery:          getDeliveryQuery,
+	}
+}
+
+// CreateSubscription registers a new webhook endpoint
+// POST /api/v1/webhooks
+func (h *WebhookHandler) CreateSubscription(c *gin.Context) {
+	var req dto.CreateSubscriptionRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	sub, err := h.createSubscriptionCommand.Execute(req)
+	if err != nil {
+		log.Printf("Error creating webhook subscription: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.Created(c, sub, "Webhook subscription created successfully")
+}
+
+// ListSubscriptions retrieves all webhook subscriptions
+// GET /api/v1/webhooks
+func (h *WebhookHandler) ListSubscriptions(c *gin.Context) {
+	// Execute query
+	subs, err := h.listSubscriptionsQuery.Execute()
+	if err != nil {
+		log.Printf("Error listing webhook subscriptions: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, subs, "Webhook subscriptions retrieved successfully")
+}
+
+// GetSubscription retrieves a webhook subscription by ID
+// GET /api/v1/webhooks/:id
+func (h *WebhookHandler) GetSubscription(c *gin.Context) {
+	subscriptionID := request.GetPathParam(c, "id")
+
+	// Execute query
+	sub, err := h.getSubscriptionQuery.Execute(subscriptionID)
+	if err != nil {
+		log.Printf("Error getting webhook subscription: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, sub, "Webhook subscription retrieved successfully")
+}
+
+// UpdateSubscription changes a subscription's endpoint, topics or state
+// PUT /api/v1/webhooks/:id
+func (h *WebhookHandler) UpdateSubscription(c *gin.Context) {
+	subscriptionID := request.GetPathParam(c, "id")
+
+	var req dto.UpdateSubscriptionRequest
+
+	// Bind and validate request
+	if err := request.BindAndValidate(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute command
+	sub, err := h.updateSubscriptionCommand.Execute(subscriptionID, req)
+	if err != nil {
+		log.Printf("Error updating webhook subscription: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, sub, "Webhook subscription updated successfully")
+}
+
+// DeleteSubscription removes a subscription and its delivery log
+// DELETE /api/v1/webhooks/:id
+func (h *WebhookHandler) DeleteSubscription(c *gin.Context) {
+	subscriptionID := request.GetPathParam(c, "id")
+
+	// Execute command
+	if err := h.deleteSubscriptionCommand.Execute(subscriptionID); err != nil {
+		log.Printf("Error deleting webhook subscription: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, nil, "Webhook subscription deleted successfully")
+}
+
+// ListDeliveries retrieves the delivery log of a subscription
+// GET /api/v1/webhooks/:id/deliveries
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	subscriptionID := request.GetPathParam(c, "id")
+
+	var req dto.ListDeliveriesRequest
+
+	// Bind and validate query parameters
+	if err := request.BindQuery(c, &req); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// Execute query
+	deliveries, err := h.listDeliveriesQuery.Execute(subscriptionID, req)
+	if err != nil {
+		log.Printf("Error listing webhook deliveries: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, deliveries, "Webhook deliveries retrieved successfully")
+}
+
+// GetDelivery retrieves a single delivery with its attempts and payload
+// GET /api/v1/webhooks/:id/deliveries/:delivery_id
+func (h *WebhookHandler) GetDelivery(c *gin.Context) {
+	subscriptionID := request.GetPathParam(c, "id")
+	deliveryID := request.GetPathParam(c, "delivery_id")
+
+	// Execute query
+	delivery, err := h.getDeliveryQuery.Execute(subscriptionID, deliveryID)
+	if err != nil {
+		log.Printf("Error getting webhook delivery: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, delivery, "Webhook delivery retrieved successfully")
+}
+
+// Redeliver sends a delivery again right away, whatever its status
+// POST /api/v1/webhooks/:id/deliveries/:delivery_id/redeliver
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	subscriptionID := request.GetPathParam(c, "id")
+	deliveryID := request.GetPathParam(c, "delivery_id")
+
+	// Execute command
+	delivery, err := h.redeliverCommand.Execute(subscriptionID, deliveryID)
+	if err != nil {
+		log.Printf("Error redelivering webhook: %v", err)
+		response.HandleError(c, err)
+		return
+	}
+
+	// Return success response
+	response.OK(c, delivery, "Webhook redelivered")
+}
@@ -41,6 +41,10 @@ func mapErrorToStatusCode(err error) int {
 		return http.StatusBadRequest
 	case errors.Is(err, shared.ErrOrderNotModifiable):
 		return http.StatusBadRequest
+	case errors.Is(err, shared.ErrProductInUse):
+		return http.StatusConflict
+	case errors.Is(err, shared.ErrStocktakeInProgress):
+		return http.StatusConflict
 	default:
 		return http.StatusInternalServerError
 	}
@@ -73,6 +73,16 @@ func NotFound(c *gin.Context, message string) {
 	Error(c, http.StatusNotFound, shared.ErrNotFound, message)
 }
 
+// Conflict sends a 409 Conflict response
+func Conflict(c *gin.Context, err error, message string) {
+	Error(c, http.StatusConflict, err, message)
+}
+
+// ServiceUnavailable sends a 503 Service Unavailable response
+func ServiceUnavailable(c *gin.Context, err error, message string) {
+	Error(c, http.StatusServiceUnavailable, err, message)
+}
+
 // InternalServerError sends a 500 Internal Server Error response
 func InternalServerError(c *gin.Context, err error, message string) {
 	Error(c, http.StatusInternalServerError, err, message)
@@ -98,6 +108,16 @@ func HandleError(c *gin.Context, err error) {
 		BadRequest(c, err, "Invalid quantity value")
 	case errors.Is(err, shared.ErrOrderNotModifiable):
 		UnprocessableEntity(c, err, "Order cannot be modified")
+	case errors.Is(err, shared.ErrProductInUse):
+		Conflict(c, err, "Product is referenced by existing orders")
+	case errors.Is(err, shared.ErrStocktakeInProgress):
+		Conflict(c, err, "A stocktake is already in progress")
+	case errors.Is(err, shared.ErrDeviceUnavailable):
+		ServiceUnavailable(c, err, "Device is not available")
+	case errors.Is(err, shared.ErrNoStableWeight):
+		Conflict(c, err, "No stable weight on the scale")
+	case errors.Is(err, shared.ErrTableInUse):
+		Conflict(c, err, "Table has open orders")
 	default:
 		InternalServerError(c, err, "Internal server error occurred")
 	}
@@ -118,6 +138,16 @@ func getErrorCode(err error) string {
 		return "INVALID_QUANTITY"
 	case errors.Is(err, shared.ErrOrderNotModifiable):
 		return "ORDER_NOT_MODIFIABLE"
+	case errors.Is(err, shared.ErrProductInUse):
+		return "PRODUCT_IN_USE"
+	case errors.Is(err, shared.ErrStocktakeInProgress):
+		return "STOCKTAKE_IN_PROGRESS"
+	case errors.Is(err, shared.ErrDeviceUnavailable):
+		return "DEVICE_UNAVAILABLE"
+	case errors.Is(err, shared.ErrNoStableWeight):
+		return "NO_STABLE_WEIGHT"
+	case errors.Is(err, shared.ErrTableInUse):
+		return "TABLE_IN_USE"
 	default:
 		return "INTERNAL_ERROR"
 	}
@@ -12,6 +12,21 @@ func RegisterRoutes(
 	productHandler *handlers.ProductHandler,
 	orderHandler *handlers.OrderHandler,
 	salesHandler *handlers.SalesHandler,
+	ingredientHandler *handlers.IngredientHandler,
+	purchasingHandler *handlers.PurchasingHandler,
+	stocktakeHandler *handlers.StocktakeHandler,
+	wasteHandler *handlers.WasteHandler,
+	inventoryHandler *handlers.InventoryHandler,
+	locationHandler *handlers.LocationHandler,
+	scaleHandler *handlers.ScaleHandler,
+	stationHandler *handlers.StationHandler,
+	eventHandler *handlers.EventHandler,
+	webhookHandler *handlers.WebhookHandler,
+	receiptHandler *handlers.ReceiptHandler,
+	kitchenPrinterHandler *handlers.KitchenPrinterHandler,
+	peripheralHandler *handlers.PeripheralHandler,
+	mailHandler *handlers.MailHandler,
+	tableHandler *handlers.TableHandler,
 ) {
 	// Health check endpoint
 	router.GET("/health", healthCheck)
@@ -27,6 +42,51 @@ func RegisterRoutes(
 
 		// Sales routes
 		registerSalesRoutes(v1, salesHandler)
+
+		// Ingredient and recipe routes
+		registerIngredientRoutes(v1, ingredientHandler)
+
+		// Supplier and purchase order routes
+		registerPurchasingRoutes(v1, purchasingHandler)
+
+		// Stocktake routes
+		registerStocktakeRoutes(v1, stocktakeHandler)
+
+		// Waste log routes
+		registerWasteRoutes(v1, wasteHandler)
+
+		// Inventory planning routes
+		registerInventoryRoutes(v1, inventoryHandler)
+
+		// Stock location and transfer routes
+		registerLocationRoutes(v1, locationHandler)
+
+		// Weighing scale routes
+		registerScaleRoutes(v1, scaleHandler)
+
+		// Kitchen station routes
+		registerStationRoutes(v1, stationHandler)
+
+		// Real-time event stream routes
+		registerEventRoutes(v1, eventHandler)
+
+		// Webhook routes
+		registerWebhookRoutes(v1, webhookHandler)
+
+		// Receipt routes
+		registerReceiptRoutes(v1, receiptHandler)
+
+		// Kitchen printer and print queue routes
+		registerKitchenPrinterRoutes(v1, kitchenPrinterHandler)
+
+		// Cash drawer and pole display routes
+		registerPeripheralRoutes(v1, peripheralHandler)
+
+		// Emailed receipt and outgoing email routes
+		registerMailRoutes(v1, mailHandler)
+
+		// Table and floor plan routes
+		registerTableRoutes(v1, tableHandler)
 	}
 }
 
@@ -45,6 +105,7 @@ func registerProductRoutes(rg *gin.RouterGroup, handler *handlers.ProductHandler
 	{
 		// Special route: must be before /:id to avoid conflict
 		products.GET("/low-stock", handler.GetLowStockProducts)
+		products.GET("/archived", handler.ListArchivedProducts)
 
 		// Standard CRUD operations
 		products.POST("", handler.CreateProduct)
@@ -55,6 +116,13 @@ func registerProductRoutes(rg *gin.RouterGroup, handler *handlers.ProductHandler
 
 		// Stock management
 		products.POST("/:id/stock", handler.UpdateStock)
+
+		// Cost price history
+		products.GET("/:id/cost-history", handler.GetCostHistory)
+
+		// Archive management
+		products.POST("/:id/restore", handler.RestoreProduct)
+		products.DELETE("/:id/purge", handler.PurgeProduct)
 	}
 }
 
@@ -72,6 +140,13 @@ func registerOrderRoutes(rg *gin.RouterGroup, handler *handlers.OrderHandler) {
 
 		// Order status management
 		orders.PATCH("/:id/status", handler.UpdateOrderStatus)
+		orders.PATCH("/:id/items/:line/status", handler.UpdateItemStatus)
+
+		// Moving, merging and splitting between tables
+		orders.POST("/:id/move", handler.MoveOrder)
+		orders.POST("/:id/merge", handler.MergeOrders)
+		orders.POST("/:id/split", handler.SplitOrder)
+		orders.GET("/:id/audit", handler.GetOrderAudit)
 	}
 }
 
@@ -84,3 +159,260 @@ func registerSalesRoutes(rg *gin.RouterGroup, handler *handlers.SalesHandler) {
 		sales.POST("/close-day", handler.CloseDay)
 	}
 }
+
+// registerIngredientRoutes registers all ingredient and recipe routes
+func registerIngredientRoutes(rg *gin.RouterGroup, handler *handlers.IngredientHandler) {
+	ingredients := rg.Group("/ingredients")
+	{
+		// Special route: must be before /:id to avoid conflict
+		ingredients.GET("/low-stock", handler.GetLowStockIngredients)
+
+		// Standard CRUD operations
+		ingredients.POST("", handler.CreateIngredient)
+		ingredients.GET("", handler.ListIngredients)
+		ingredients.GET("/:id", handler.GetIngredient)
+		ingredients.PUT("/:id", handler.UpdateIngredient)
+
+		// Stock management
+		ingredients.POST("/:id/stock", handler.UpdateIngredientStock)
+	}
+
+	// Recipes belong to sellable products
+	recipes := rg.Group("/products/:id/recipe")
+	{
+		recipes.GET("", handler.GetRecipe)
+		recipes.PUT("", handler.SetRecipe)
+		recipes.DELETE("", handler.DeleteRecipe)
+	}
+}
+
+// registerPurchasingRoutes registers all supplier and purchase order routes
+func registerPurchasingRoutes(rg *gin.RouterGroup, handler *handlers.PurchasingHandler) {
+	suppliers := rg.Group("/suppliers")
+	{
+		suppliers.POST("", handler.CreateSupplier)
+		suppliers.GET("", handler.ListSuppliers)
+		suppliers.GET("/:id", handler.GetSupplier)
+		suppliers.PUT("/:id", handler.UpdateSupplier)
+	}
+
+	purchaseOrders := rg.Group("/purchase-orders")
+	{
+		// Special route: must be before /:id to avoid conflict
+		purchaseOrders.GET("/open", handler.GetOpenPurchaseOrders)
+
+		// Standard CRUD operations
+		purchaseOrders.POST("", handler.CreatePurchaseOrder)
+		purchaseOrders.GET("", handler.ListPurchaseOrders)
+		purchaseOrders.GET("/:id", handler.GetPurchaseOrder)
+		purchaseOrders.PUT("/:id", handler.UpdatePurchaseOrder)
+
+		// Purchase order status management
+		purchaseOrders.PATCH("/:id/status", handler.UpdatePurchaseOrderStatus)
+
+		// Goods receiving
+		purchaseOrders.POST("/:id/receive", handler.ReceivePurchaseOrder)
+	}
+}
+
+// registerStocktakeRoutes registers all stocktake routes
+func registerStocktakeRoutes(rg *gin.RouterGroup, handler *handlers.StocktakeHandler) {
+	stocktakes := rg.Group("/stocktakes")
+	{
+		stocktakes.POST("", handler.OpenStocktake)
+		stocktakes.GET("", handler.ListStocktakes)
+		stocktakes.GET("/:id", handler.GetStocktake)
+
+		// Counting and review
+		stocktakes.POST("/:id/counts", handler.SubmitCounts)
+		stocktakes.GET("/:id/variance", handler.GetVarianceReport)
+
+		// Closing
+		stocktakes.POST("/:id/finalize", handler.FinalizeStocktake)
+		stocktakes.POST("/:id/cancel", handler.CancelStocktake)
+	}
+}
+
+// registerWasteRoutes registers all waste log routes
+func registerWasteRoutes(rg *gin.RouterGroup, handler *handlers.WasteHandler) {
+	wasteLog := rg.Group("/waste")
+	{
+		wasteLog.POST("", handler.RecordWaste)
+		wasteLog.POST("/lots", handler.WriteOffLot)
+		wasteLog.POST("/lots/expired", handler.WriteOffExpiredLots)
+		wasteLog.GET("", handler.ListWaste)
+		wasteLog.GET("/report", handler.GetWasteReport)
+	}
+}
+
+// registerInventoryRoutes registers all inventory planning routes
+func registerInventoryRoutes(rg *gin.RouterGroup, handler *handlers.InventoryHandler) {
+	inventory := rg.Group("/inventory")
+	{
+		inventory.GET("/reorder-suggestions", handler.GetReorderSuggestions)
+		inventory.POST("/reorder-suggestions/purchase-order", handler.CreateDraftPurchaseOrder)
+		inventory.GET("/lots/expiring", handler.GetExpiringLots)
+	}
+
+	// Stock lots with expiry dates belong to products
+	lots := rg.Group("/products/:id/lots")
+	{
+		lots.GET("", handler.ListLots)
+		lots.POST("", handler.ReceiveLot)
+	}
+}
+
+// registerLocationRoutes registers all stock location and transfer routes
+func registerLocationRoutes(rg *gin.RouterGroup, handler *handlers.LocationHandler) {
+	locations := rg.Group("/locations")
+	{
+		locations.POST("", handler.CreateLocation)
+		locations.GET("", handler.ListLocations)
+		locations.GET("/:id", handler.GetLocation)
+		locations.PUT("/:id", handler.UpdateLocation)
+
+		// Stock held at the location
+		locations.GET("/:id/stock", handler.GetLocationStock)
+		locations.GET("/:id/low-stock", handler.GetLocationLowStock)
+		locations.PUT("/:id/stock/:product_id", handler.SetLowStockLevel)
+	}
+
+	// Stock of a product across locations
+	rg.GET("/products/:id/locations", handler.GetProductLocations)
+
+	transfers := rg.Group("/transfers")
+	{
+		transfers.POST("", handler.CreateTransfer)
+		transfers.GET("", handler.ListTransfers)
+		transfers.GET("/:id", handler.GetTransfer)
+	}
+}
+
+// registerScaleRoutes registers all weighing scale routes
+func registerScaleRoutes(rg *gin.RouterGroup, handler *handlers.ScaleHandler) {
+	scale := rg.Group("/scale")
+	{
+		scale.GET("/weight", handler.GetWeight)
+		scale.PUT("/simulator", handler.SimulateWeight)
+	}
+}
+
+// registerStationRoutes registers all kitchen station routes
+func registerStationRoutes(rg *gin.RouterGroup, handler *handlers.StationHandler) {
+	stations := rg.Group("/stations")
+	{
+		stations.POST("", handler.CreateStation)
+		stations.GET("", handler.ListStations)
+		stations.GET("/:id", handler.GetStation)
+		stations.PUT("/:id", handler.UpdateStation)
+
+		// Orders waiting on the station
+		stations.GET("/:id/queue", handler.GetQueue)
+		stations.POST("/:id/queue/:order_id/bump", handler.BumpOrder)
+	}
+}
+
+// registerEventRoutes registers the real-time event stream routes
+func registerEventRoutes(rg *gin.RouterGroup, handler *handlers.EventHandler) {
+	events := rg.Group("/events")
+	{
+		events.GET("", handler.StreamEvents)
+		events.GET("/ws", handler.StreamEventsWS)
+	}
+}
+
+// registerWebhookRoutes registers all webhook subscription routes
+func registerWebhookRoutes(rg *gin.RouterGroup, handler *handlers.WebhookHandler) {
+	webhooks := rg.Group("/webhooks")
+	{
+		webhooks.POST("", handler.CreateSubscription)
+		webhooks.GET("", handler.ListSubscriptions)
+		webhooks.GET("/:id", handler.GetSubscription)
+		webhooks.PUT("/:id", handler.UpdateSubscription)
+		webhooks.DELETE("/:id", handler.DeleteSubscription)
+
+		// Delivery log
+		webhooks.GET("/:id/deliveries", handler.ListDeliveries)
+		webhooks.GET("/:id/deliveries/:delivery_id", handler.GetDelivery)
+		webhooks.POST("/:id/deliveries/:delivery_id/redeliver", handler.Redeliver)
+	}
+}
+
+// registerReceiptRoutes registers customer receipt and printer routes
+func registerReceiptRoutes(rg *gin.RouterGroup, handler *handlers.ReceiptHandler) {
+	rg.GET("/orders/:id/receipt", handler.PreviewReceipt)
+	rg.POST("/orders/:id/receipt/print", handler.PrintReceipt)
+
+	printer := rg.Group("/printer")
+	{
+		printer.GET("/memory/jobs", handler.ListMemoryJobs)
+		printer.DELETE("/memory/jobs", handler.ClearMemoryJobs)
+	}
+}
+
+// registerKitchenPrinterRoutes registers kitchen printer and print queue routes
+func registerKitchenPrinterRoutes(rg *gin.RouterGroup, handler *handlers.KitchenPrinterHandler) {
+	printers := rg.Group("/kitchen-printers")
+	{
+		printers.POST("", handler.CreatePrinter)
+		printers.GET("", handler.ListPrinters)
+		printers.GET("/:id", handler.GetPrinter)
+		printers.PUT("/:id", handler.UpdatePrinter)
+		printers.DELETE("/:id", handler.DeletePrinter)
+	}
+
+	rg.POST("/orders/:id/kitchen-tickets/reprint", handler.Reprint)
+
+	jobs := rg.Group("/print-jobs")
+	{
+		jobs.GET("", handler.ListPrintJobs)
+		jobs.GET("/:id", handler.GetPrintJob)
+		jobs.POST("/:id/retry", handler.RetryPrintJob)
+	}
+}
+
+// registerPeripheralRoutes registers cash drawer and customer display routes
+func registerPeripheralRoutes(rg *gin.RouterGroup, handler *handlers.PeripheralHandler) {
+	drawer := rg.Group("/drawer")
+	{
+		drawer.POST("/open", handler.OpenDrawer)
+		drawer.GET("/openings", handler.ListDrawerOpenings)
+		drawer.GET("/simulator", handler.GetDrawerSimulator)
+	}
+
+	display := rg.Group("/display")
+	{
+		display.PUT("", handler.ShowDisplay)
+		display.DELETE("", handler.ClearDisplay)
+		display.GET("/simulator", handler.GetDisplaySimulator)
+	}
+}
+
+// registerMailRoutes registers emailed receipt and outgoing email queue routes
+func registerMailRoutes(rg *gin.RouterGroup, handler *handlers.MailHandler) {
+	rg.GET("/orders/:id/receipt/email", handler.PreviewReceipt)
+	rg.POST("/orders/:id/receipt/email", handler.SendReceipt)
+
+	emails := rg.Group("/emails")
+	{
+		emails.GET("", handler.ListEmails)
+		emails.GET("/:id", handler.GetEmail)
+		emails.POST("/:id/retry", handler.RetryEmail)
+	}
+}
+
+// registerTableRoutes registers the floor plan routes
+func registerTableRoutes(rg *gin.RouterGroup, handler *handlers.TableHandler) {
+	tables := rg.Group("/tables")
+	{
+		tables.POST("", handler.CreateTable)
+		tables.GET("", handler.ListTables)
+		tables.GET("/:id", handler.GetTable)
+		tables.PUT("/:id", handler.UpdateTable)
+		tables.DELETE("/:id", handler.DeleteTable)
+
+		// Guests asking for the bill
+		tables.POST("/:id/bill-request", handler.RequestBill)
+		tables.DELETE("/:id/bill-request", handler.CancelBillRequest)
+	}
+}
@@ -0,0 +1,217 @@
+package mailer
+
+import (
+	"POSFlowBackend/internal/domain/mail"
+	"bytes"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// Security is how the connection to the mail server is protected
+type Security string
+
+const (
+	SecurityNone     Security = "none"     // plain SMTP, for local relays and test servers
+	SecurityStartTLS Security = "starttls" // upgrade with STARTTLS, usually on port 587
+	SecurityTLS      Security = "tls"      // implicit TLS, usually on port 465
+)
+
+// SMTPSender hands messages to a mail server over SMTP, opening a new
+// connection for each message
+type SMTPSender struct {
+	host     string
+	port     int
+	security Security
+	username string
+	password string
+	from     netmail.Address
+	timeout  time.Duration
+}
+
+func NewSMTPSender(
+	host string,
+	port int,
+	security Security,
+	username, password string,
+	from netmail.Address,
+	timeout time.Duration,
+) (*SMTPSender, error) {
+	switch security {
+	case SecurityNone, SecurityStartTLS, SecurityTLS:
+	default:
+		return nil, fmt.Errorf("unknown SMTP security %q, use none, starttls or tls", security)
+	}
+	if host == "" || from.Address == "" {
+		return nil, errors.New("SMTP host and sender address are required")
+	}
+
+	return &SMTPSender{
+		host:     host,
+		port:     port,
+		security: security,
+		username: username,
+		password: password,
+		from:     from,
+		timeout:  timeout,
+	}, nil
+}
+
+// Send implements mail.Sender. Permanent (5xx) refusals of the sender,
+// recipient or message are reported as mail.ErrRejected.
+func (s *SMTPSender) Send(msg mail.Message) error {
+	body, err := s.encode(msg)
+	if err != nil {
+		return err
+	}
+
+	client, err := s.dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if s.security == SecurityStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return errors.New("mail server does not support STARTTLS")
+		}
+		if err := client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return err
+		}
+	}
+	if s.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(s.from.Address); err != nil {
+		return rejected(err)
+	}
+	if err := client.Rcpt(msg.To); err != nil {
+		return rejected(err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return rejected(err)
+	}
+	if _, err := w.Write(body); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return rejected(err)
+	}
+	return client.Quit()
+}
+
+func (s *SMTPSender) dial() (*smtp.Client, error) {
+	address := net.JoinHostPort(s.host, fmt.Sprint(s.port))
+	dialer := &net.Dialer{Timeout: s.timeout}
+
+	var conn net.Conn
+	var err error
+	if s.security == SecurityTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", address, &tls.Config{ServerName: s.host})
+	} else {
+		conn, err = dialer.Dial("tcp", address)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The whole conversation must finish within the timeout
+	if err := conn.SetDeadline(time.Now().Add(s.timeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+// encode builds a multipart/alternative message with the text body first,
+// so clients that can show HTML pick the last part
+func (s *SMTPSender) encode(msg mail.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	parts := multipart.NewWriter(&buf)
+
+	header := func(key, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", key, value)
+	}
+	header("From", s.from.String())
+	header("To", msg.To)
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", s.messageID())
+	header("MIME-Version", "1.0")
+	header("Content-Type", `multipart/alternative; boundary="`+parts.Boundary()+`"`)
+	buf.WriteString("\r\n")
+
+	for _, part := range []struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain; charset=utf-8", msg.TextBody},
+		{"text/html; charset=utf-8", msg.HTMLBody},
+	} {
+		if part.body == "" {
+			continue
+		}
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := io.WriteString(qp, part.body); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// messageID makes a unique Message-ID in the sender's domain
+func (s *SMTPSender) messageID() string {
+	random := make([]byte, 12)
+	rand.Read(random)
+
+	domain := s.host
+	if at := strings.LastIndex(s.from.Address, "@"); at >= 0 {
+		domain = s.from.Address[at+1:]
+	}
+	return fmt.Sprintf("<%d.%s@%s>", time.Now().UnixNano(), hex.EncodeToString(random), domain)
+}
+
+// rejected marks permanent SMTP failures so they are not retried
+func rejected(err error) error {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) && protoErr.Code >= 500 {
+		return fmt.Errorf("%w: %v", mail.ErrRejected, err)
+	}
+	return err
+}
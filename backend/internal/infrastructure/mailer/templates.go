@@ -0,0 +1,75 @@
+package mailer
+
+import (
+	"POSFlowBackend/internal/domain/mail"
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	texttemplate "text/template"
+)
+
+//go:embed templates/*
+var defaultTemplates embed.FS
+
+// TemplateComposer renders emails from a pair of templates per email: an
+// HTML one (html/template, so order data is escaped) and a plain text one.
+// The built-in templates can be replaced by files of the same name, e.g.
+// receipt.html and receipt.txt, in a template directory.
+type TemplateComposer struct {
+	receiptHTML *htmltemplate.Template
+	receiptText *texttemplate.Template
+}
+
+// NewTemplateComposer loads the templates from dir, or the built-in ones
+// when dir is empty
+func NewTemplateComposer(dir string) (*TemplateComposer, error) {
+	var files fs.FS
+	if dir != "" {
+		files = os.DirFS(dir)
+	} else {
+		sub, err := fs.Sub(defaultTemplates, "templates")
+		if err != nil {
+			return nil, err
+		}
+		files = sub
+	}
+
+	receiptHTML, err := htmltemplate.ParseFS(files, "receipt.html")
+	if err != nil {
+		return nil, err
+	}
+	receiptText, err := texttemplate.ParseFS(files, "receipt.txt")
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplateComposer{
+		receiptHTML: receiptHTML,
+		receiptText: receiptText,
+	}, nil
+}
+
+// ComposeReceipt implements mail.Composer
+func (c *TemplateComposer) ComposeReceipt(to string, receipt mail.Receipt) (mail.Message, error) {
+	var html, text bytes.Buffer
+	if err := c.receiptHTML.Execute(&html, receipt); err != nil {
+		return mail.Message{}, err
+	}
+	if err := c.receiptText.Execute(&text, receipt); err != nil {
+		return mail.Message{}, err
+	}
+
+	subject := "Your receipt for order #" + receipt.Reference
+	if receipt.Store.Name != "" {
+		subject = "Your receipt from " + receipt.Store.Name + " - order #" + receipt.Reference
+	}
+
+	return mail.Message{
+		To:       to,
+		Subject:  subject,
+		TextBody: text.String(),
+		HTMLBody: html.String(),
+	}, nil
+}
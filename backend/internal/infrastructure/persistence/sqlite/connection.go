@@ -4,8 +4,10 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
@@ -21,8 +23,10 @@ func NewDatabase(dbPath string) (*Database, error) {
 		return nil, err
 	}
 
-	// Open database connection
-	db, err := gorm.Open(sqlite.Open(dbPath), &gorm.Config{
+	// Open database connection. Transactions take the write lock up front
+	// and wait for each other, so concurrent orders queue instead of failing.
+	dsn := dbPath + "?_pragma=busy_timeout(5000)&_txlock=immediate"
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
 	})
 
@@ -48,15 +52,133 @@ func (d *Database) AutoMigrate() error {
 
 	err := d.DB.AutoMigrate(
 		&ProductModel{},
+		&ProductCostModel{},
+		&ProductLotModel{},
 		&OrderModel{},
 		&OrderItemModel{},
 		&SalesModel{},
+		&IngredientModel{},
+		&RecipeLineModel{},
+		&SupplierModel{},
+		&PurchaseOrderModel{},
+		&PurchaseOrderLineModel{},
+		&StocktakeModel{},
+		&StocktakeCountModel{},
+		&StocktakeLineModel{},
+		&WasteEntryModel{},
+		&LocationModel{},
+		&StockLevelModel{},
+		&TransferModel{},
+		&TransferLineModel{},
+		&StationModel{},
+		&OutboxModel{},
+		&WebhookSubscriptionModel{},
+		&WebhookDeliveryModel{},
+		&KitchenPrinterModel{},
+		&PrintJobModel{},
+		&DrawerOpeningModel{},
+		&EmailModel{},
+		&BusinessDayModel{},
+		&TicketCounterModel{},
+		&TableModel{},
+		&OrderAuditModel{},
 	)
 
 	if err != nil {
 		return err
 	}
 
+	// Full-text search index for products
+	if err := d.migrateProductSearch(); err != nil {
+		return err
+	}
+
+	// Tables for the table numbers orders already use
+	if err := d.migrateTables(); err != nil {
+		return err
+	}
+
 	log.Println("✅ Migrations completed successfully")
 	return nil
 }
+
+// migrateProductSearch creates the product FTS5 index and fills it
+// from the products table when it is empty (e.g. on an existing database)
+func (d *Database) migrateProductSearch() error {
+	err := d.DB.Exec(`CREATE VIRTUAL TABLE IF NOT EXISTS ` + productSearchTable + ` USING fts5(
+		product_id UNINDEXED,
+		name,
+		description,
+		sku,
+		tags,
+		tokenize = 'unicode61 remove_diacritics 2'
+	)`).Error
+	if err != nil {
+		return err
+	}
+
+	var indexed int64
+	if err := d.DB.Table(productSearchTable).Count(&indexed).Error; err != nil {
+		return err
+	}
+	if indexed > 0 {
+		return nil
+	}
+
+	var models []ProductModel
+	if err := d.DB.Find(&models).Error; err != nil {
+		return err
+	}
+
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		for _, model := range models {
+			if err := indexProduct(tx, &model); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// migrateTables creates a floor plan table for every table number orders
+// refer to that has no table yet, so databases from before the floor plan
+// keep taking dine-in orders for the same tables. The tables start with
+// four seats at the plan's origin, to be arranged from the floor plan.
+func (d *Database) migrateTables() error {
+	var numbers []string
+	err := d.DB.Model(&OrderModel{}).
+		Distinct().
+		Where("TRIM(table_number) <> ''").
+		Where("table_number NOT IN (?)", d.DB.Model(&TableModel{}).Select("number")).
+		Order("table_number").
+		Pluck("table_number", &numbers).Error
+	if err != nil {
+		return err
+	}
+	if len(numbers) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	err = d.DB.Transaction(func(tx *gorm.DB) error {
+		for _, number := range numbers {
+			model := TableModel{
+				ID:        uuid.New().String(),
+				Number:    number,
+				Capacity:  4,
+				CreatedAt: now,
+				UpdatedAt: now,
+			}
+			if err := tx.Create(&model).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("🪑 Created %d tables from existing orders", len(numbers))
+	return nil
+}
@@ -0,0 +1,95 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/peripheral"
+	"POSFlowBackend/internal/domain/shared"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type DrawerOpeningRepository struct {
+	db *gorm.DB
+}
+
+func NewDrawerOpeningRepository(db *gorm.DB) *DrawerOpeningRepository {
+	return &DrawerOpeningRepository{db: db}
+}
+
+// Save implements peripheral.DrawerOpeningRepository
+func (r *DrawerOpeningRepository) Save(opening *peripheral.DrawerOpening) error {
+	model := r.toModel(opening)
+
+	// Upsert: Update if exists, insert if not
+	return r.db.Save(&model).Error
+}
+
+// FindByID implements peripheral.DrawerOpeningRepository
+func (r *DrawerOpeningRepository) FindByID(id peripheral.OpeningID) (*peripheral.DrawerOpening, error) {
+	var model DrawerOpeningModel
+
+	result := r.db.Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model), nil
+}
+
+// FindByDateRange implements peripheral.DrawerOpeningRepository
+func (r *DrawerOpeningRepository) FindByDateRange(start, end time.Time, reason peripheral.Reason) ([]*peripheral.DrawerOpening, error) {
+	var models []DrawerOpeningModel
+
+	query := r.db.Where("opened_at >= ? AND opened_at < ?", start, end)
+	if reason != "" {
+		query = query.Where("reason = ?", string(reason))
+	}
+
+	result := query.Order("opened_at desc").Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *DrawerOpeningRepository) toModel(opening *peripheral.DrawerOpening) DrawerOpeningModel {
+	return DrawerOpeningModel{
+		ID:          opening.ID().String(),
+		Reason:      string(opening.Reason()),
+		OrderID:     opening.OrderID().String(),
+		StaffMember: opening.StaffMember(),
+		Note:        opening.Note(),
+		Succeeded:   opening.Succeeded(),
+		Failure:     opening.Failure(),
+		OpenedAt:    opening.OpenedAt(),
+	}
+}
+
+func (r *DrawerOpeningRepository) toDomain(model *DrawerOpeningModel) *peripheral.DrawerOpening {
+	return peripheral.ReconstructDrawerOpening(
+		peripheral.OpeningID(model.ID),
+		peripheral.Reason(model.Reason),
+		shared.OrderID(model.OrderID),
+		model.StaffMember,
+		model.Note,
+		model.Succeeded,
+		model.Failure,
+		model.OpenedAt,
+	)
+}
+
+func (r *DrawerOpeningRepository) toDomainList(models []DrawerOpeningModel) []*peripheral.DrawerOpening {
+	var openings []*peripheral.DrawerOpening
+
+	for _, model := range models {
+		openings = append(openings, r.toDomain(&model))
+	}
+
+	return openings
+}
@@ -0,0 +1,122 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/mail"
+	"POSFlowBackend/internal/domain/shared"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type EmailRepository struct {
+	db *gorm.DB
+}
+
+func NewEmailRepository(db *gorm.DB) *EmailRepository {
+	return &EmailRepository{db: db}
+}
+
+// Save implements mail.EmailRepository
+func (r *EmailRepository) Save(email *mail.Email) error {
+	model := r.toModel(email)
+	return r.db.Save(&model).Error
+}
+
+// FindByID implements mail.EmailRepository
+func (r *EmailRepository) FindByID(id mail.EmailID) (*mail.Email, error) {
+	var model EmailModel
+
+	result := r.db.Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model), nil
+}
+
+// FindRecent implements mail.EmailRepository
+func (r *EmailRepository) FindRecent(status mail.Status, orderID shared.OrderID, limit int) ([]*mail.Email, error) {
+	var models []EmailModel
+
+	query := r.db.Model(&EmailModel{})
+	if status != "" {
+		query = query.Where("status = ?", string(status))
+	}
+	if orderID != "" {
+		query = query.Where("order_id = ?", orderID.String())
+	}
+
+	result := query.Order("created_at desc").Limit(limit).Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// FindDue implements mail.EmailRepository
+func (r *EmailRepository) FindDue(now time.Time, limit int) ([]*mail.Email, error) {
+	var models []EmailModel
+
+	result := r.db.Where("status = ? AND next_attempt_at <= ?", string(mail.StatusPending), now).
+		Order("created_at asc").
+		Limit(limit).
+		Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *EmailRepository) toModel(email *mail.Email) EmailModel {
+	msg := email.Message()
+	return EmailModel{
+		ID:            email.ID().String(),
+		OrderID:       email.OrderID().String(),
+		Recipient:     msg.To,
+		Subject:       msg.Subject,
+		TextBody:      msg.TextBody,
+		HTMLBody:      msg.HTMLBody,
+		Status:        string(email.Status()),
+		Attempts:      email.Attempts(),
+		LastError:     email.LastError(),
+		NextAttemptAt: email.NextAttemptAt(),
+		SentAt:        email.SentAt(),
+		CreatedAt:     email.CreatedAt(),
+		UpdatedAt:     email.UpdatedAt(),
+	}
+}
+
+func (r *EmailRepository) toDomain(model *EmailModel) *mail.Email {
+	return mail.ReconstructEmail(
+		mail.EmailID(model.ID),
+		shared.OrderID(model.OrderID),
+		mail.Message{
+			To:       model.Recipient,
+			Subject:  model.Subject,
+			TextBody: model.TextBody,
+			HTMLBody: model.HTMLBody,
+		},
+		mail.Status(model.Status),
+		model.Attempts,
+		model.LastError,
+		model.NextAttemptAt,
+		model.SentAt,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+}
+
+func (r *EmailRepository) toDomainList(models []EmailModel) []*mail.Email {
+	emails := make([]*mail.Email, 0, len(models))
+	for _, model := range models {
+		emails = append(emails, r.toDomain(&model))
+	}
+	return emails
+}
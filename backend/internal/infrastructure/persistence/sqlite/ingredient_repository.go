@@ -0,0 +1,99 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/ingredient"
+	"POSFlowBackend/internal/domain/shared"
+
+	"gorm.io/gorm"
+)
+
+type IngredientRepository struct {
+	db *gorm.DB
+}
+
+func NewIngredientRepository(db *gorm.DB) *IngredientRepository {
+	return &IngredientRepository{db: db}
+}
+
+// Save implements ingredient.IngredientRepository
+func (r *IngredientRepository) Save(ing *ingredient.Ingredient) error {
+	model := r.toModel(ing)
+
+	// Upsert: Update if exists, insert if not
+	return r.db.Save(&model).Error
+}
+
+// FindByID implements ingredient.IngredientRepository
+func (r *IngredientRepository) FindByID(id ingredient.IngredientID) (*ingredient.Ingredient, error) {
+	var model IngredientModel
+
+	result := r.db.Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model), nil
+}
+
+// FindAll implements ingredient.IngredientRepository
+func (r *IngredientRepository) FindAll() ([]*ingredient.Ingredient, error) {
+	var models []IngredientModel
+
+	result := r.db.Order("name asc").Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// FindLowStock implements ingredient.IngredientRepository
+func (r *IngredientRepository) FindLowStock() ([]*ingredient.Ingredient, error) {
+	var models []IngredientModel
+
+	result := r.db.Where("stock <= low_stock_level").Order("name asc").Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *IngredientRepository) toModel(ing *ingredient.Ingredient) IngredientModel {
+	return IngredientModel{
+		ID:            ing.ID().String(),
+		Name:          ing.Name(),
+		Unit:          string(ing.Unit()),
+		Stock:         ing.Stock(),
+		LowStockLevel: ing.LowStockLevel(),
+		CreatedAt:     ing.CreatedAt(),
+		UpdatedAt:     ing.UpdatedAt(),
+	}
+}
+
+func (r *IngredientRepository) toDomain(model *IngredientModel) *ingredient.Ingredient {
+	return ingredient.ReconstructIngredient(
+		ingredient.IngredientID(model.ID),
+		model.Name,
+		shared.UnitOfMeasure(model.Unit),
+		model.Stock,
+		model.LowStockLevel,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+}
+
+func (r *IngredientRepository) toDomainList(models []IngredientModel) []*ingredient.Ingredient {
+	var ingredients []*ingredient.Ingredient
+
+	for _, model := range models {
+		ingredients = append(ingredients, r.toDomain(&model))
+	}
+
+	return ingredients
+}
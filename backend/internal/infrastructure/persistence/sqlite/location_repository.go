@@ -0,0 +1,123 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"encoding/json"
+
+	"gorm.io/gorm"
+)
+
+type LocationRepository struct {
+	db *gorm.DB
+}
+
+func NewLocationRepository(db *gorm.DB) *LocationRepository {
+	return &LocationRepository{db: db}
+}
+
+// Save implements location.LocationRepository
+func (r *LocationRepository) Save(loc *location.Location) error {
+	model := r.toModel(loc)
+	return r.db.Save(&model).Error
+}
+
+// FindByID implements location.LocationRepository
+func (r *LocationRepository) FindByID(id location.LocationID) (*location.Location, error) {
+	var model LocationModel
+
+	result := r.db.Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model)
+}
+
+// FindAll implements location.LocationRepository
+func (r *LocationRepository) FindAll() ([]*location.Location, error) {
+	var models []LocationModel
+
+	result := r.db.Order("is_default desc, name asc").Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models)
+}
+
+// FindDefault implements location.LocationRepository
+func (r *LocationRepository) FindDefault() (*location.Location, error) {
+	var model LocationModel
+
+	result := r.db.Where("is_default = ?", true).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model)
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *LocationRepository) toModel(loc *location.Location) LocationModel {
+	terminalsJSON, _ := json.Marshal(loc.Terminals())
+	categoriesJSON, _ := json.Marshal(loc.Categories())
+
+	return LocationModel{
+		ID:         loc.ID().String(),
+		Name:       loc.Name(),
+		IsDefault:  loc.IsDefault(),
+		Terminals:  string(terminalsJSON),
+		Categories: string(categoriesJSON),
+		CreatedAt:  loc.CreatedAt(),
+		UpdatedAt:  loc.UpdatedAt(),
+	}
+}
+
+func (r *LocationRepository) toDomain(model *LocationModel) (*location.Location, error) {
+	terminals := []string{}
+	if model.Terminals != "" {
+		if err := json.Unmarshal([]byte(model.Terminals), &terminals); err != nil {
+			return nil, err
+		}
+	}
+
+	categories := []product.Category{}
+	if model.Categories != "" {
+		if err := json.Unmarshal([]byte(model.Categories), &categories); err != nil {
+			return nil, err
+		}
+	}
+
+	return location.ReconstructLocation(
+		location.LocationID(model.ID),
+		model.Name,
+		model.IsDefault,
+		terminals,
+		categories,
+		model.CreatedAt,
+		model.UpdatedAt,
+	), nil
+}
+
+func (r *LocationRepository) toDomainList(models []LocationModel) ([]*location.Location, error) {
+	var locations []*location.Location
+
+	for _, model := range models {
+		loc, err := r.toDomain(&model)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, loc)
+	}
+
+	return locations, nil
+}
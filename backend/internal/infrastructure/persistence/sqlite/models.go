@@ -7,32 +7,79 @@ import (
 
 // ProductModel - Database representation of Product
 type ProductModel struct {
-	ID          string `gorm:"primaryKey"`
-	Name        string `gorm:"not null"`
-	Description string
-	Price       float64 `gorm:"not null"`
-	Category    string  `gorm:"not null"`
-	Stock       int     `gorm:"default:0"`
-	Active      bool    `gorm:"default:true"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	ID               string `gorm:"primaryKey"`
+	Name             string `gorm:"not null"`
+	Description      string
+	SKU              string            `gorm:"index"`
+	Price            float64           `gorm:"not null"`
+	Cost             float64           `gorm:"default:0"`
+	Category         string            `gorm:"not null"`
+	Stock            float64           `gorm:"default:0"`
+	Unit             string            `gorm:"default:'unit'"`
+	PurchaseUnit     string            `gorm:"default:'unit'"`
+	UnitsPerPurchase float64           `gorm:"default:1"`
+	Weighed          bool              `gorm:"default:false"`
+	Allergens        string            `gorm:"type:text"` // JSON array of allergens
+	DietaryLabels    string            `gorm:"type:text"` // JSON array of dietary labels
+	Active           bool              `gorm:"default:true"`
+	Available        bool              `gorm:"default:true"`
+	Lots             []ProductLotModel `gorm:"foreignKey:ProductID;constraint:OnDelete:CASCADE"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
 }
 
 func (ProductModel) TableName() string {
 	return "products"
 }
 
+// ProductLotModel - Database representation of a stock Lot
+type ProductLotModel struct {
+	ID         string `gorm:"primaryKey"`
+	ProductID  string `gorm:"not null;index"`
+	Code       string
+	Quantity   float64   `gorm:"not null"`
+	ExpiresAt  time.Time `gorm:"not null;index"`
+	ReceivedAt time.Time
+}
+
+func (ProductLotModel) TableName() string {
+	return "product_lots"
+}
+
+// ProductCostModel - Database representation of a product cost price change
+type ProductCostModel struct {
+	ID            uint      `gorm:"primaryKey;autoIncrement"`
+	ProductID     string    `gorm:"not null;index"`
+	Cost          float64   `gorm:"not null"`
+	EffectiveFrom time.Time `gorm:"not null"`
+}
+
+func (ProductCostModel) TableName() string {
+	return "product_costs"
+}
+
+// productSearchTable is the FTS5 index over product name, description, SKU and tags.
+// It is maintained by ProductRepository and is not managed by GORM.
+const productSearchTable = "products_fts"
+
 // OrderModel - Database representation of Order
 type OrderModel struct {
-	ID          string           `gorm:"primaryKey"`
-	TableNumber string           `gorm:"not null"`
-	Status      string           `gorm:"default:'pending'"`
-	Total       float64          `gorm:"not null"`
-	Items       []OrderItemModel `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	ID                string `gorm:"primaryKey"`
+	TableNumber       string `gorm:"not null"`
+	Channel           string
+	TicketNumber      string `gorm:"uniqueIndex:idx_orders_ticket,where:ticket_number <> ''"` // unique within the business day
+	BusinessDayID     uint64 `gorm:"uniqueIndex:idx_orders_ticket,where:ticket_number <> '';index"`
+	TerminalID        string
+	Status            string  `gorm:"default:'pending'"`
+	Total             float64 `gorm:"not null"`
+	DeclaredAllergies string  `gorm:"type:text"` // JSON array of allergens
+	PaymentMethod     string
+	CustomerEmail     string
+	Items             []OrderItemModel `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         gorm.DeletedAt `gorm:"index"`
 }
 
 func (OrderModel) TableName() string {
@@ -41,23 +88,53 @@ func (OrderModel) TableName() string {
 
 // OrderItemModel - Database representation of OrderItem
 type OrderItemModel struct {
-	ID        uint    `gorm:"primaryKey;autoIncrement"`
-	OrderID   string  `gorm:"not null;index"`
-	ProductID string  `gorm:"not null"`
-	Quantity  int     `gorm:"not null"`
-	UnitPrice float64 `gorm:"not null"`
-	Subtotal  float64 `gorm:"not null"`
+	ID                uint    `gorm:"primaryKey;autoIncrement"`
+	OrderID           string  `gorm:"not null;index"`
+	Line              int     `gorm:"default:0"`
+	ProductID         string  `gorm:"not null"`
+	Quantity          float64 `gorm:"not null"`
+	UnitPrice         float64 `gorm:"not null"`
+	UnitCost          float64 `gorm:"default:0"`
+	Subtotal          float64 `gorm:"not null"`
+	AllergenConflicts string  `gorm:"type:text"` // JSON array of allergens
+	LocationID        string  `gorm:"index"`
+	Weighed           bool    `gorm:"default:false"`
+	StationID         string  `gorm:"index"`
+	Status            string  `gorm:"default:'queued'"`
+	StartedAt         *time.Time
+	DoneAt            *time.Time
+	ServedAt          *time.Time
 }
 
 func (OrderItemModel) TableName() string {
 	return "order_items"
 }
 
+// OrderAuditModel - Database representation of an order's move, merge or split
+type OrderAuditModel struct {
+	ID            uint   `gorm:"primaryKey;autoIncrement"`
+	OrderID       string `gorm:"not null;index"`
+	Action        string `gorm:"not null"`
+	RelatedOrders string `gorm:"type:text"` // JSON array of order IDs
+	FromTable     string
+	ToTable       string
+	Amount        float64
+	SplitMode     string
+	StaffMember   string
+	Note          string
+	At            time.Time `gorm:"not null"`
+}
+
+func (OrderAuditModel) TableName() string {
+	return "order_audit"
+}
+
 // SalesModel - Database representation of DailySales
 type SalesModel struct {
 	ID          string    `gorm:"primaryKey"`
 	Date        time.Time `gorm:"not null;uniqueIndex"`
 	TotalSales  float64   `gorm:"default:0"`
+	TotalCost   float64   `gorm:"default:0"`
 	TotalOrders int       `gorm:"default:0"`
 	OrderIDs    string    `gorm:"type:text"` // JSON array of order IDs
 	Closed      bool      `gorm:"default:false"`
@@ -69,3 +146,375 @@ type SalesModel struct {
 func (SalesModel) TableName() string {
 	return "daily_sales"
 }
+
+// IngredientModel - Database representation of Ingredient
+type IngredientModel struct {
+	ID            string  `gorm:"primaryKey"`
+	Name          string  `gorm:"not null"`
+	Unit          string  `gorm:"not null"`
+	Stock         float64 `gorm:"default:0"`
+	LowStockLevel float64 `gorm:"default:0"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (IngredientModel) TableName() string {
+	return "ingredients"
+}
+
+// RecipeLineModel - Database representation of a Recipe line
+type RecipeLineModel struct {
+	ID           uint    `gorm:"primaryKey;autoIncrement"`
+	ProductID    string  `gorm:"not null;index"`
+	IngredientID string  `gorm:"not null;index"`
+	Quantity     float64 `gorm:"not null"`
+	UpdatedAt    time.Time
+}
+
+func (RecipeLineModel) TableName() string {
+	return "recipe_lines"
+}
+
+// SupplierModel - Database representation of Supplier
+type SupplierModel struct {
+	ID          string `gorm:"primaryKey"`
+	Name        string `gorm:"not null"`
+	ContactName string
+	Email       string
+	Phone       string
+	Notes       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (SupplierModel) TableName() string {
+	return "suppliers"
+}
+
+// PurchaseOrderModel - Database representation of PurchaseOrder
+type PurchaseOrderModel struct {
+	ID           string `gorm:"primaryKey"`
+	SupplierID   string `gorm:"not null;index"`
+	Status       string `gorm:"default:'draft';index"`
+	ExpectedDate *time.Time
+	Notes        string
+	Lines        []PurchaseOrderLineModel `gorm:"foreignKey:PurchaseOrderID;constraint:OnDelete:CASCADE"`
+	SentAt       *time.Time
+	ReceivedAt   *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (PurchaseOrderModel) TableName() string {
+	return "purchase_orders"
+}
+
+// PurchaseOrderLineModel - Database representation of PurchaseOrderLine
+type PurchaseOrderLineModel struct {
+	ID               uint    `gorm:"primaryKey;autoIncrement"`
+	PurchaseOrderID  string  `gorm:"not null;index"`
+	ProductID        string  `gorm:"not null;index"`
+	QuantityOrdered  float64 `gorm:"not null"`
+	QuantityReceived float64 `gorm:"default:0"`
+	UnitCost         float64 `gorm:"not null"`
+}
+
+func (PurchaseOrderLineModel) TableName() string {
+	return "purchase_order_lines"
+}
+
+// StocktakeModel - Database representation of Stocktake
+type StocktakeModel struct {
+	ID          string `gorm:"primaryKey"`
+	Name        string
+	LocationID  string                `gorm:"index"` // empty on stocktakes taken before locations, meaning the default
+	Status      string                `gorm:"default:'open';index"`
+	Counts      []StocktakeCountModel `gorm:"foreignKey:StocktakeID;constraint:OnDelete:CASCADE"`
+	Lines       []StocktakeLineModel  `gorm:"foreignKey:StocktakeID;constraint:OnDelete:CASCADE"`
+	OpenedAt    time.Time             `gorm:"not null"`
+	FinalizedAt *time.Time
+	UpdatedAt   time.Time
+}
+
+func (StocktakeModel) TableName() string {
+	return "stocktakes"
+}
+
+// StocktakeCountModel - Database representation of a stocktake Count
+type StocktakeCountModel struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	StocktakeID string    `gorm:"not null;index"`
+	ProductID   string    `gorm:"not null"`
+	TerminalID  string    `gorm:"not null"`
+	Counted     float64   `gorm:"not null"`
+	Expected    float64   `gorm:"not null"`
+	CountedAt   time.Time `gorm:"not null"`
+}
+
+func (StocktakeCountModel) TableName() string {
+	return "stocktake_counts"
+}
+
+// StocktakeLineModel - Database representation of a finalized stocktake VarianceLine
+type StocktakeLineModel struct {
+	ID          uint    `gorm:"primaryKey;autoIncrement"`
+	StocktakeID string  `gorm:"not null;index"`
+	ProductID   string  `gorm:"not null"`
+	Expected    float64 `gorm:"not null"`
+	Counted     float64 `gorm:"not null"`
+	UnitCost    float64 `gorm:"default:0"`
+}
+
+func (StocktakeLineModel) TableName() string {
+	return "stocktake_lines"
+}
+
+// WasteEntryModel - Database representation of WasteEntry
+type WasteEntryModel struct {
+	ID          string  `gorm:"primaryKey"`
+	ProductID   string  `gorm:"not null;index"`
+	Quantity    float64 `gorm:"not null"`
+	Reason      string  `gorm:"not null;index"`
+	StaffMember string  `gorm:"not null"`
+	Notes       string
+	UnitCost    float64   `gorm:"default:0"`
+	RecordedAt  time.Time `gorm:"not null;index"`
+}
+
+func (WasteEntryModel) TableName() string {
+	return "waste_entries"
+}
+
+// LocationModel - Database representation of Location
+type LocationModel struct {
+	ID         string `gorm:"primaryKey"`
+	Name       string `gorm:"not null"`
+	IsDefault  bool   `gorm:"default:false;index"`
+	Terminals  string `gorm:"type:text"` // JSON array of terminal IDs
+	Categories string `gorm:"type:text"` // JSON array of product categories
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (LocationModel) TableName() string {
+	return "locations"
+}
+
+// StationModel - Database representation of a kitchen Station
+type StationModel struct {
+	ID         string `gorm:"primaryKey"`
+	Name       string `gorm:"not null"`
+	Products   string `gorm:"type:text"` // JSON array of product IDs
+	Categories string `gorm:"type:text"` // JSON array of product categories
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (StationModel) TableName() string {
+	return "stations"
+}
+
+// StockLevelModel - Database representation of StockLevel
+type StockLevelModel struct {
+	LocationID    string  `gorm:"primaryKey"`
+	ProductID     string  `gorm:"primaryKey;index"`
+	Quantity      float64 `gorm:"default:0"`
+	LowStockLevel float64 `gorm:"default:0"`
+	UpdatedAt     time.Time
+}
+
+func (StockLevelModel) TableName() string {
+	return "stock_levels"
+}
+
+// TransferModel - Database representation of Transfer
+type TransferModel struct {
+	ID             string `gorm:"primaryKey"`
+	FromLocationID string `gorm:"not null;index"`
+	ToLocationID   string `gorm:"not null;index"`
+	Note           string
+	CreatedBy      string
+	Lines          []TransferLineModel `gorm:"foreignKey:TransferID;constraint:OnDelete:CASCADE"`
+	CreatedAt      time.Time           `gorm:"index"`
+}
+
+func (TransferModel) TableName() string {
+	return "transfers"
+}
+
+// TransferLineModel - Database representation of TransferLine
+type TransferLineModel struct {
+	ID         uint    `gorm:"primaryKey;autoIncrement"`
+	TransferID string  `gorm:"not null;index"`
+	ProductID  string  `gorm:"not null"`
+	Quantity   float64 `gorm:"not null"`
+}
+
+func (TransferLineModel) TableName() string {
+	return "transfer_lines"
+}
+
+// OutboxModel - Database representation of a domain event awaiting dispatch
+type OutboxModel struct {
+	ID           uint64     `gorm:"primaryKey;autoIncrement"`
+	Name         string     `gorm:"not null;index"`
+	AggregateID  string     `gorm:"not null;index"`
+	Payload      string     `gorm:"type:text;not null"` // JSON-encoded event
+	OccurredAt   time.Time  `gorm:"not null"`
+	DispatchedAt *time.Time `gorm:"index"`
+	Attempts     int        `gorm:"default:0"`
+	LastError    string
+}
+
+func (OutboxModel) TableName() string {
+	return "outbox"
+}
+
+// WebhookSubscriptionModel - Database representation of a webhook Subscription
+type WebhookSubscriptionModel struct {
+	ID          string `gorm:"primaryKey"`
+	URL         string `gorm:"not null"`
+	Secret      string `gorm:"not null"`
+	Topics      string `gorm:"type:text"` // JSON array of event topics
+	Description string
+	Active      bool `gorm:"default:true"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (WebhookSubscriptionModel) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// WebhookDeliveryModel - Database representation of a webhook Delivery
+type WebhookDeliveryModel struct {
+	ID             string     `gorm:"primaryKey"`
+	SubscriptionID string     `gorm:"not null;index"`
+	EventID        uint64     `gorm:"not null"`
+	EventName      string     `gorm:"not null"`
+	Payload        string     `gorm:"type:text;not null"`
+	Status         string     `gorm:"not null;index"`
+	Attempts       string     `gorm:"type:text"` // JSON array of attempts
+	Tries          int        `gorm:"default:0"`
+	NextAttemptAt  *time.Time `gorm:"index"`
+	CreatedAt      time.Time  `gorm:"index"`
+	UpdatedAt      time.Time
+}
+
+func (WebhookDeliveryModel) TableName() string {
+	return "webhook_deliveries"
+}
+
+// KitchenPrinterModel - Database representation of a KitchenPrinter
+type KitchenPrinterModel struct {
+	ID        string `gorm:"primaryKey"`
+	Name      string `gorm:"not null"`
+	Driver    string `gorm:"not null"`
+	Address   string
+	Paper     string `gorm:"not null"`
+	Stations  string `gorm:"type:text"` // JSON array of station IDs
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (KitchenPrinterModel) TableName() string {
+	return "kitchen_printers"
+}
+
+// PrintJobModel - Database representation of a kitchen PrintJob
+type PrintJobModel struct {
+	ID            string     `gorm:"primaryKey"`
+	PrinterID     string     `gorm:"not null;index"`
+	OrderID       string     `gorm:"not null;index"`
+	StationID     string     `gorm:"not null"`
+	Kind          string     `gorm:"not null"`
+	Document      string     `gorm:"type:text;not null"` // JSON of the laid out chit
+	Status        string     `gorm:"not null;index"`
+	Attempts      int        `gorm:"default:0"`
+	LastError     string     `gorm:"type:text"`
+	NextAttemptAt *time.Time `gorm:"index"`
+	PrintedAt     *time.Time
+	CreatedAt     time.Time `gorm:"index"`
+	UpdatedAt     time.Time
+}
+
+func (PrintJobModel) TableName() string {
+	return "print_jobs"
+}
+
+// DrawerOpeningModel - Database representation of a cash DrawerOpening
+type DrawerOpeningModel struct {
+	ID          string `gorm:"primaryKey"`
+	Reason      string `gorm:"not null;index"`
+	OrderID     string `gorm:"index"`
+	StaffMember string
+	Note        string
+	Succeeded   bool
+	Failure     string    `gorm:"type:text"`
+	OpenedAt    time.Time `gorm:"not null;index"`
+}
+
+func (DrawerOpeningModel) TableName() string {
+	return "drawer_openings"
+}
+
+// EmailModel - Database representation of an outgoing Email
+type EmailModel struct {
+	ID            string     `gorm:"primaryKey"`
+	OrderID       string     `gorm:"index"`
+	Recipient     string     `gorm:"not null"`
+	Subject       string     `gorm:"not null"`
+	TextBody      string     `gorm:"type:text"`
+	HTMLBody      string     `gorm:"type:text"`
+	Status        string     `gorm:"not null;index"`
+	Attempts      int        `gorm:"default:0"`
+	LastError     string     `gorm:"type:text"`
+	NextAttemptAt *time.Time `gorm:"index"`
+	SentAt        *time.Time
+	CreatedAt     time.Time `gorm:"index"`
+	UpdatedAt     time.Time
+}
+
+func (EmailModel) TableName() string {
+	return "emails"
+}
+
+// BusinessDayModel - Database representation of an order BusinessDay
+type BusinessDayModel struct {
+	ID       uint64    `gorm:"primaryKey;autoIncrement"`
+	IsOpen   *bool     `gorm:"uniqueIndex"` // true while open, NULL once closed; at most one day is open
+	OpenedAt time.Time `gorm:"not null"`
+	ClosedAt *time.Time
+}
+
+func (BusinessDayModel) TableName() string {
+	return "business_days"
+}
+
+// TicketCounterModel - Last ticket number handed out per sequence and business day
+type TicketCounterModel struct {
+	BusinessDayID uint64 `gorm:"primaryKey"`
+	Sequence      string `gorm:"primaryKey"` // the channel prefix, empty for plain numbers
+	LastNumber    int    `gorm:"not null"`
+}
+
+func (TicketCounterModel) TableName() string {
+	return "ticket_counters"
+}
+
+// TableModel - Database representation of a floor plan Table
+type TableModel struct {
+	ID              string `gorm:"primaryKey"`
+	Number          string `gorm:"not null;uniqueIndex"`
+	Area            string `gorm:"index"`
+	Capacity        int    `gorm:"not null"`
+	X               float64
+	Y               float64
+	BillRequestedAt *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (TableModel) TableName() string {
+	return "tables"
+}
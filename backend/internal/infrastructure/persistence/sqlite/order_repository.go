@@ -1,8 +1,13 @@
 package sqlite
 
 import (
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/kitchen"
+	"POSFlowBackend/internal/domain/location"
 	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/product"
 	"POSFlowBackend/internal/domain/shared"
+	"encoding/json"
 	"time"
 
 	"gorm.io/gorm"
@@ -18,18 +23,50 @@ func NewOrderRepository(db *gorm.DB) *OrderRepository {
 
 // Save implements order.OrderRepository
 func (r *OrderRepository) Save(ord *order.Order) error {
-	model := r.toModel(ord)
+	return r.SaveAll([]*order.Order{ord})
+}
 
-	// Use transaction to ensure all items are saved
-	return r.db.Transaction(func(tx *gorm.DB) error {
-		// Delete existing items if updating
-		if err := tx.Where("order_id = ?", model.ID).Delete(&OrderItemModel{}).Error; err != nil {
-			return err
+// SaveAll implements order.OrderRepository
+func (r *OrderRepository) SaveAll(orders []*order.Order) error {
+	// Use transaction to ensure all items and recorded events are saved
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for _, ord := range orders {
+			if err := r.save(tx, ord); err != nil {
+				return err
+			}
 		}
-
-		// Save order with items
-		return tx.Save(&model).Error
+		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	for _, ord := range orders {
+		ord.ClearEvents()
+	}
+	return nil
+}
+
+func (r *OrderRepository) save(tx *gorm.DB, ord *order.Order) error {
+	model := r.toModel(ord)
+
+	// Delete existing items if updating
+	if err := tx.Where("order_id = ?", model.ID).Delete(&OrderItemModel{}).Error; err != nil {
+		return err
+	}
+
+	// Save order with items
+	if err := tx.Save(&model).Error; err != nil {
+		return err
+	}
+
+	// Keep moves, merges and splits in the audit trail
+	if err := recordAudit(tx, ord.Events()); err != nil {
+		return err
+	}
+
+	// Store the recorded domain events
+	return appendOutbox(tx, ord.Events())
 }
 
 // FindByID implements order.OrderRepository
@@ -75,6 +112,22 @@ func (r *OrderRepository) FindPending() ([]*order.Order, error) {
 	return r.toDomainList(models)
 }
 
+// FindOpen implements order.OrderRepository
+func (r *OrderRepository) FindOpen() ([]*order.Order, error) {
+	var models []OrderModel
+
+	result := r.db.Preload("Items").
+		Where("status IN ?", []string{"pending", "preparing", "ready"}).
+		Order("created_at asc").
+		Find(&models)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models)
+}
+
 // FindByStatus implements order.OrderRepository
 func (r *OrderRepository) FindByStatus(status order.OrderStatus) ([]*order.Order, error) {
 	var models []OrderModel
@@ -107,68 +160,243 @@ func (r *OrderRepository) FindByDateRange(start, end time.Time) ([]*order.Order,
 	return r.toDomainList(models)
 }
 
+// FindByBusinessDay implements order.OrderRepository
+func (r *OrderRepository) FindByBusinessDay(day order.BusinessDayID) ([]*order.Order, error) {
+	var models []OrderModel
+
+	result := r.db.Preload("Items").
+		Where("business_day_id = ?", uint64(day)).
+		Order("created_at desc").
+		Find(&models)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models)
+}
+
+// FindByTicket implements order.OrderRepository
+func (r *OrderRepository) FindByTicket(day order.BusinessDayID, ticket order.TicketNumber) ([]*order.Order, error) {
+	var models []OrderModel
+
+	result := r.db.Preload("Items").
+		Where("business_day_id = ? AND ticket_number = ?", uint64(day), ticket.String()).
+		Order("created_at desc").
+		Find(&models)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models)
+}
+
+// ExistsByProduct implements order.OrderRepository
+func (r *OrderRepository) ExistsByProduct(productID shared.ProductID) (bool, error) {
+	var count int64
+
+	result := r.db.Model(&OrderItemModel{}).
+		Where("product_id = ?", productID.String()).
+		Count(&count)
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return count > 0, nil
+}
+
+// FindAudit implements order.OrderRepository
+func (r *OrderRepository) FindAudit(id shared.OrderID) ([]order.AuditRecord, error) {
+	var models []OrderAuditModel
+
+	result := r.db.Where("order_id = ?", id.String()).
+		Order("at asc, id asc").
+		Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	records := []order.AuditRecord{}
+	for _, model := range models {
+		record, err := r.toAuditRecord(&model)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// recordAudit appends the audit trail entries of the recorded events
+func recordAudit(tx *gorm.DB, events []event.DomainEvent) error {
+	var models []OrderAuditModel
+	for _, e := range events {
+		audited, ok := e.(order.Audited)
+		if !ok {
+			continue
+		}
+		for _, record := range audited.AuditRecords() {
+			related, _ := json.Marshal(record.RelatedOrders)
+			models = append(models, OrderAuditModel{
+				OrderID:       record.OrderID.String(),
+				Action:        string(record.Action),
+				RelatedOrders: string(related),
+				FromTable:     record.FromTable.String(),
+				ToTable:       record.ToTable.String(),
+				Amount:        record.Amount.Amount,
+				SplitMode:     string(record.SplitMode),
+				StaffMember:   record.StaffMember,
+				Note:          record.Note,
+				At:            record.At,
+			})
+		}
+	}
+	if len(models) == 0 {
+		return nil
+	}
+
+	return tx.Create(&models).Error
+}
+
 // --- Mappers: Domain Entity ↔ Database Model ---
 
 func (r *OrderRepository) toModel(ord *order.Order) OrderModel {
 	var items []OrderItemModel
 
 	for _, item := range ord.Items() {
+		conflictsJSON, _ := json.Marshal(item.AllergenConflicts())
+
 		items = append(items, OrderItemModel{
-			OrderID:   ord.ID().String(),
-			ProductID: item.ProductID().String(),
-			Quantity:  item.Quantity(),
-			UnitPrice: item.UnitPrice().Amount,
-			Subtotal:  item.Subtotal().Amount,
+			OrderID:           ord.ID().String(),
+			Line:              item.Line(),
+			ProductID:         item.ProductID().String(),
+			Quantity:          item.Quantity(),
+			UnitPrice:         item.UnitPrice().Amount,
+			UnitCost:          item.UnitCost().Amount,
+			Subtotal:          item.Subtotal().Amount,
+			AllergenConflicts: string(conflictsJSON),
+			LocationID:        item.LocationID().String(),
+			Weighed:           item.Weighed(),
+			StationID:         item.StationID().String(),
+			Status:            string(item.Status()),
+			StartedAt:         item.StartedAt(),
+			DoneAt:            item.DoneAt(),
+			ServedAt:          item.ServedAt(),
 		})
 	}
 
+	// Convert declared allergies to JSON
+	allergiesJSON, _ := json.Marshal(ord.DeclaredAllergies())
+
 	return OrderModel{
-		ID:          ord.ID().String(),
-		TableNumber: ord.TableNumber().String(),
-		Status:      string(ord.Status()),
-		Total:       ord.Total().Amount,
-		Items:       items,
-		CreatedAt:   ord.CreatedAt(),
-		UpdatedAt:   ord.UpdatedAt(),
+		ID:                ord.ID().String(),
+		TableNumber:       ord.TableNumber().String(),
+		Channel:           string(ord.Channel()),
+		TicketNumber:      ord.TicketNumber().String(),
+		BusinessDayID:     uint64(ord.BusinessDay()),
+		TerminalID:        ord.TerminalID(),
+		Status:            string(ord.Status()),
+		Total:             ord.Total().Amount,
+		DeclaredAllergies: string(allergiesJSON),
+		PaymentMethod:     string(ord.PaymentMethod()),
+		CustomerEmail:     ord.CustomerEmail(),
+		Items:             items,
+		CreatedAt:         ord.CreatedAt(),
+		UpdatedAt:         ord.UpdatedAt(),
 	}
 }
 
 func (r *OrderRepository) toDomain(model *OrderModel) (*order.Order, error) {
 	var items []*order.OrderItem
 
-	for _, itemModel := range model.Items {
+	for i, itemModel := range model.Items {
 		unitPrice, err := shared.NewMoney(itemModel.UnitPrice)
 		if err != nil {
 			return nil, err
 		}
 
-		item, err := order.NewOrderItem(
+		unitCost, err := shared.NewMoney(itemModel.UnitCost)
+		if err != nil {
+			return nil, err
+		}
+
+		subtotal, err := shared.NewMoney(itemModel.Subtotal)
+		if err != nil {
+			return nil, err
+		}
+
+		var conflicts []product.Allergen
+		if itemModel.AllergenConflicts != "" {
+			if err := json.Unmarshal([]byte(itemModel.AllergenConflicts), &conflicts); err != nil {
+				return nil, err
+			}
+		}
+
+		// Rows saved before items were numbered fall back to their position
+		line := itemModel.Line
+		if line == 0 {
+			line = i + 1
+		}
+
+		status := order.ItemStatus(itemModel.Status)
+		if status == "" {
+			status = order.ItemQueued
+		}
+
+		item := order.ReconstructOrderItem(
+			line,
 			shared.ProductID(itemModel.ProductID),
 			itemModel.Quantity,
 			*unitPrice,
+			*unitCost,
+			*subtotal,
+			conflicts,
+			location.LocationID(itemModel.LocationID),
+			itemModel.Weighed,
+			kitchen.StationID(itemModel.StationID),
+			status,
+			itemModel.StartedAt,
+			itemModel.DoneAt,
+			itemModel.ServedAt,
 		)
-		if err != nil {
-			return nil, err
-		}
 
 		items = append(items, item)
 	}
 
-	ord, err := order.NewOrder(
-		shared.OrderID(model.ID),
-		order.TableNumber(model.TableNumber),
-		items,
-	)
-	if err != nil {
-		return nil, err
+	// Restore declared allergies
+	var allergies []product.Allergen
+	if model.DeclaredAllergies != "" {
+		if err := json.Unmarshal([]byte(model.DeclaredAllergies), &allergies); err != nil {
+			return nil, err
+		}
 	}
 
-	// Update status if not pending
-	if model.Status != "pending" {
-		ord.UpdateStatus(order.OrderStatus(model.Status))
+	// Orders placed before channels were recorded were dine-in
+	channel := order.Channel(model.Channel)
+	if channel == "" {
+		channel = order.ChannelDineIn
 	}
 
-	return ord, nil
+	// Reconstruct domain entity with all saved values
+	return order.ReconstructOrder(
+		shared.OrderID(model.ID),
+		order.TableNumber(model.TableNumber),
+		channel,
+		order.TicketNumber(model.TicketNumber),
+		order.BusinessDayID(model.BusinessDayID),
+		model.TerminalID,
+		items,
+		order.OrderStatus(model.Status),
+		shared.Money{Amount: model.Total, Currency: "USD"},
+		allergies,
+		order.PaymentMethod(model.PaymentMethod),
+		model.CustomerEmail,
+		model.CreatedAt,
+		model.UpdatedAt,
+	), nil
 }
 
 func (r *OrderRepository) toDomainList(models []OrderModel) ([]*order.Order, error) {
@@ -184,3 +412,25 @@ func (r *OrderRepository) toDomainList(models []OrderModel) ([]*order.Order, err
 
 	return orders, nil
 }
+
+func (r *OrderRepository) toAuditRecord(model *OrderAuditModel) (order.AuditRecord, error) {
+	var related []shared.OrderID
+	if model.RelatedOrders != "" {
+		if err := json.Unmarshal([]byte(model.RelatedOrders), &related); err != nil {
+			return order.AuditRecord{}, err
+		}
+	}
+
+	return order.AuditRecord{
+		OrderID:       shared.OrderID(model.OrderID),
+		Action:        order.AuditAction(model.Action),
+		RelatedOrders: related,
+		FromTable:     order.TableNumber(model.FromTable),
+		ToTable:       order.TableNumber(model.ToTable),
+		Amount:        shared.Money{Amount: model.Amount, Currency: "USD"},
+		SplitMode:     order.SplitMode(model.SplitMode),
+		StaffMember:   model.StaffMember,
+		Note:          model.Note,
+		At:            model.At,
+	}, nil
+}
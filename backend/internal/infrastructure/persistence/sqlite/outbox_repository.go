@@ -0,0 +1,87 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/event"
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type OutboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// FindUndispatched implements event.OutboxRepository
+func (r *OutboxRepository) FindUndispatched(maxAttempts, limit int) ([]event.Message, error) {
+	var models []OutboxModel
+
+	result := r.db.Where("dispatched_at IS NULL AND attempts < ?", maxAttempts).
+		Order("id asc").
+		Limit(limit).
+		Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	messages := make([]event.Message, 0, len(models))
+	for _, model := range models {
+		messages = append(messages, r.toDomain(&model))
+	}
+	return messages, nil
+}
+
+// MarkDispatched implements event.OutboxRepository
+func (r *OutboxRepository) MarkDispatched(id uint64) error {
+	return r.db.Model(&OutboxModel{}).Where("id = ?", id).Update("dispatched_at", time.Now()).Error
+}
+
+// RecordFailure implements event.OutboxRepository
+func (r *OutboxRepository) RecordFailure(id uint64, reason string) error {
+	return r.db.Model(&OutboxModel{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"attempts":   gorm.Expr("attempts + 1"),
+		"last_error": reason,
+	}).Error
+}
+
+// appendOutbox stores the events an aggregate recorded, inside the
+// transaction that saves the aggregate
+func appendOutbox(tx *gorm.DB, events []event.DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	models := make([]OutboxModel, 0, len(events))
+	for _, e := range events {
+		payload, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		models = append(models, OutboxModel{
+			Name:        e.EventName().String(),
+			AggregateID: e.AggregateID(),
+			Payload:     string(payload),
+			OccurredAt:  now,
+		})
+	}
+
+	return tx.Create(&models).Error
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *OutboxRepository) toDomain(model *OutboxModel) event.Message {
+	return event.Message{
+		ID:          model.ID,
+		Name:        event.Topic(model.Name),
+		AggregateID: model.AggregateID,
+		Payload:     json.RawMessage(model.Payload),
+		OccurredAt:  model.OccurredAt,
+		Attempts:    model.Attempts,
+	}
+}
@@ -0,0 +1,240 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/kitchen"
+	"POSFlowBackend/internal/domain/printing"
+	"POSFlowBackend/internal/domain/shared"
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type KitchenPrinterRepository struct {
+	db *gorm.DB
+}
+
+func NewKitchenPrinterRepository(db *gorm.DB) *KitchenPrinterRepository {
+	return &KitchenPrinterRepository{db: db}
+}
+
+// Save implements printing.KitchenPrinterRepository
+func (r *KitchenPrinterRepository) Save(printer *printing.KitchenPrinter) error {
+	model := r.toModel(printer)
+	return r.db.Save(&model).Error
+}
+
+// FindByID implements printing.KitchenPrinterRepository
+func (r *KitchenPrinterRepository) FindByID(id printing.PrinterID) (*printing.KitchenPrinter, error) {
+	var model KitchenPrinterModel
+
+	result := r.db.Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model)
+}
+
+// FindAll implements printing.KitchenPrinterRepository
+func (r *KitchenPrinterRepository) FindAll() ([]*printing.KitchenPrinter, error) {
+	var models []KitchenPrinterModel
+
+	result := r.db.Order("name asc").Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	printers := make([]*printing.KitchenPrinter, 0, len(models))
+	for _, model := range models {
+		printer, err := r.toDomain(&model)
+		if err != nil {
+			return nil, err
+		}
+		printers = append(printers, printer)
+	}
+	return printers, nil
+}
+
+// Delete implements printing.KitchenPrinterRepository; the printer's past
+// jobs are kept
+func (r *KitchenPrinterRepository) Delete(id printing.PrinterID) error {
+	result := r.db.Where("id = ?", id.String()).Delete(&KitchenPrinterModel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return shared.ErrNotFound
+	}
+	return nil
+}
+
+type PrintJobRepository struct {
+	db *gorm.DB
+}
+
+func NewPrintJobRepository(db *gorm.DB) *PrintJobRepository {
+	return &PrintJobRepository{db: db}
+}
+
+// Save implements printing.PrintJobRepository
+func (r *PrintJobRepository) Save(job *printing.PrintJob) error {
+	model, err := r.toModel(job)
+	if err != nil {
+		return err
+	}
+	return r.db.Save(&model).Error
+}
+
+// FindByID implements printing.PrintJobRepository
+func (r *PrintJobRepository) FindByID(id printing.PrintJobID) (*printing.PrintJob, error) {
+	var model PrintJobModel
+
+	result := r.db.Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model)
+}
+
+// FindByStatus implements printing.PrintJobRepository
+func (r *PrintJobRepository) FindByStatus(status printing.JobStatus, limit int) ([]*printing.PrintJob, error) {
+	var models []PrintJobModel
+
+	query := r.db.Model(&PrintJobModel{})
+	if status != "" {
+		query = query.Where("status = ?", string(status))
+	}
+
+	result := query.Order("created_at desc").Limit(limit).Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models)
+}
+
+// FindDue implements printing.PrintJobRepository
+func (r *PrintJobRepository) FindDue(now time.Time, limit int) ([]*printing.PrintJob, error) {
+	var models []PrintJobModel
+
+	result := r.db.Where("status = ? AND next_attempt_at <= ?", string(printing.JobPending), now).
+		Order("created_at asc").
+		Limit(limit).
+		Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models)
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+// documentJSON is how a laid out document is stored
+type documentJSON struct {
+	Paper printing.PaperWidth `json:"paper"`
+	Lines []printing.Line     `json:"lines"`
+	Cut   bool                `json:"cut"`
+}
+
+func (r *KitchenPrinterRepository) toModel(printer *printing.KitchenPrinter) KitchenPrinterModel {
+	stationsJSON, _ := json.Marshal(printer.Stations())
+
+	return KitchenPrinterModel{
+		ID:        printer.ID().String(),
+		Name:      printer.Name(),
+		Driver:    string(printer.Driver()),
+		Address:   printer.Address(),
+		Paper:     string(printer.Paper()),
+		Stations:  string(stationsJSON),
+		CreatedAt: printer.CreatedAt(),
+		UpdatedAt: printer.UpdatedAt(),
+	}
+}
+
+func (r *KitchenPrinterRepository) toDomain(model *KitchenPrinterModel) (*printing.KitchenPrinter, error) {
+	stations := []kitchen.StationID{}
+	if model.Stations != "" {
+		if err := json.Unmarshal([]byte(model.Stations), &stations); err != nil {
+			return nil, err
+		}
+	}
+
+	return printing.ReconstructKitchenPrinter(
+		printing.PrinterID(model.ID),
+		model.Name,
+		printing.Driver(model.Driver),
+		model.Address,
+		printing.PaperWidth(model.Paper),
+		stations,
+		model.CreatedAt,
+		model.UpdatedAt,
+	), nil
+}
+
+func (r *PrintJobRepository) toModel(job *printing.PrintJob) (PrintJobModel, error) {
+	doc := job.Document()
+	docJSON, err := json.Marshal(documentJSON{Paper: doc.Paper(), Lines: doc.Lines(), Cut: doc.Cut()})
+	if err != nil {
+		return PrintJobModel{}, err
+	}
+
+	return PrintJobModel{
+		ID:            job.ID().String(),
+		PrinterID:     job.PrinterID().String(),
+		OrderID:       job.OrderID().String(),
+		StationID:     job.StationID().String(),
+		Kind:          string(job.Kind()),
+		Document:      string(docJSON),
+		Status:        string(job.Status()),
+		Attempts:      job.Attempts(),
+		LastError:     job.LastError(),
+		NextAttemptAt: job.NextAttemptAt(),
+		PrintedAt:     job.PrintedAt(),
+		CreatedAt:     job.CreatedAt(),
+		UpdatedAt:     job.UpdatedAt(),
+	}, nil
+}
+
+func (r *PrintJobRepository) toDomain(model *PrintJobModel) (*printing.PrintJob, error) {
+	var doc documentJSON
+	if err := json.Unmarshal([]byte(model.Document), &doc); err != nil {
+		return nil, err
+	}
+
+	return printing.ReconstructPrintJob(
+		printing.PrintJobID(model.ID),
+		printing.PrinterID(model.PrinterID),
+		shared.OrderID(model.OrderID),
+		kitchen.StationID(model.StationID),
+		printing.JobKind(model.Kind),
+		printing.ReconstructDocument(doc.Paper, doc.Lines, doc.Cut),
+		printing.JobStatus(model.Status),
+		model.Attempts,
+		model.LastError,
+		model.NextAttemptAt,
+		model.PrintedAt,
+		model.CreatedAt,
+		model.UpdatedAt,
+	), nil
+}
+
+func (r *PrintJobRepository) toDomainList(models []PrintJobModel) ([]*printing.PrintJob, error) {
+	jobs := make([]*printing.PrintJob, 0, len(models))
+	for _, model := range models {
+		job, err := r.toDomain(&model)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
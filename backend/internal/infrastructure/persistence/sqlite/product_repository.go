@@ -3,6 +3,10 @@ package sqlite
 import (
 	"POSFlowBackend/internal/domain/product"
 	"POSFlowBackend/internal/domain/shared"
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode"
 
 	"gorm.io/gorm"
 )
@@ -19,15 +23,61 @@ func NewProductRepository(db *gorm.DB) *ProductRepository {
 func (r *ProductRepository) Save(prod *product.Product) error {
 	model := r.toModel(prod)
 
-	// Upsert: Update if exists, insert if not
-	return r.db.Save(&model).Error
+	// Keep the search index and the outbox in sync with the product row
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Delete existing lots; the remaining ones are saved with the product
+		if err := tx.Where("product_id = ?", model.ID).Delete(&ProductLotModel{}).Error; err != nil {
+			return err
+		}
+
+		// Upsert: Update if exists, insert if not (archived rows included)
+		if err := tx.Unscoped().Save(&model).Error; err != nil {
+			return err
+		}
+
+		// Record cost price changes
+		if err := recordCost(tx, &model); err != nil {
+			return err
+		}
+
+		// Store the recorded domain events
+		if err := appendOutbox(tx, prod.Events()); err != nil {
+			return err
+		}
+
+		// Archived products are not searchable
+		if model.DeletedAt.Valid {
+			return unindexProduct(tx, model.ID)
+		}
+		return indexProduct(tx, &model)
+	})
+	if err != nil {
+		return err
+	}
+
+	prod.ClearEvents()
+	return nil
+}
+
+// WithdrawStock implements product.ProductRepository
+func (r *ProductRepository) WithdrawStock(id shared.ProductID, quantity float64) error {
+	result := r.db.Model(&ProductModel{}).
+		Where("id = ? AND ROUND(stock - ?, 3) >= 0", id.String(), quantity).
+		Update("stock", gorm.Expr("ROUND(stock - ?, 3)", quantity))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return shared.ErrInsufficientStock
+	}
+	return nil
 }
 
 // FindByID implements product.ProductRepository
 func (r *ProductRepository) FindByID(id shared.ProductID) (*product.Product, error) {
 	var model ProductModel
 
-	result := r.db.Where("id = ?", id.String()).First(&model)
+	result := r.db.Preload("Lots").Where("id = ?", id.String()).First(&model)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, shared.ErrNotFound
@@ -38,11 +88,41 @@ func (r *ProductRepository) FindByID(id shared.ProductID) (*product.Product, err
 	return r.toDomain(&model)
 }
 
+// FindByIDIncludingArchived implements product.ProductRepository
+func (r *ProductRepository) FindByIDIncludingArchived(id shared.ProductID) (*product.Product, error) {
+	var model ProductModel
+
+	result := r.db.Unscoped().Preload("Lots").Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model)
+}
+
+// FindArchived implements product.ProductRepository
+func (r *ProductRepository) FindArchived() ([]*product.Product, error) {
+	var models []ProductModel
+
+	result := r.db.Unscoped().Preload("Lots").
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at desc").
+		Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models)
+}
+
 // FindAll implements product.ProductRepository
 func (r *ProductRepository) FindAll() ([]*product.Product, error) {
 	var models []ProductModel
 
-	result := r.db.Where("active = ?", true).Find(&models)
+	result := r.db.Preload("Lots").Where("active = ?", true).Find(&models)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -54,7 +134,7 @@ func (r *ProductRepository) FindAll() ([]*product.Product, error) {
 func (r *ProductRepository) FindByCategory(category product.Category) ([]*product.Product, error) {
 	var models []ProductModel
 
-	result := r.db.Where("category = ? AND active = ?", string(category), true).Find(&models)
+	result := r.db.Preload("Lots").Where("category = ? AND active = ?", string(category), true).Find(&models)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -67,7 +147,23 @@ func (r *ProductRepository) FindLowStock() ([]*product.Product, error) {
 	var models []ProductModel
 
 	// Products with stock <= 10
-	result := r.db.Where("stock <= ? AND active = ?", 10, true).Find(&models)
+	result := r.db.Preload("Lots").Where("stock <= ? AND active = ?", 10, true).Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models)
+}
+
+// FindWithLotsExpiringBefore implements product.ProductRepository
+func (r *ProductRepository) FindWithLotsExpiringBefore(t time.Time) ([]*product.Product, error) {
+	var models []ProductModel
+
+	result := r.db.Preload("Lots").
+		Where("active = ?", true).
+		Where("EXISTS (SELECT 1 FROM product_lots WHERE product_lots.product_id = products.id AND product_lots.expires_at < ?)", t).
+		Order("name asc").
+		Find(&models)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -75,24 +171,235 @@ func (r *ProductRepository) FindLowStock() ([]*product.Product, error) {
 	return r.toDomainList(models)
 }
 
-// Delete implements product.ProductRepository
+// Search implements product.ProductRepository
+func (r *ProductRepository) Search(filter product.ProductFilter) ([]*product.Product, int, error) {
+	var models []ProductModel
+
+	query := r.db.Model(&ProductModel{}).Where("products.active = ?", true)
+
+	// Full-text match against the FTS5 index, best matches first.
+	// bm25 weights favour name, then SKU, then description and tags.
+	match := buildMatchExpression(filter.Query)
+	if match != "" {
+		query = query.
+			Joins("JOIN "+productSearchTable+" ON "+productSearchTable+".product_id = products.id").
+			Where(productSearchTable+" MATCH ?", match)
+	}
+
+	if filter.Category != "" {
+		query = query.Where("products.category = ?", string(filter.Category))
+	}
+
+	// Tags are stored as JSON arrays, so match them with json_each
+	for _, tag := range filter.Tags {
+		query = query.Where(
+			"(EXISTS (SELECT 1 FROM json_each(products.allergens) WHERE value = ?) OR "+
+				"EXISTS (SELECT 1 FROM json_each(products.dietary_labels) WHERE value = ?))",
+			tag, tag,
+		)
+	}
+
+	for _, allergen := range filter.ExcludeAllergens {
+		query = query.Where(
+			"NOT EXISTS (SELECT 1 FROM json_each(products.allergens) WHERE value = ?)",
+			string(allergen),
+		)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if match != "" {
+		query = query.Order("bm25(" + productSearchTable + ", 0, 10.0, 1.0, 5.0, 2.0)")
+	}
+	query = query.Order("products.name asc")
+
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit).Offset(filter.Offset)
+	}
+
+	result := query.Preload("Lots").Select("products.*").Find(&models)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	products, err := r.toDomainList(models)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return products, int(total), nil
+}
+
+// Delete implements product.ProductRepository.
+// It soft-deletes the row, which archives the product.
 func (r *ProductRepository) Delete(id shared.ProductID) error {
-	return r.db.Delete(&ProductModel{}, "id = ?", id.String()).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&ProductModel{}).Where("id = ?", id.String()).Update("active", false).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&ProductModel{}, "id = ?", id.String()).Error; err != nil {
+			return err
+		}
+
+		return unindexProduct(tx, id.String())
+	})
+}
+
+// FindCostHistory implements product.ProductRepository
+func (r *ProductRepository) FindCostHistory(id shared.ProductID) ([]product.CostRecord, error) {
+	var models []ProductCostModel
+
+	result := r.db.Where("product_id = ?", id.String()).
+		Order("effective_from desc, id desc").
+		Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	history := []product.CostRecord{}
+	for _, model := range models {
+		history = append(history, product.CostRecord{
+			Cost:          shared.Money{Amount: model.Cost, Currency: "USD"},
+			EffectiveFrom: model.EffectiveFrom,
+		})
+	}
+
+	return history, nil
+}
+
+// Purge implements product.ProductRepository
+func (r *ProductRepository) Purge(id shared.ProductID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Delete(&ProductModel{}, "id = ?", id.String()).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&ProductCostModel{}, "product_id = ?", id.String()).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&ProductLotModel{}, "product_id = ?", id.String()).Error; err != nil {
+			return err
+		}
+
+		return unindexProduct(tx, id.String())
+	})
+}
+
+// recordCost appends a cost history entry when the cost price has changed
+func recordCost(tx *gorm.DB, model *ProductModel) error {
+	var last ProductCostModel
+
+	result := tx.Where("product_id = ?", model.ID).Order("effective_from desc, id desc").Limit(1).Find(&last)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	// Nothing to record for products that never had a cost
+	if result.RowsAffected == 0 && model.Cost == 0 {
+		return nil
+	}
+	if result.RowsAffected > 0 && last.Cost == model.Cost {
+		return nil
+	}
+
+	return tx.Create(&ProductCostModel{
+		ProductID:     model.ID,
+		Cost:          model.Cost,
+		EffectiveFrom: model.UpdatedAt,
+	}).Error
+}
+
+// --- Search index ---
+
+// indexProduct replaces the search index entry of a product
+func indexProduct(tx *gorm.DB, model *ProductModel) error {
+	if err := unindexProduct(tx, model.ID); err != nil {
+		return err
+	}
+
+	// Index tags as plain words, e.g. ["gluten","dairy"] -> "gluten dairy"
+	var tags []string
+	for _, raw := range []string{model.Allergens, model.DietaryLabels} {
+		var values []string
+		if raw != "" {
+			if err := json.Unmarshal([]byte(raw), &values); err != nil {
+				return err
+			}
+		}
+		tags = append(tags, values...)
+	}
+
+	return tx.Exec(
+		"INSERT INTO "+productSearchTable+" (product_id, name, description, sku, tags) VALUES (?, ?, ?, ?, ?)",
+		model.ID, model.Name, model.Description, model.SKU, strings.Join(tags, " "),
+	).Error
+}
+
+// unindexProduct removes a product from the search index
+func unindexProduct(tx *gorm.DB, id string) error {
+	return tx.Exec("DELETE FROM "+productSearchTable+" WHERE product_id = ?", id).Error
+}
+
+// buildMatchExpression turns user input into an FTS5 query where every
+// term must match by prefix, e.g. "chee burg" -> "chee"* "burg"*
+func buildMatchExpression(input string) string {
+	terms := strings.FieldsFunc(input, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var parts []string
+	for _, term := range terms {
+		parts = append(parts, `"`+term+`"*`)
+	}
+
+	return strings.Join(parts, " ")
 }
 
 // --- Mappers: Domain Entity ↔ Database Model ---
 
 func (r *ProductRepository) toModel(prod *product.Product) ProductModel {
+	// Convert tags to JSON
+	allergensJSON, _ := json.Marshal(prod.Allergens())
+	dietaryLabelsJSON, _ := json.Marshal(prod.DietaryLabels())
+
+	var lots []ProductLotModel
+	for _, lot := range prod.Lots() {
+		lots = append(lots, ProductLotModel{
+			ID:         lot.ID.String(),
+			ProductID:  prod.ID().String(),
+			Code:       lot.Code,
+			Quantity:   lot.Quantity,
+			ExpiresAt:  lot.ExpiresAt,
+			ReceivedAt: lot.ReceivedAt,
+		})
+	}
+
 	return ProductModel{
-		ID:          prod.ID().String(),
-		Name:        prod.Name(),
-		Description: prod.Description(),
-		Price:       prod.Price().Amount,
-		Category:    string(prod.Category()),
-		Stock:       prod.Stock(),
-		Active:      prod.IsActive(),
-		CreatedAt:   prod.CreatedAt(),
-		UpdatedAt:   prod.UpdatedAt(),
+		ID:               prod.ID().String(),
+		Name:             prod.Name(),
+		Description:      prod.Description(),
+		SKU:              prod.SKU(),
+		Price:            prod.Price().Amount,
+		Cost:             prod.Cost().Amount,
+		Category:         string(prod.Category()),
+		Stock:            prod.Stock(),
+		Unit:             string(prod.Unit()),
+		PurchaseUnit:     string(prod.PurchaseUnit()),
+		UnitsPerPurchase: prod.UnitsPerPurchase(),
+		Weighed:          prod.IsWeighed(),
+		Allergens:        string(allergensJSON),
+		DietaryLabels:    string(dietaryLabelsJSON),
+		Lots:             lots,
+		Active:           prod.IsActive(),
+		Available:        prod.IsAvailable(),
+		CreatedAt:        prod.CreatedAt(),
+		UpdatedAt:        prod.UpdatedAt(),
+		DeletedAt:        toDeletedAt(prod.ArchivedAt()),
 	}
 }
 
@@ -102,28 +409,85 @@ func (r *ProductRepository) toDomain(model *ProductModel) (*product.Product, err
 		return nil, err
 	}
 
-	prod, err := product.NewProduct(
-		shared.ProductID(model.ID),
-		model.Name,
-		*price,
-		product.Category(model.Category),
-		model.Stock,
-	)
+	cost, err := shared.NewMoney(model.Cost)
 	if err != nil {
 		return nil, err
 	}
 
-	// Set description if exists
-	if model.Description != "" {
-		prod.UpdateInfo(model.Name, model.Description, product.Category(model.Category))
+	category := product.Category(model.Category)
+	if !category.IsValid() {
+		return nil, shared.ErrInvalidInput
+	}
+
+	// Rows saved before units existed are counted in pieces
+	unit := shared.UnitOfMeasure(model.Unit)
+	if unit == "" {
+		unit = shared.UnitPiece
+	}
+	purchaseUnit := shared.UnitOfMeasure(model.PurchaseUnit)
+	if purchaseUnit == "" {
+		purchaseUnit = unit
+	}
+	unitsPerPurchase := model.UnitsPerPurchase
+	if unitsPerPurchase <= 0 {
+		unitsPerPurchase = 1
+	}
+
+	// Parse tags from JSON
+	allergens := []product.Allergen{}
+	if model.Allergens != "" {
+		if err := json.Unmarshal([]byte(model.Allergens), &allergens); err != nil {
+			return nil, err
+		}
+	}
+	dietaryLabels := []product.DietaryLabel{}
+	if model.DietaryLabels != "" {
+		if err := json.Unmarshal([]byte(model.DietaryLabels), &dietaryLabels); err != nil {
+			return nil, err
+		}
 	}
 
-	// Set active status
-	if !model.Active {
-		prod.Deactivate()
+	// Restore lots
+	var lots []product.Lot
+	for _, lotModel := range model.Lots {
+		lots = append(lots, product.Lot{
+			ID:         product.LotID(lotModel.ID),
+			Code:       lotModel.Code,
+			Quantity:   lotModel.Quantity,
+			ExpiresAt:  lotModel.ExpiresAt,
+			ReceivedAt: lotModel.ReceivedAt,
+		})
 	}
 
-	return prod, nil
+	// Archived products are soft-deleted rows
+	var archivedAt *time.Time
+	if model.DeletedAt.Valid {
+		archivedAt = &model.DeletedAt.Time
+	}
+
+	// Reconstruct domain entity with all saved values
+	return product.ReconstructProduct(
+		shared.ProductID(model.ID),
+		model.Name,
+		model.Description,
+		model.SKU,
+		*price,
+		*cost,
+		category,
+		model.Stock,
+		unit,
+		purchaseUnit,
+		unitsPerPurchase,
+		model.Weighed,
+		lots,
+		allergens,
+		dietaryLabels,
+		model.Active,
+		model.Available,
+		archivedAt,
+		model.CreatedAt,
+		model.UpdatedAt,
+	), nil
 }
 
 func (r *ProductRepository) toDomainList(models []ProductModel) ([]*product.Product, error) {
@@ -139,3 +503,10 @@ func (r *ProductRepository) toDomainList(models []ProductModel) ([]*product.Prod
 
 	return products, nil
 }
+
+func toDeletedAt(t *time.Time) gorm.DeletedAt {
+	if t == nil {
+		return gorm.DeletedAt{}
+	}
+	return gorm.DeletedAt{Time: *t, Valid: true}
+}
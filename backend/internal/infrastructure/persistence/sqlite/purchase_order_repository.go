@@ -0,0 +1,191 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/purchasing"
+	"POSFlowBackend/internal/domain/shared"
+
+	"gorm.io/gorm"
+)
+
+type PurchaseOrderRepository struct {
+	db *gorm.DB
+}
+
+func NewPurchaseOrderRepository(db *gorm.DB) *PurchaseOrderRepository {
+	return &PurchaseOrderRepository{db: db}
+}
+
+// Save implements purchasing.PurchaseOrderRepository
+func (r *PurchaseOrderRepository) Save(po *purchasing.PurchaseOrder) error {
+	model := r.toModel(po)
+
+	// Use transaction to ensure all lines are saved
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// Delete existing lines if updating
+		if err := tx.Where("purchase_order_id = ?", model.ID).Delete(&PurchaseOrderLineModel{}).Error; err != nil {
+			return err
+		}
+
+		// Save purchase order with lines
+		return tx.Save(&model).Error
+	})
+}
+
+// FindByID implements purchasing.PurchaseOrderRepository
+func (r *PurchaseOrderRepository) FindByID(id purchasing.PurchaseOrderID) (*purchasing.PurchaseOrder, error) {
+	var model PurchaseOrderModel
+
+	result := r.db.Preload("Lines").Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model), nil
+}
+
+// FindAll implements purchasing.PurchaseOrderRepository
+func (r *PurchaseOrderRepository) FindAll() ([]*purchasing.PurchaseOrder, error) {
+	var models []PurchaseOrderModel
+
+	result := r.db.Preload("Lines").Order("created_at desc").Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// FindBySupplier implements purchasing.PurchaseOrderRepository
+func (r *PurchaseOrderRepository) FindBySupplier(supplierID purchasing.SupplierID) ([]*purchasing.PurchaseOrder, error) {
+	var models []PurchaseOrderModel
+
+	result := r.db.Preload("Lines").
+		Where("supplier_id = ?", supplierID.String()).
+		Order("created_at desc").
+		Find(&models)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// FindByStatus implements purchasing.PurchaseOrderRepository
+func (r *PurchaseOrderRepository) FindByStatus(status purchasing.PurchaseOrderStatus) ([]*purchasing.PurchaseOrder, error) {
+	var models []PurchaseOrderModel
+
+	result := r.db.Preload("Lines").
+		Where("status = ?", string(status)).
+		Order("created_at desc").
+		Find(&models)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// FindOpen implements purchasing.PurchaseOrderRepository
+func (r *PurchaseOrderRepository) FindOpen() ([]*purchasing.PurchaseOrder, error) {
+	var models []PurchaseOrderModel
+
+	result := r.db.Preload("Lines").
+		Where("status IN ?", []string{
+			string(purchasing.StatusDraft),
+			string(purchasing.StatusSent),
+			string(purchasing.StatusPartiallyReceived),
+		}).
+		Order("expected_date IS NULL, expected_date asc, created_at asc").
+		Find(&models)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// ExistsByProduct implements purchasing.PurchaseOrderRepository
+func (r *PurchaseOrderRepository) ExistsByProduct(productID shared.ProductID) (bool, error) {
+	var count int64
+
+	result := r.db.Model(&PurchaseOrderLineModel{}).
+		Where("product_id = ?", productID.String()).
+		Count(&count)
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return count > 0, nil
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *PurchaseOrderRepository) toModel(po *purchasing.PurchaseOrder) PurchaseOrderModel {
+	var lines []PurchaseOrderLineModel
+
+	for _, line := range po.Lines() {
+		lines = append(lines, PurchaseOrderLineModel{
+			PurchaseOrderID:  po.ID().String(),
+			ProductID:        line.ProductID().String(),
+			QuantityOrdered:  line.QuantityOrdered(),
+			QuantityReceived: line.QuantityReceived(),
+			UnitCost:         line.UnitCost().Amount,
+		})
+	}
+
+	return PurchaseOrderModel{
+		ID:           po.ID().String(),
+		SupplierID:   po.SupplierID().String(),
+		Status:       string(po.Status()),
+		ExpectedDate: po.ExpectedDate(),
+		Notes:        po.Notes(),
+		Lines:        lines,
+		SentAt:       po.SentAt(),
+		ReceivedAt:   po.ReceivedAt(),
+		CreatedAt:    po.CreatedAt(),
+		UpdatedAt:    po.UpdatedAt(),
+	}
+}
+
+func (r *PurchaseOrderRepository) toDomain(model *PurchaseOrderModel) *purchasing.PurchaseOrder {
+	var lines []*purchasing.PurchaseOrderLine
+
+	for _, lineModel := range model.Lines {
+		lines = append(lines, purchasing.ReconstructPurchaseOrderLine(
+			shared.ProductID(lineModel.ProductID),
+			lineModel.QuantityOrdered,
+			lineModel.QuantityReceived,
+			shared.Money{Amount: lineModel.UnitCost, Currency: "USD"},
+		))
+	}
+
+	return purchasing.ReconstructPurchaseOrder(
+		purchasing.PurchaseOrderID(model.ID),
+		purchasing.SupplierID(model.SupplierID),
+		lines,
+		purchasing.PurchaseOrderStatus(model.Status),
+		model.ExpectedDate,
+		model.Notes,
+		model.SentAt,
+		model.ReceivedAt,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+}
+
+func (r *PurchaseOrderRepository) toDomainList(models []PurchaseOrderModel) []*purchasing.PurchaseOrder {
+	var orders []*purchasing.PurchaseOrder
+
+	for _, model := range models {
+		orders = append(orders, r.toDomain(&model))
+	}
+
+	return orders
+}
@@ -0,0 +1,105 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/ingredient"
+	"POSFlowBackend/internal/domain/shared"
+
+	"gorm.io/gorm"
+)
+
+type RecipeRepository struct {
+	db *gorm.DB
+}
+
+func NewRecipeRepository(db *gorm.DB) *RecipeRepository {
+	return &RecipeRepository{db: db}
+}
+
+// Save implements ingredient.RecipeRepository
+func (r *RecipeRepository) Save(recipe *ingredient.Recipe) error {
+	models := r.toModels(recipe)
+
+	// Replace all lines of the recipe in one transaction
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("product_id = ?", recipe.ProductID().String()).Delete(&RecipeLineModel{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&models).Error
+	})
+}
+
+// FindByProduct implements ingredient.RecipeRepository
+func (r *RecipeRepository) FindByProduct(productID shared.ProductID) (*ingredient.Recipe, error) {
+	var models []RecipeLineModel
+
+	result := r.db.Where("product_id = ?", productID.String()).Order("id asc").Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if len(models) == 0 {
+		return nil, shared.ErrNotFound
+	}
+
+	return r.toDomain(productID, models), nil
+}
+
+// FindByIngredient implements ingredient.RecipeRepository
+func (r *RecipeRepository) FindByIngredient(ingredientID ingredient.IngredientID) ([]*ingredient.Recipe, error) {
+	var productIDs []string
+
+	result := r.db.Model(&RecipeLineModel{}).
+		Where("ingredient_id = ?", ingredientID.String()).
+		Distinct().
+		Pluck("product_id", &productIDs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	var recipes []*ingredient.Recipe
+	for _, productID := range productIDs {
+		recipe, err := r.FindByProduct(shared.ProductID(productID))
+		if err != nil {
+			return nil, err
+		}
+		recipes = append(recipes, recipe)
+	}
+
+	return recipes, nil
+}
+
+// Delete implements ingredient.RecipeRepository
+func (r *RecipeRepository) Delete(productID shared.ProductID) error {
+	return r.db.Where("product_id = ?", productID.String()).Delete(&RecipeLineModel{}).Error
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *RecipeRepository) toModels(recipe *ingredient.Recipe) []RecipeLineModel {
+	var models []RecipeLineModel
+
+	for _, line := range recipe.Lines() {
+		models = append(models, RecipeLineModel{
+			ProductID:    recipe.ProductID().String(),
+			IngredientID: line.IngredientID.String(),
+			Quantity:     line.Quantity,
+			UpdatedAt:    recipe.UpdatedAt(),
+		})
+	}
+
+	return models
+}
+
+func (r *RecipeRepository) toDomain(productID shared.ProductID, models []RecipeLineModel) *ingredient.Recipe {
+	var lines []ingredient.RecipeLine
+
+	for _, model := range models {
+		lines = append(lines, ingredient.RecipeLine{
+			IngredientID: ingredient.IngredientID(model.IngredientID),
+			Quantity:     model.Quantity,
+		})
+	}
+
+	return ingredient.ReconstructRecipe(productID, lines, models[0].UpdatedAt)
+}
@@ -20,7 +20,20 @@ func NewSalesRepository(db *gorm.DB) *SalesRepository {
 // Save implements sales.SalesRepository
 func (r *SalesRepository) Save(s *sales.DailySales) error {
 	model := r.toModel(s)
-	return r.db.Save(&model).Error
+
+	// Save the day and its recorded events together
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&model).Error; err != nil {
+			return err
+		}
+		return appendOutbox(tx, s.Events())
+	})
+	if err != nil {
+		return err
+	}
+
+	s.ClearEvents()
+	return nil
 }
 
 // FindByID implements sales.SalesRepository
@@ -110,6 +123,7 @@ func (r *SalesRepository) toModel(s *sales.DailySales) SalesModel {
 		ID:          s.ID().String(),
 		Date:        s.Date(),
 		TotalSales:  s.TotalSales().Amount,
+		TotalCost:   s.TotalCost().Amount,
 		TotalOrders: s.TotalOrders(),
 		OrderIDs:    string(orderIDsJSON),
 		Closed:      s.IsClosed(),
@@ -133,6 +147,7 @@ func (r *SalesRepository) toDomain(model *SalesModel) (*sales.DailySales, error)
 		sales.SalesID(model.ID),
 		model.Date,
 		shared.Money{Amount: model.TotalSales, Currency: "USD"},
+		shared.Money{Amount: model.TotalCost, Currency: "USD"},
 		model.TotalOrders,
 		orderIDs,
 		model.Closed,
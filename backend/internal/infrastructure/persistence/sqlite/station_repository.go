@@ -0,0 +1,106 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/kitchen"
+	"POSFlowBackend/internal/domain/product"
+	"POSFlowBackend/internal/domain/shared"
+	"encoding/json"
+
+	"gorm.io/gorm"
+)
+
+type StationRepository struct {
+	db *gorm.DB
+}
+
+func NewStationRepository(db *gorm.DB) *StationRepository {
+	return &StationRepository{db: db}
+}
+
+// Save implements kitchen.StationRepository
+func (r *StationRepository) Save(station *kitchen.Station) error {
+	model := r.toModel(station)
+	return r.db.Save(&model).Error
+}
+
+// FindByID implements kitchen.StationRepository
+func (r *StationRepository) FindByID(id kitchen.StationID) (*kitchen.Station, error) {
+	var model StationModel
+
+	result := r.db.Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model)
+}
+
+// FindAll implements kitchen.StationRepository
+func (r *StationRepository) FindAll() ([]*kitchen.Station, error) {
+	var models []StationModel
+
+	result := r.db.Order("created_at asc").Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models)
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *StationRepository) toModel(station *kitchen.Station) StationModel {
+	productsJSON, _ := json.Marshal(station.Products())
+	categoriesJSON, _ := json.Marshal(station.Categories())
+
+	return StationModel{
+		ID:         station.ID().String(),
+		Name:       station.Name(),
+		Products:   string(productsJSON),
+		Categories: string(categoriesJSON),
+		CreatedAt:  station.CreatedAt(),
+		UpdatedAt:  station.UpdatedAt(),
+	}
+}
+
+func (r *StationRepository) toDomain(model *StationModel) (*kitchen.Station, error) {
+	products := []shared.ProductID{}
+	if model.Products != "" {
+		if err := json.Unmarshal([]byte(model.Products), &products); err != nil {
+			return nil, err
+		}
+	}
+
+	categories := []product.Category{}
+	if model.Categories != "" {
+		if err := json.Unmarshal([]byte(model.Categories), &categories); err != nil {
+			return nil, err
+		}
+	}
+
+	return kitchen.ReconstructStation(
+		kitchen.StationID(model.ID),
+		model.Name,
+		products,
+		categories,
+		model.CreatedAt,
+		model.UpdatedAt,
+	), nil
+}
+
+func (r *StationRepository) toDomainList(models []StationModel) ([]*kitchen.Station, error) {
+	var stations []*kitchen.Station
+
+	for _, model := range models {
+		station, err := r.toDomain(&model)
+		if err != nil {
+			return nil, err
+		}
+		stations = append(stations, station)
+	}
+
+	return stations, nil
+}
@@ -0,0 +1,95 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/shared"
+
+	"gorm.io/gorm"
+)
+
+type StockLevelRepository struct {
+	db *gorm.DB
+}
+
+func NewStockLevelRepository(db *gorm.DB) *StockLevelRepository {
+	return &StockLevelRepository{db: db}
+}
+
+// Save implements location.StockLevelRepository
+func (r *StockLevelRepository) Save(level *location.StockLevel) error {
+	model := r.toModel(level)
+	return r.db.Save(&model).Error
+}
+
+// Find implements location.StockLevelRepository
+func (r *StockLevelRepository) Find(locationID location.LocationID, productID shared.ProductID) (*location.StockLevel, error) {
+	var model StockLevelModel
+
+	result := r.db.
+		Where("location_id = ? AND product_id = ?", locationID.String(), productID.String()).
+		First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model), nil
+}
+
+// FindByLocation implements location.StockLevelRepository
+func (r *StockLevelRepository) FindByLocation(locationID location.LocationID) ([]*location.StockLevel, error) {
+	var models []StockLevelModel
+
+	result := r.db.Where("location_id = ?", locationID.String()).Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// FindByProduct implements location.StockLevelRepository
+func (r *StockLevelRepository) FindByProduct(productID shared.ProductID) ([]*location.StockLevel, error) {
+	var models []StockLevelModel
+
+	result := r.db.Where("product_id = ?", productID.String()).Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *StockLevelRepository) toModel(level *location.StockLevel) StockLevelModel {
+	return StockLevelModel{
+		LocationID:    level.LocationID().String(),
+		ProductID:     level.ProductID().String(),
+		Quantity:      level.Quantity(),
+		LowStockLevel: level.LowStockLevel(),
+		UpdatedAt:     level.UpdatedAt(),
+	}
+}
+
+func (r *StockLevelRepository) toDomain(model *StockLevelModel) *location.StockLevel {
+	return location.ReconstructStockLevel(
+		location.LocationID(model.LocationID),
+		shared.ProductID(model.ProductID),
+		model.Quantity,
+		model.LowStockLevel,
+		model.UpdatedAt,
+	)
+}
+
+func (r *StockLevelRepository) toDomainList(models []StockLevelModel) []*location.StockLevel {
+	var levels []*location.StockLevel
+
+	for _, model := range models {
+		levels = append(levels, r.toDomain(&model))
+	}
+
+	return levels
+}
@@ -0,0 +1,171 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/stocktake"
+
+	"gorm.io/gorm"
+)
+
+type StocktakeRepository struct {
+	db *gorm.DB
+}
+
+func NewStocktakeRepository(db *gorm.DB) *StocktakeRepository {
+	return &StocktakeRepository{db: db}
+}
+
+// Save implements stocktake.StocktakeRepository
+func (r *StocktakeRepository) Save(st *stocktake.Stocktake) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return r.save(tx, st)
+	})
+}
+
+// FindByID implements stocktake.StocktakeRepository
+func (r *StocktakeRepository) FindByID(id stocktake.StocktakeID) (*stocktake.Stocktake, error) {
+	var model StocktakeModel
+
+	result := r.db.Preload("Counts").Preload("Lines").Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model), nil
+}
+
+// FindAll implements stocktake.StocktakeRepository
+func (r *StocktakeRepository) FindAll() ([]*stocktake.Stocktake, error) {
+	var models []StocktakeModel
+
+	result := r.db.Preload("Counts").Preload("Lines").Order("opened_at desc").Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// FindOpen implements stocktake.StocktakeRepository
+func (r *StocktakeRepository) FindOpen() (*stocktake.Stocktake, error) {
+	var model StocktakeModel
+
+	result := r.db.Preload("Counts").Preload("Lines").
+		Where("status = ?", string(stocktake.StatusOpen)).
+		First(&model)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model), nil
+}
+
+func (r *StocktakeRepository) save(tx *gorm.DB, st *stocktake.Stocktake) error {
+	model := r.toModel(st)
+
+	// Delete existing counts and lines if updating
+	if err := tx.Where("stocktake_id = ?", model.ID).Delete(&StocktakeCountModel{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("stocktake_id = ?", model.ID).Delete(&StocktakeLineModel{}).Error; err != nil {
+		return err
+	}
+
+	// Save stocktake with counts and lines
+	return tx.Save(&model).Error
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *StocktakeRepository) toModel(st *stocktake.Stocktake) StocktakeModel {
+	var counts []StocktakeCountModel
+	for _, count := range st.Counts() {
+		counts = append(counts, StocktakeCountModel{
+			StocktakeID: st.ID().String(),
+			ProductID:   count.ProductID.String(),
+			TerminalID:  count.TerminalID,
+			Counted:     count.Counted,
+			Expected:    count.Expected,
+			CountedAt:   count.CountedAt,
+		})
+	}
+
+	// Variance lines are only recorded once finalized
+	var lines []StocktakeLineModel
+	if st.IsFinalized() {
+		for _, line := range st.VarianceLines(nil) {
+			lines = append(lines, StocktakeLineModel{
+				StocktakeID: st.ID().String(),
+				ProductID:   line.ProductID.String(),
+				Expected:    line.Expected,
+				Counted:     line.Counted,
+				UnitCost:    line.UnitCost.Amount,
+			})
+		}
+	}
+
+	return StocktakeModel{
+		ID:          st.ID().String(),
+		Name:        st.Name(),
+		LocationID:  st.LocationID().String(),
+		Status:      string(st.Status()),
+		Counts:      counts,
+		Lines:       lines,
+		OpenedAt:    st.OpenedAt(),
+		FinalizedAt: st.FinalizedAt(),
+		UpdatedAt:   st.UpdatedAt(),
+	}
+}
+
+func (r *StocktakeRepository) toDomain(model *StocktakeModel) *stocktake.Stocktake {
+	var counts []stocktake.Count
+	for _, countModel := range model.Counts {
+		counts = append(counts, stocktake.Count{
+			ProductID:  shared.ProductID(countModel.ProductID),
+			TerminalID: countModel.TerminalID,
+			Counted:    countModel.Counted,
+			Expected:   countModel.Expected,
+			CountedAt:  countModel.CountedAt,
+		})
+	}
+
+	var lines []stocktake.VarianceLine
+	for _, lineModel := range model.Lines {
+		lines = append(lines, stocktake.VarianceLine{
+			ProductID: shared.ProductID(lineModel.ProductID),
+			Expected:  lineModel.Expected,
+			Counted:   lineModel.Counted,
+			UnitCost:  shared.Money{Amount: lineModel.UnitCost, Currency: "USD"},
+		})
+	}
+
+	return stocktake.ReconstructStocktake(
+		stocktake.StocktakeID(model.ID),
+		model.Name,
+		location.LocationID(model.LocationID),
+		stocktake.StocktakeStatus(model.Status),
+		counts,
+		lines,
+		model.OpenedAt,
+		model.FinalizedAt,
+		model.UpdatedAt,
+	)
+}
+
+func (r *StocktakeRepository) toDomainList(models []StocktakeModel) []*stocktake.Stocktake {
+	var stocktakes []*stocktake.Stocktake
+
+	for _, model := range models {
+		stocktakes = append(stocktakes, r.toDomain(&model))
+	}
+
+	return stocktakes
+}
@@ -0,0 +1,89 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/purchasing"
+	"POSFlowBackend/internal/domain/shared"
+
+	"gorm.io/gorm"
+)
+
+type SupplierRepository struct {
+	db *gorm.DB
+}
+
+func NewSupplierRepository(db *gorm.DB) *SupplierRepository {
+	return &SupplierRepository{db: db}
+}
+
+// Save implements purchasing.SupplierRepository
+func (r *SupplierRepository) Save(supplier *purchasing.Supplier) error {
+	model := r.toModel(supplier)
+
+	// Upsert: Update if exists, insert if not
+	return r.db.Save(&model).Error
+}
+
+// FindByID implements purchasing.SupplierRepository
+func (r *SupplierRepository) FindByID(id purchasing.SupplierID) (*purchasing.Supplier, error) {
+	var model SupplierModel
+
+	result := r.db.Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model), nil
+}
+
+// FindAll implements purchasing.SupplierRepository
+func (r *SupplierRepository) FindAll() ([]*purchasing.Supplier, error) {
+	var models []SupplierModel
+
+	result := r.db.Order("name asc").Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *SupplierRepository) toModel(supplier *purchasing.Supplier) SupplierModel {
+	return SupplierModel{
+		ID:          supplier.ID().String(),
+		Name:        supplier.Name(),
+		ContactName: supplier.ContactName(),
+		Email:       supplier.Email(),
+		Phone:       supplier.Phone(),
+		Notes:       supplier.Notes(),
+		CreatedAt:   supplier.CreatedAt(),
+		UpdatedAt:   supplier.UpdatedAt(),
+	}
+}
+
+func (r *SupplierRepository) toDomain(model *SupplierModel) *purchasing.Supplier {
+	return purchasing.ReconstructSupplier(
+		purchasing.SupplierID(model.ID),
+		model.Name,
+		model.ContactName,
+		model.Email,
+		model.Phone,
+		model.Notes,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+}
+
+func (r *SupplierRepository) toDomainList(models []SupplierModel) []*purchasing.Supplier {
+	var suppliers []*purchasing.Supplier
+
+	for _, model := range models {
+		suppliers = append(suppliers, r.toDomain(&model))
+	}
+
+	return suppliers
+}
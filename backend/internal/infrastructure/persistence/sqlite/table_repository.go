@@ -0,0 +1,113 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/table"
+
+	"gorm.io/gorm"
+)
+
+type TableRepository struct {
+	db *gorm.DB
+}
+
+func NewTableRepository(db *gorm.DB) *TableRepository {
+	return &TableRepository{db: db}
+}
+
+// Save implements table.TableRepository
+func (r *TableRepository) Save(t *table.Table) error {
+	model := r.toModel(t)
+	return r.db.Save(&model).Error
+}
+
+// FindByID implements table.TableRepository
+func (r *TableRepository) FindByID(id table.TableID) (*table.Table, error) {
+	var model TableModel
+
+	result := r.db.Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model), nil
+}
+
+// FindByNumber implements table.TableRepository
+func (r *TableRepository) FindByNumber(number string) (*table.Table, error) {
+	var model TableModel
+
+	result := r.db.Where("number = ?", number).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model), nil
+}
+
+// FindAll implements table.TableRepository
+func (r *TableRepository) FindAll() ([]*table.Table, error) {
+	var models []TableModel
+
+	result := r.db.Order("area asc, number asc").Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// Delete implements table.TableRepository; orders keep the table number
+func (r *TableRepository) Delete(id table.TableID) error {
+	result := r.db.Where("id = ?", id.String()).Delete(&TableModel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return shared.ErrNotFound
+	}
+	return nil
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *TableRepository) toModel(t *table.Table) TableModel {
+	return TableModel{
+		ID:              t.ID().String(),
+		Number:          t.Number(),
+		Area:            t.Area(),
+		Capacity:        t.Capacity(),
+		X:               t.Position().X,
+		Y:               t.Position().Y,
+		BillRequestedAt: t.BillRequestedAt(),
+		CreatedAt:       t.CreatedAt(),
+		UpdatedAt:       t.UpdatedAt(),
+	}
+}
+
+func (r *TableRepository) toDomain(model *TableModel) *table.Table {
+	return table.ReconstructTable(
+		table.TableID(model.ID),
+		model.Number,
+		model.Area,
+		model.Capacity,
+		table.Position{X: model.X, Y: model.Y},
+		model.BillRequestedAt,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+}
+
+func (r *TableRepository) toDomainList(models []TableModel) []*table.Table {
+	tables := make([]*table.Table, 0, len(models))
+	for _, model := range models {
+		tables = append(tables, r.toDomain(&model))
+	}
+	return tables
+}
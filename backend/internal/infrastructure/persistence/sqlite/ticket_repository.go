@@ -0,0 +1,121 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/shared"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TicketRepository struct {
+	db *gorm.DB
+}
+
+func NewTicketRepository(db *gorm.DB) *TicketRepository {
+	return &TicketRepository{db: db}
+}
+
+// NextNumber implements order.TicketRepository
+func (r *TicketRepository) NextNumber(sequence string, at time.Time) (order.BusinessDayID, int, error) {
+	var day BusinessDayModel
+	var counter TicketCounterModel
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Open a business day unless one is open; the unique open flag
+		// keeps a concurrent caller from opening a second one
+		result := tx.Where("is_open = ?", true).Limit(1).Find(&day)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			open := true
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&BusinessDayModel{IsOpen: &open, OpenedAt: at}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("is_open = ?", true).First(&day).Error; err != nil {
+				return err
+			}
+		}
+
+		// Increment the sequence in a single statement
+		counter = TicketCounterModel{BusinessDayID: day.ID, Sequence: sequence, LastNumber: 1}
+		return tx.Clauses(
+			clause.OnConflict{
+				Columns:   []clause.Column{{Name: "business_day_id"}, {Name: "sequence"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{"last_number": gorm.Expr("ticket_counters.last_number + 1")}),
+			},
+			clause.Returning{Columns: []clause.Column{{Name: "last_number"}}},
+		).Create(&counter).Error
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return order.BusinessDayID(day.ID), counter.LastNumber, nil
+}
+
+// CurrentDay implements order.TicketRepository
+func (r *TicketRepository) CurrentDay() (*order.BusinessDay, error) {
+	var model BusinessDayModel
+
+	result := r.db.Where("is_open = ?", true).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model), nil
+}
+
+// FindDay implements order.TicketRepository
+func (r *TicketRepository) FindDay(id order.BusinessDayID) (*order.BusinessDay, error) {
+	var model BusinessDayModel
+
+	result := r.db.Where("id = ?", uint64(id)).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model), nil
+}
+
+// CloseDay implements order.TicketRepository
+func (r *TicketRepository) CloseDay(at time.Time) (*order.BusinessDay, error) {
+	var model BusinessDayModel
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("is_open = ?", true).First(&model).Error; err != nil {
+			return err
+		}
+
+		model.IsOpen = nil
+		model.ClosedAt = &at
+		return tx.Save(&model).Error
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.toDomain(&model), nil
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *TicketRepository) toDomain(model *BusinessDayModel) *order.BusinessDay {
+	return order.ReconstructBusinessDay(
+		order.BusinessDayID(model.ID),
+		model.OpenedAt,
+		model.ClosedAt,
+	)
+}
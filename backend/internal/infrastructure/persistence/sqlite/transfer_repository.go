@@ -0,0 +1,130 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/shared"
+
+	"gorm.io/gorm"
+)
+
+type TransferRepository struct {
+	db *gorm.DB
+}
+
+func NewTransferRepository(db *gorm.DB) *TransferRepository {
+	return &TransferRepository{db: db}
+}
+
+// Save implements location.TransferRepository
+func (r *TransferRepository) Save(transfer *location.Transfer) error {
+	model := r.toModel(transfer)
+
+	// Use transaction to ensure all lines are saved
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// Delete existing lines if updating
+		if err := tx.Where("transfer_id = ?", model.ID).Delete(&TransferLineModel{}).Error; err != nil {
+			return err
+		}
+
+		// Save transfer with lines
+		return tx.Save(&model).Error
+	})
+}
+
+// FindByID implements location.TransferRepository
+func (r *TransferRepository) FindByID(id location.TransferID) (*location.Transfer, error) {
+	var model TransferModel
+
+	result := r.db.Preload("Lines").Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model), nil
+}
+
+// FindAll implements location.TransferRepository
+func (r *TransferRepository) FindAll() ([]*location.Transfer, error) {
+	var models []TransferModel
+
+	result := r.db.Preload("Lines").Order("created_at desc").Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// FindByLocation implements location.TransferRepository
+func (r *TransferRepository) FindByLocation(locationID location.LocationID) ([]*location.Transfer, error) {
+	var models []TransferModel
+
+	result := r.db.Preload("Lines").
+		Where("from_location_id = ? OR to_location_id = ?", locationID.String(), locationID.String()).
+		Order("created_at desc").
+		Find(&models)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *TransferRepository) toModel(transfer *location.Transfer) TransferModel {
+	var lines []TransferLineModel
+
+	for _, line := range transfer.Lines() {
+		lines = append(lines, TransferLineModel{
+			TransferID: transfer.ID().String(),
+			ProductID:  line.ProductID.String(),
+			Quantity:   line.Quantity,
+		})
+	}
+
+	return TransferModel{
+		ID:             transfer.ID().String(),
+		FromLocationID: transfer.FromLocationID().String(),
+		ToLocationID:   transfer.ToLocationID().String(),
+		Note:           transfer.Note(),
+		CreatedBy:      transfer.CreatedBy(),
+		Lines:          lines,
+		CreatedAt:      transfer.CreatedAt(),
+	}
+}
+
+func (r *TransferRepository) toDomain(model *TransferModel) *location.Transfer {
+	var lines []location.TransferLine
+
+	for _, lineModel := range model.Lines {
+		lines = append(lines, location.TransferLine{
+			ProductID: shared.ProductID(lineModel.ProductID),
+			Quantity:  lineModel.Quantity,
+		})
+	}
+
+	return location.ReconstructTransfer(
+		location.TransferID(model.ID),
+		location.LocationID(model.FromLocationID),
+		location.LocationID(model.ToLocationID),
+		lines,
+		model.Note,
+		model.CreatedBy,
+		model.CreatedAt,
+	)
+}
+
+func (r *TransferRepository) toDomainList(models []TransferModel) []*location.Transfer {
+	var transfers []*location.Transfer
+
+	for _, model := range models {
+		transfers = append(transfers, r.toDomain(&model))
+	}
+
+	return transfers
+}
@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/location"
+	"POSFlowBackend/internal/domain/order"
+	"POSFlowBackend/internal/domain/purchasing"
+	"POSFlowBackend/internal/domain/stocktake"
+
+	"gorm.io/gorm"
+)
+
+// unitOfWork runs a function in a transaction, handing it repositories
+// bound to that transaction. Saves the repositories make in their own
+// transactions become savepoints of the outer one.
+type unitOfWork[S any] struct {
+	db     *gorm.DB
+	stores func(tx *gorm.DB) S
+}
+
+func (u *unitOfWork[S]) Do(fn func(stores S) error) error {
+	return u.db.Transaction(func(tx *gorm.DB) error {
+		return fn(u.stores(tx))
+	})
+}
+
+// NewOrderUnitOfWork implements order.UnitOfWork
+func NewOrderUnitOfWork(db *gorm.DB) order.UnitOfWork {
+	return &unitOfWork[order.Stores]{
+		db: db,
+		stores: func(tx *gorm.DB) order.Stores {
+			return order.Stores{
+				Orders:      NewOrderRepository(tx),
+				Tickets:     NewTicketRepository(tx),
+				Products:    NewProductRepository(tx),
+				Ingredients: NewIngredientRepository(tx),
+				Recipes:     NewRecipeRepository(tx),
+				Locations:   NewLocationRepository(tx),
+				StockLevels: NewStockLevelRepository(tx),
+				Transfers:   NewTransferRepository(tx),
+			}
+		},
+	}
+}
+
+// NewStocktakeUnitOfWork implements stocktake.UnitOfWork
+func NewStocktakeUnitOfWork(db *gorm.DB) stocktake.UnitOfWork {
+	return &unitOfWork[stocktake.Stores]{
+		db: db,
+		stores: func(tx *gorm.DB) stocktake.Stores {
+			return stocktake.Stores{
+				Stocktakes:  NewStocktakeRepository(tx),
+				Products:    NewProductRepository(tx),
+				Locations:   NewLocationRepository(tx),
+				StockLevels: NewStockLevelRepository(tx),
+				Transfers:   NewTransferRepository(tx),
+			}
+		},
+	}
+}
+
+// NewLocationUnitOfWork implements location.UnitOfWork
+func NewLocationUnitOfWork(db *gorm.DB) location.UnitOfWork {
+	return &unitOfWork[location.Stores]{
+		db: db,
+		stores: func(tx *gorm.DB) location.Stores {
+			return location.Stores{
+				Locations:   NewLocationRepository(tx),
+				StockLevels: NewStockLevelRepository(tx),
+				Transfers:   NewTransferRepository(tx),
+				Products:    NewProductRepository(tx),
+			}
+		},
+	}
+}
+
+// NewPurchasingUnitOfWork implements purchasing.UnitOfWork
+func NewPurchasingUnitOfWork(db *gorm.DB) purchasing.UnitOfWork {
+	return &unitOfWork[purchasing.Stores]{
+		db: db,
+		stores: func(tx *gorm.DB) purchasing.Stores {
+			return purchasing.Stores{
+				PurchaseOrders: NewPurchaseOrderRepository(tx),
+				Products:       NewProductRepository(tx),
+				Locations:      NewLocationRepository(tx),
+				StockLevels:    NewStockLevelRepository(tx),
+				Transfers:      NewTransferRepository(tx),
+			}
+		},
+	}
+}
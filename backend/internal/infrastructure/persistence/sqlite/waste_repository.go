@@ -0,0 +1,109 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/waste"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type WasteRepository struct {
+	db *gorm.DB
+}
+
+func NewWasteRepository(db *gorm.DB) *WasteRepository {
+	return &WasteRepository{db: db}
+}
+
+// Save implements waste.WasteRepository
+func (r *WasteRepository) Save(entry *waste.WasteEntry) error {
+	model := r.toModel(entry)
+
+	// Upsert: Update if exists, insert if not
+	return r.db.Save(&model).Error
+}
+
+// FindByID implements waste.WasteRepository
+func (r *WasteRepository) FindByID(id waste.WasteID) (*waste.WasteEntry, error) {
+	var model WasteEntryModel
+
+	result := r.db.Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model), nil
+}
+
+// FindByDateRange implements waste.WasteRepository
+func (r *WasteRepository) FindByDateRange(start, end time.Time) ([]*waste.WasteEntry, error) {
+	var models []WasteEntryModel
+
+	result := r.db.
+		Where("recorded_at >= ? AND recorded_at < ?", start, end).
+		Order("recorded_at desc").
+		Find(&models)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// ExistsByProduct implements waste.WasteRepository
+func (r *WasteRepository) ExistsByProduct(productID shared.ProductID) (bool, error) {
+	var count int64
+
+	result := r.db.Model(&WasteEntryModel{}).
+		Where("product_id = ?", productID.String()).
+		Count(&count)
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return count > 0, nil
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *WasteRepository) toModel(entry *waste.WasteEntry) WasteEntryModel {
+	return WasteEntryModel{
+		ID:          entry.ID().String(),
+		ProductID:   entry.ProductID().String(),
+		Quantity:    entry.Quantity(),
+		Reason:      string(entry.Reason()),
+		StaffMember: entry.StaffMember(),
+		Notes:       entry.Notes(),
+		UnitCost:    entry.UnitCost().Amount,
+		RecordedAt:  entry.RecordedAt(),
+	}
+}
+
+func (r *WasteRepository) toDomain(model *WasteEntryModel) *waste.WasteEntry {
+	return waste.ReconstructWasteEntry(
+		waste.WasteID(model.ID),
+		shared.ProductID(model.ProductID),
+		model.Quantity,
+		waste.Reason(model.Reason),
+		model.StaffMember,
+		model.Notes,
+		shared.Money{Amount: model.UnitCost, Currency: "USD"},
+		model.RecordedAt,
+	)
+}
+
+func (r *WasteRepository) toDomainList(models []WasteEntryModel) []*waste.WasteEntry {
+	var entries []*waste.WasteEntry
+
+	for _, model := range models {
+		entries = append(entries, r.toDomain(&model))
+	}
+
+	return entries
+}
@@ -0,0 +1,228 @@
+package sqlite
+
+import (
+	"POSFlowBackend/internal/domain/event"
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/webhook"
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type WebhookSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookSubscriptionRepository(db *gorm.DB) *WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{db: db}
+}
+
+// Save implements webhook.SubscriptionRepository
+func (r *WebhookSubscriptionRepository) Save(sub *webhook.Subscription) error {
+	model := r.toModel(sub)
+	return r.db.Save(&model).Error
+}
+
+// FindByID implements webhook.SubscriptionRepository
+func (r *WebhookSubscriptionRepository) FindByID(id webhook.SubscriptionID) (*webhook.Subscription, error) {
+	var model WebhookSubscriptionModel
+
+	result := r.db.Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model)
+}
+
+// FindAll implements webhook.SubscriptionRepository
+func (r *WebhookSubscriptionRepository) FindAll() ([]*webhook.Subscription, error) {
+	var models []WebhookSubscriptionModel
+
+	result := r.db.Order("created_at asc").Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	subs := make([]*webhook.Subscription, 0, len(models))
+	for _, model := range models {
+		sub, err := r.toDomain(&model)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+	return subs, nil
+}
+
+// Delete implements webhook.SubscriptionRepository
+func (r *WebhookSubscriptionRepository) Delete(id webhook.SubscriptionID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", id.String()).Delete(&WebhookSubscriptionModel{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return shared.ErrNotFound
+		}
+
+		return tx.Where("subscription_id = ?", id.String()).Delete(&WebhookDeliveryModel{}).Error
+	})
+}
+
+type WebhookDeliveryRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookDeliveryRepository(db *gorm.DB) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{db: db}
+}
+
+// Save implements webhook.DeliveryRepository
+func (r *WebhookDeliveryRepository) Save(delivery *webhook.Delivery) error {
+	model := r.toModel(delivery)
+	return r.db.Save(&model).Error
+}
+
+// FindByID implements webhook.DeliveryRepository
+func (r *WebhookDeliveryRepository) FindByID(id webhook.DeliveryID) (*webhook.Delivery, error) {
+	var model WebhookDeliveryModel
+
+	result := r.db.Where("id = ?", id.String()).First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, shared.ErrNotFound
+		}
+		return nil, result.Error
+	}
+
+	return r.toDomain(&model)
+}
+
+// FindBySubscription implements webhook.DeliveryRepository
+func (r *WebhookDeliveryRepository) FindBySubscription(id webhook.SubscriptionID, status webhook.DeliveryStatus, limit int) ([]*webhook.Delivery, error) {
+	var models []WebhookDeliveryModel
+
+	query := r.db.Where("subscription_id = ?", id.String())
+	if status != "" {
+		query = query.Where("status = ?", string(status))
+	}
+
+	result := query.Order("created_at desc, event_id desc").Limit(limit).Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models)
+}
+
+// FindDue implements webhook.DeliveryRepository
+func (r *WebhookDeliveryRepository) FindDue(now time.Time, limit int) ([]*webhook.Delivery, error) {
+	var models []WebhookDeliveryModel
+
+	result := r.db.Where("status = ? AND next_attempt_at <= ?", string(webhook.DeliveryPending), now).
+		Order("next_attempt_at asc").
+		Limit(limit).
+		Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return r.toDomainList(models)
+}
+
+// --- Mappers: Domain Entity ↔ Database Model ---
+
+func (r *WebhookSubscriptionRepository) toModel(sub *webhook.Subscription) WebhookSubscriptionModel {
+	topicsJSON, _ := json.Marshal(sub.Topics())
+
+	return WebhookSubscriptionModel{
+		ID:          sub.ID().String(),
+		URL:         sub.URL(),
+		Secret:      sub.Secret(),
+		Topics:      string(topicsJSON),
+		Description: sub.Description(),
+		Active:      sub.IsActive(),
+		CreatedAt:   sub.CreatedAt(),
+		UpdatedAt:   sub.UpdatedAt(),
+	}
+}
+
+func (r *WebhookSubscriptionRepository) toDomain(model *WebhookSubscriptionModel) (*webhook.Subscription, error) {
+	topics := []event.Topic{}
+	if model.Topics != "" {
+		if err := json.Unmarshal([]byte(model.Topics), &topics); err != nil {
+			return nil, err
+		}
+	}
+
+	return webhook.ReconstructSubscription(
+		webhook.SubscriptionID(model.ID),
+		model.URL,
+		model.Secret,
+		topics,
+		model.Description,
+		model.Active,
+		model.CreatedAt,
+		model.UpdatedAt,
+	), nil
+}
+
+func (r *WebhookDeliveryRepository) toModel(delivery *webhook.Delivery) WebhookDeliveryModel {
+	attemptsJSON, _ := json.Marshal(delivery.Attempts())
+
+	return WebhookDeliveryModel{
+		ID:             delivery.ID().String(),
+		SubscriptionID: delivery.SubscriptionID().String(),
+		EventID:        delivery.EventID(),
+		EventName:      delivery.EventName().String(),
+		Payload:        string(delivery.Payload()),
+		Status:         string(delivery.Status()),
+		Attempts:       string(attemptsJSON),
+		Tries:          delivery.Tries(),
+		NextAttemptAt:  delivery.NextAttemptAt(),
+		CreatedAt:      delivery.CreatedAt(),
+		UpdatedAt:      delivery.UpdatedAt(),
+	}
+}
+
+func (r *WebhookDeliveryRepository) toDomain(model *WebhookDeliveryModel) (*webhook.Delivery, error) {
+	attempts := []webhook.Attempt{}
+	if model.Attempts != "" {
+		if err := json.Unmarshal([]byte(model.Attempts), &attempts); err != nil {
+			return nil, err
+		}
+	}
+
+	return webhook.ReconstructDelivery(
+		webhook.DeliveryID(model.ID),
+		webhook.SubscriptionID(model.SubscriptionID),
+		model.EventID,
+		event.Topic(model.EventName),
+		[]byte(model.Payload),
+		webhook.DeliveryStatus(model.Status),
+		attempts,
+		model.Tries,
+		model.NextAttemptAt,
+		model.CreatedAt,
+		model.UpdatedAt,
+	), nil
+}
+
+func (r *WebhookDeliveryRepository) toDomainList(models []WebhookDeliveryModel) ([]*webhook.Delivery, error) {
+	deliveries := make([]*webhook.Delivery, 0, len(models))
+
+	for _, model := range models {
+		delivery, err := r.toDomain(&model)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,43 @@
+package printer
+
+// pc858 lists the characters of code page 858 from 0x80 to 0xFF: code page
+// 850 (multilingual Latin-1) with the euro sign at 0xD5
+const pc858 = "ÇüéâäàåçêëèïîìÄÅ" +
+	"ÉæÆôöòûùÿÖÜø£Ø×ƒ" +
+	"áíóúñÑªº¿®¬½¼¡«»" +
+	"░▒▓│┤ÁÂÀ©╣║╗╝¢¥┐" +
+	"└┴┬├─┼ãÃ╚╔╩╦╠═╬¤" +
+	"ðÐÊËÈ€ÍÎÏ┘┌█▄¦Ì▀" +
+	"ÓßÔÒõÕµþÞÚÛÙýÝ¯´" +
+	"­±‗¾¶§÷¸°¨·¹³²■ "
+
+var (
+	pc858Encode = map[rune]byte{}
+	pc858Decode [128]rune
+)
+
+func init() {
+	i := 0
+	for _, r := range pc858 {
+		pc858Encode[r] = byte(0x80 + i)
+		pc858Decode[i] = r
+		i++
+	}
+}
+
+// encodeText converts text to code page 858, replacing characters the
+// printer cannot show with '?'
+func encodeText(text string) []byte {
+	out := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r >= 0x20 && r < 0x7f:
+			out = append(out, byte(r))
+		case pc858Encode[r] != 0:
+			out = append(out, pc858Encode[r])
+		default:
+			out = append(out, '?')
+		}
+	}
+	return out
+}
@@ -0,0 +1,32 @@
+package printer
+
+import (
+	"POSFlowBackend/internal/domain/printing"
+	"POSFlowBackend/internal/domain/shared"
+	"fmt"
+	"time"
+)
+
+// Connector opens kitchen printers as ESC/POS printers over their driver.
+// Memory printers all print to the same shared MemoryPrinter.
+type Connector struct {
+	timeout time.Duration
+	memory  *MemoryPrinter
+}
+
+func NewConnector(timeout time.Duration, memory *MemoryPrinter) *Connector {
+	return &Connector{timeout: timeout, memory: memory}
+}
+
+// Connect implements printing.Connector
+func (c *Connector) Connect(p *printing.KitchenPrinter) (printing.Printer, error) {
+	switch p.Driver() {
+	case printing.DriverTCP:
+		return NewESCPOSPrinter(TCPDialer(p.Address(), c.timeout)), nil
+	case printing.DriverFile:
+		return NewESCPOSPrinter(FileDialer(p.Address())), nil
+	case printing.DriverMemory:
+		return NewESCPOSPrinter(c.memory.Dial), nil
+	}
+	return nil, fmt.Errorf("%w: unknown printer driver %s", shared.ErrDeviceUnavailable, p.Driver())
+}
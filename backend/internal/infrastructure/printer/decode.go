@@ -0,0 +1,47 @@
+package printer
+
+import "strings"
+
+// commandLengths gives the number of parameter bytes after the ESC/POS
+// commands this package sends, keyed by prefix and command byte
+var commandLengths = map[[2]byte]int{
+	{0x1b, '@'}: 0,
+	{0x1b, 't'}: 1,
+	{0x1b, 'a'}: 1,
+	{0x1b, 'E'}: 1,
+	{0x1b, 'd'}: 1,
+	{0x1b, 'p'}: 3,
+	{0x1d, '!'}: 1,
+	{0x1d, 'V'}: 2,
+}
+
+// PlainText strips the commands from an ESC/POS job and decodes its text,
+// showing what a printer would have printed. It is meant for inspecting
+// jobs from the memory printer or a fake network printer.
+func PlainText(job []byte) string {
+	var b strings.Builder
+	for i := 0; i < len(job); i++ {
+		c := job[i]
+		switch {
+		case c == 0x1b || c == 0x1d:
+			if i+1 >= len(job) {
+				return b.String()
+			}
+			n, known := commandLengths[[2]byte{c, job[i+1]}]
+			if !known {
+				n = 0
+			}
+			if c == 0x1d && job[i+1] == 'V' && i+2 < len(job) && job[i+2] < 'A' {
+				n = 1 // GS V m without feed amount
+			}
+			i += 1 + n
+		case c == '\n':
+			b.WriteByte('\n')
+		case c >= 0x80:
+			b.WriteRune(pc858Decode[c-0x80])
+		case c >= 0x20 && c < 0x7f:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
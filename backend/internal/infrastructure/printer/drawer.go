@@ -0,0 +1,75 @@
+package printer
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"time"
+)
+
+// cmdDrawerKick is ESC p m t1 t2: pulse drawer pin m (0 = connector pin 2)
+// on for t1×2 ms and off for t2×2 ms, which suits most solenoid drawers
+var cmdDrawerKick = []byte{0x1b, 'p', 0, 25, 250}
+
+// ESCPOSDrawer is a cash drawer plugged into the DK port of a receipt
+// printer; the printer fires the drawer when it receives the kick command.
+// It shares the receipt printer, so kicks and receipts don't interleave.
+type ESCPOSDrawer struct {
+	printer *ESCPOSPrinter
+}
+
+func NewESCPOSDrawer(printer *ESCPOSPrinter) *ESCPOSDrawer {
+	return &ESCPOSDrawer{printer: printer}
+}
+
+// Open implements peripheral.CashDrawer
+func (d *ESCPOSDrawer) Open() error {
+	return d.printer.Send(cmdDrawerKick)
+}
+
+// DrawerSimulator is an in-memory printer with a cash drawer attached, for
+// development and tests without hardware. Its Dial method plugs into
+// ESCPOSPrinter and counts the kick pulses found in each job.
+type DrawerSimulator struct {
+	mu         sync.Mutex
+	kicks      int
+	lastKickAt time.Time
+}
+
+func NewDrawerSimulator() *DrawerSimulator {
+	return &DrawerSimulator{}
+}
+
+// Dial implements Dialer; kicks are counted when the connection is closed
+func (s *DrawerSimulator) Dial() (io.WriteCloser, error) {
+	return &drawerJob{simulator: s}, nil
+}
+
+// Kicks returns how many times the drawer was fired and when it last was
+func (s *DrawerSimulator) Kicks() (int, time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.kicks, s.lastKickAt
+}
+
+type drawerJob struct {
+	simulator *DrawerSimulator
+	buf       bytes.Buffer
+}
+
+func (j *drawerJob) Write(p []byte) (int, error) {
+	return j.buf.Write(p)
+}
+
+func (j *drawerJob) Close() error {
+	kicks := bytes.Count(j.buf.Bytes(), cmdDrawerKick[:2])
+	if kicks == 0 {
+		return nil
+	}
+
+	j.simulator.mu.Lock()
+	defer j.simulator.mu.Unlock()
+	j.simulator.kicks += kicks
+	j.simulator.lastKickAt = time.Now()
+	return nil
+}
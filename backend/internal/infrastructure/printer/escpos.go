@@ -0,0 +1,108 @@
+package printer
+
+import (
+	"POSFlowBackend/internal/domain/printing"
+	"POSFlowBackend/internal/domain/shared"
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Dialer opens the connection to a printer for one print job
+type Dialer func() (io.WriteCloser, error)
+
+// ESC/POS commands, as understood by Epson TM printers and the many
+// compatible receipt and kitchen printers
+var (
+	cmdInit       = []byte{0x1b, '@'}     // ESC @: reset to defaults
+	cmdCodePage   = []byte{0x1b, 't', 19} // ESC t 19: PC858, Latin-1 with the euro sign
+	cmdAlignLeft  = []byte{0x1b, 'a', 0}  // ESC a n: justification
+	cmdAlignMid   = []byte{0x1b, 'a', 1}
+	cmdAlignRight = []byte{0x1b, 'a', 2}
+	cmdBoldOn     = []byte{0x1b, 'E', 1} // ESC E n: emphasized
+	cmdBoldOff    = []byte{0x1b, 'E', 0}
+	cmdSizeLarge  = []byte{0x1d, '!', 0x11} // GS ! n: double width and height
+	cmdSizeNormal = []byte{0x1d, '!', 0x00}
+	cmdFeedCut    = []byte{0x1d, 'V', 66, 4} // GS V 66 n: feed n lines, then partial cut
+	cmdFeed       = []byte{0x1b, 'd', 4}     // ESC d n: feed n lines
+)
+
+// ESCPOSPrinter prints documents on a printer speaking ESC/POS. A new
+// connection is opened for each job, which is how raw port 9100 and
+// device file printers expect to be driven.
+type ESCPOSPrinter struct {
+	dial Dialer
+
+	mu sync.Mutex
+}
+
+func NewESCPOSPrinter(dial Dialer) *ESCPOSPrinter {
+	return &ESCPOSPrinter{dial: dial}
+}
+
+// Print implements printing.Printer
+func (p *ESCPOSPrinter) Print(doc *printing.Document) error {
+	return p.Send(Encode(doc))
+}
+
+// Send writes a raw ESC/POS job to the printer
+func (p *ESCPOSPrinter) Send(job []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	conn, err := p.dial()
+	if err != nil {
+		return fmt.Errorf("%w: %v", shared.ErrDeviceUnavailable, err)
+	}
+	if _, err := conn.Write(job); err != nil {
+		conn.Close()
+		return fmt.Errorf("%w: %v", shared.ErrDeviceUnavailable, err)
+	}
+	if err := conn.Close(); err != nil {
+		return fmt.Errorf("%w: %v", shared.ErrDeviceUnavailable, err)
+	}
+	return nil
+}
+
+// Encode renders a document to an ESC/POS byte stream
+func Encode(doc *printing.Document) []byte {
+	var buf bytes.Buffer
+	buf.Write(cmdInit)
+	buf.Write(cmdCodePage)
+
+	for _, line := range doc.Lines() {
+		switch line.Align {
+		case printing.AlignCenter:
+			buf.Write(cmdAlignMid)
+		case printing.AlignRight:
+			buf.Write(cmdAlignRight)
+		default:
+			buf.Write(cmdAlignLeft)
+		}
+		if line.Bold {
+			buf.Write(cmdBoldOn)
+		}
+		if line.Large {
+			buf.Write(cmdSizeLarge)
+		}
+
+		buf.Write(encodeText(line.Text))
+		buf.WriteByte('\n')
+
+		if line.Large {
+			buf.Write(cmdSizeNormal)
+		}
+		if line.Bold {
+			buf.Write(cmdBoldOff)
+		}
+	}
+
+	buf.Write(cmdAlignLeft)
+	if doc.Cut() {
+		buf.Write(cmdFeedCut)
+	} else {
+		buf.Write(cmdFeed)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,66 @@
+package printer
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// memoryJobLimit is how many jobs the memory printer keeps
+const memoryJobLimit = 50
+
+// MemoryPrinter is an in-memory printer for development and tests without
+// hardware. Its Dial method plugs into ESCPOSPrinter, so jobs are encoded
+// exactly as for a real printer and can be inspected afterwards.
+type MemoryPrinter struct {
+	mu   sync.Mutex
+	jobs [][]byte
+}
+
+func NewMemoryPrinter() *MemoryPrinter {
+	return &MemoryPrinter{}
+}
+
+// Dial implements Dialer; the job is stored when the connection is closed
+func (m *MemoryPrinter) Dial() (io.WriteCloser, error) {
+	return &memoryJob{printer: m}, nil
+}
+
+// Jobs returns the stored jobs, oldest first
+func (m *MemoryPrinter) Jobs() [][]byte {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	jobs := make([][]byte, len(m.jobs))
+	copy(jobs, m.jobs)
+	return jobs
+}
+
+// Clear discards the stored jobs
+func (m *MemoryPrinter) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.jobs = nil
+}
+
+func (m *MemoryPrinter) store(job []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.jobs = append(m.jobs, job)
+	if len(m.jobs) > memoryJobLimit {
+		m.jobs = m.jobs[len(m.jobs)-memoryJobLimit:]
+	}
+}
+
+type memoryJob struct {
+	printer *MemoryPrinter
+	buf     bytes.Buffer
+}
+
+func (j *memoryJob) Write(p []byte) (int, error) {
+	return j.buf.Write(p)
+}
+
+func (j *memoryJob) Close() error {
+	j.printer.store(j.buf.Bytes())
+	return nil
+}
@@ -0,0 +1,32 @@
+package printer
+
+import (
+	"io"
+	"net"
+	"os"
+	"time"
+)
+
+// TCPDialer connects to a network printer's raw port, usually host:9100.
+// Writes fail after the timeout so an unplugged printer doesn't hang a job.
+func TCPDialer(address string, timeout time.Duration) Dialer {
+	return func() (io.WriteCloser, error) {
+		conn, err := net.DialTimeout("tcp", address, timeout)
+		if err != nil {
+			return nil, err
+		}
+		if err := conn.SetWriteDeadline(time.Now().Add(timeout)); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return conn, nil
+	}
+}
+
+// FileDialer writes jobs to a printer device such as /dev/usb/lp0, or
+// appends them to a regular file to capture the raw output
+func FileDialer(path string) Dialer {
+	return func() (io.WriteCloser, error) {
+		return os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	}
+}
@@ -0,0 +1,162 @@
+package realtime
+
+import (
+	"POSFlowBackend/internal/domain/event"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+)
+
+// ResetTopic is sent to a reconnecting client whose missed events are no
+// longer buffered; it should reload its state instead of replaying
+const ResetTopic = "stream.reset"
+
+// subscriberBuffer is how many events a client may fall behind before it is
+// dropped; it reconnects with its last event ID and catches up from history
+const subscriberBuffer = 64
+
+// Event is a published event as delivered to clients
+type Event struct {
+	ID         uint64          `json:"id"`
+	Topic      string          `json:"topic"`
+	Data       json.RawMessage `json:"data"`
+	OccurredAt time.Time       `json:"occurred_at"`
+}
+
+// Subscription receives the events matching its topic filters
+type Subscription struct {
+	// Replay holds the events missed since the client's last event ID, oldest first
+	Replay  []Event
+	filters []string
+	events  chan Event
+}
+
+// Events delivers live events; it is closed when the subscriber is dropped
+func (s *Subscription) Events() <-chan Event { return s.events }
+
+func (s *Subscription) wants(topic string) bool {
+	if len(s.filters) == 0 {
+		return true
+	}
+	for _, f := range s.filters {
+		if event.Topic(topic).Matches(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// Broker fans events out to subscribers and keeps the most recent ones so
+// clients can resume from their last event ID after a reconnect
+type Broker struct {
+	mu          sync.Mutex
+	nextID      uint64
+	history     []Event // contiguous IDs, oldest first
+	historySize int
+	subscribers map[*Subscription]struct{}
+}
+
+func NewBroker(historySize int) *Broker {
+	return &Broker{
+		// Seed from the clock so IDs keep increasing across restarts and a
+		// client resuming from before a restart is told to reload
+		nextID:      uint64(time.Now().UnixMilli()),
+		historySize: historySize,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish implements event.Publisher
+func (b *Broker) Publish(topic event.Topic, payload interface{}) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		log.Printf("Error encoding %s event: %v", topic, err)
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ev := Event{
+		ID:         b.nextID,
+		Topic:      topic.String(),
+		Data:       data,
+		OccurredAt: time.Now(),
+	}
+	b.nextID++
+
+	b.history = append(b.history, ev)
+	if len(b.history) > b.historySize {
+		b.history = b.history[len(b.history)-b.historySize:]
+	}
+
+	for sub := range b.subscribers {
+		if !sub.wants(ev.Topic) {
+			continue
+		}
+		select {
+		case sub.events <- ev:
+		default:
+			// Too far behind: drop it and let the client resume from history
+			b.remove(sub)
+		}
+	}
+}
+
+// Forward implements event.Handler, relaying a domain event to clients with
+// its stored payload
+func (b *Broker) Forward(msg event.Message) error {
+	b.Publish(msg.Name, msg.Payload)
+	return nil
+}
+
+// Subscribe registers a subscriber for the given topic filters (all topics
+// when empty). A non-zero lastEventID replays what the client missed, or a
+// single ResetTopic event when those events are no longer buffered.
+func (b *Broker) Subscribe(filters []string, lastEventID uint64) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub := &Subscription{
+		filters: filters,
+		events:  make(chan Event, subscriberBuffer),
+	}
+
+	if lastEventID != 0 {
+		latest := b.nextID - 1
+		oldest := b.nextID - uint64(len(b.history))
+		switch {
+		case lastEventID > latest || lastEventID+1 < oldest:
+			sub.Replay = []Event{{
+				ID:         latest,
+				Topic:      ResetTopic,
+				Data:       json.RawMessage("{}"),
+				OccurredAt: time.Now(),
+			}}
+		default:
+			for _, ev := range b.history[lastEventID+1-oldest:] {
+				if sub.wants(ev.Topic) {
+					sub.Replay = append(sub.Replay, ev)
+				}
+			}
+		}
+	}
+
+	b.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// Unsubscribe removes a subscriber; it is safe to call more than once
+func (b *Broker) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.remove(sub)
+}
+
+func (b *Broker) remove(sub *Subscription) {
+	if _, ok := b.subscribers[sub]; ok {
+		delete(b.subscribers, sub)
+		close(sub.events)
+	}
+}
@@ -0,0 +1,146 @@
+package scale
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/weighing"
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Dialer opens the connection to a scale
+type Dialer func() (io.ReadWriteCloser, error)
+
+// SICSScale reads a scale speaking MT-SICS, the ASCII protocol of Mettler
+// Toledo and most compatible retail scales. Each read sends "SI" (send weight
+// immediately) and parses a reply such as "S S      0.350 kg", where the
+// second field is S for a stable weight or D while the load is moving.
+//
+// The connection is opened on first use and reopened after any error, so a
+// scale that is switched off and on again recovers by itself.
+type SICSScale struct {
+	dial    Dialer
+	timeout time.Duration
+
+	mu     sync.Mutex
+	conn   io.ReadWriteCloser
+	reader *bufio.Reader
+}
+
+func NewSICSScale(dial Dialer, timeout time.Duration) *SICSScale {
+	return &SICSScale{dial: dial, timeout: timeout}
+}
+
+// Read implements weighing.Scale
+func (s *SICSScale) Read() (weighing.Reading, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		conn, err := s.dial()
+		if err != nil {
+			return weighing.Reading{}, fmt.Errorf("%w: %v", shared.ErrDeviceUnavailable, err)
+		}
+		s.conn = conn
+		s.reader = bufio.NewReader(conn)
+	}
+
+	line, err := s.exchange("SI")
+	if err != nil {
+		s.close()
+		return weighing.Reading{}, fmt.Errorf("%w: %v", shared.ErrDeviceUnavailable, err)
+	}
+
+	return parseSICSWeight(line, time.Now())
+}
+
+// Close releases the connection to the scale
+func (s *SICSScale) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.close()
+}
+
+func (s *SICSScale) close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	s.reader = nil
+	return err
+}
+
+// exchange sends a command and reads one CR LF terminated reply
+func (s *SICSScale) exchange(command string) (string, error) {
+	if d, ok := s.conn.(interface{ SetDeadline(time.Time) error }); ok {
+		_ = d.SetDeadline(time.Now().Add(s.timeout))
+	}
+
+	if _, err := io.WriteString(s.conn, command+"\r\n"); err != nil {
+		return "", err
+	}
+
+	line, err := s.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// parseSICSWeight parses the reply to an S or SI command
+func parseSICSWeight(line string, now time.Time) (weighing.Reading, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return weighing.Reading{}, fmt.Errorf("%w: empty reply from scale", shared.ErrDeviceUnavailable)
+	}
+
+	switch fields[0] {
+	case "ES", "ET", "EL":
+		return weighing.Reading{}, fmt.Errorf("%w: scale rejected the command (%s)", shared.ErrDeviceUnavailable, fields[0])
+	case "S":
+	default:
+		return weighing.Reading{}, fmt.Errorf("%w: unexpected reply %q", shared.ErrDeviceUnavailable, line)
+	}
+
+	if len(fields) < 2 {
+		return weighing.Reading{}, fmt.Errorf("%w: unexpected reply %q", shared.ErrDeviceUnavailable, line)
+	}
+
+	switch fields[1] {
+	case "S", "D":
+	case "I":
+		return weighing.Reading{}, fmt.Errorf("%w: scale is busy", shared.ErrNoStableWeight)
+	case "+":
+		return weighing.Reading{}, fmt.Errorf("%w: scale is overloaded", shared.ErrNoStableWeight)
+	case "-":
+		return weighing.Reading{}, fmt.Errorf("%w: scale is underloaded", shared.ErrNoStableWeight)
+	default:
+		return weighing.Reading{}, fmt.Errorf("%w: unexpected reply %q", shared.ErrDeviceUnavailable, line)
+	}
+
+	if len(fields) < 4 {
+		return weighing.Reading{}, fmt.Errorf("%w: unexpected reply %q", shared.ErrDeviceUnavailable, line)
+	}
+
+	weight, err := strconv.ParseFloat(fields[2], 64)
+	if err != nil {
+		return weighing.Reading{}, fmt.Errorf("%w: unreadable weight %q", shared.ErrDeviceUnavailable, fields[2])
+	}
+
+	unit := shared.UnitOfMeasure(fields[3])
+	if !unit.IsMass() {
+		return weighing.Reading{}, fmt.Errorf("%w: unsupported scale unit %q", shared.ErrDeviceUnavailable, fields[3])
+	}
+
+	return weighing.Reading{
+		Weight: weight,
+		Unit:   unit,
+		Stable: fields[1] == "S",
+		ReadAt: now,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package scale
+
+import (
+	"POSFlowBackend/internal/domain/shared"
+	"POSFlowBackend/internal/domain/weighing"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseSICSWeight(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		line    string
+		want    weighing.Reading
+		wantErr error
+	}{
+		{
+			name: "stable weight",
+			line: "S S     100.50 g",
+			want: weighing.Reading{Weight: 100.5, Unit: shared.UnitGram, Stable: true, ReadAt: now},
+		},
+		{
+			name: "dynamic weight",
+			line: "S D       0.355 kg",
+			want: weighing.Reading{Weight: 0.355, Unit: shared.UnitKilogram, Stable: false, ReadAt: now},
+		},
+		{name: "busy", line: "S I", wantErr: shared.ErrNoStableWeight},
+		{name: "overload", line: "S +", wantErr: shared.ErrNoStableWeight},
+		{name: "underload", line: "S -", wantErr: shared.ErrNoStableWeight},
+		{name: "syntax error", line: "ES", wantErr: shared.ErrDeviceUnavailable},
+		{name: "transmission error", line: "ET", wantErr: shared.ErrDeviceUnavailable},
+		{name: "logical error", line: "EL", wantErr: shared.ErrDeviceUnavailable},
+		{name: "volume unit", line: "S S     100.00 ml", wantErr: shared.ErrDeviceUnavailable},
+		{name: "piece unit", line: "S S       3 unit", wantErr: shared.ErrDeviceUnavailable},
+		{name: "unknown unit", line: "S S     100.00 lb", wantErr: shared.ErrDeviceUnavailable},
+		{name: "unreadable weight", line: "S S     1O0.00 g", wantErr: shared.ErrDeviceUnavailable},
+		{name: "missing unit", line: "S S     100.00", wantErr: shared.ErrDeviceUnavailable},
+		{name: "unknown status", line: "S X     100.00 g", wantErr: shared.ErrDeviceUnavailable},
+		{name: "unknown reply", line: "I4 A \"123\"", wantErr: shared.ErrDeviceUnavailable},
+		{name: "empty reply", line: "", wantErr: shared.ErrDeviceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSICSWeight(tt.line, now)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("parseSICSWeight(%q) error = %v, want %v", tt.line, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSICSWeight(%q) unexpected error: %v", tt.line, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseSICSWeight(%q) = %+v, want %+v", tt.line, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package scale
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+)
+
+// Simulator is an in-memory scale speaking MT-SICS, for development and
+// tests without hardware. Its Dial method plugs into SICSScale, so the
+// protocol handling is exercised exactly as with a real scale.
+type Simulator struct {
+	mu     sync.Mutex
+	weight float64
+	unit   string
+	stable bool
+}
+
+func NewSimulator() *Simulator {
+	return &Simulator{unit: "kg", stable: true}
+}
+
+// SetWeight places a load on the simulated scale, in kg
+func (s *Simulator) SetWeight(weight float64, stable bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.weight = weight
+	s.stable = stable
+}
+
+// Dial implements Dialer, serving the protocol over an in-memory pipe
+func (s *Simulator) Dial() (io.ReadWriteCloser, error) {
+	client, server := net.Pipe()
+	go s.serve(server)
+	return client, nil
+}
+
+func (s *Simulator) serve(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		if _, err := io.WriteString(conn, s.reply(strings.TrimSpace(line))+"\r\n"); err != nil {
+			return
+		}
+	}
+}
+
+// reply answers a command the way a SICS level 0 scale does
+func (s *Simulator) reply(command string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch command {
+	case "S":
+		if !s.stable {
+			return "S I"
+		}
+		return fmt.Sprintf("S S %10.3f %s", s.weight, s.unit)
+	case "SI":
+		status := "S"
+		if !s.stable {
+			status = "D"
+		}
+		return fmt.Sprintf("S %s %10.3f %s", status, s.weight, s.unit)
+	default:
+		return "ES"
+	}
+}
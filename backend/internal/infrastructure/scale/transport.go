@@ -0,0 +1,25 @@
+package scale
+
+import (
+	"io"
+	"net"
+	"os"
+	"time"
+)
+
+// SerialDialer opens a serial scale through its device file, e.g. /dev/ttyUSB0
+// or COM3. Line settings (usually 9600 baud, 8 data bits, no parity) are
+// taken from the operating system, configured with stty or the device manager.
+func SerialDialer(device string) Dialer {
+	return func() (io.ReadWriteCloser, error) {
+		return os.OpenFile(device, os.O_RDWR, 0)
+	}
+}
+
+// TCPDialer connects to a scale behind a serial-to-Ethernet converter or a
+// networked scale, given as host:port
+func TCPDialer(address string, timeout time.Duration) Dialer {
+	return func() (io.ReadWriteCloser, error) {
+		return net.DialTimeout("tcp", address, timeout)
+	}
+}
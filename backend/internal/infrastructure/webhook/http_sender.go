@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+)
+
+// HTTPSender posts webhook payloads over HTTP
+type HTTPSender struct {
+	client *http.Client
+}
+
+func NewHTTPSender(timeout time.Duration) *HTTPSender {
+	return &HTTPSender{client: &http.Client{Timeout: timeout}}
+}
+
+// Post implements webhook.Sender
+func (s *HTTPSender) Post(url string, headers map[string]string, body []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "POSFlow-Webhooks/1.0")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	return resp.StatusCode, nil
+}
@@ -146,6 +146,14 @@ class APIClient {
     });
   }
 
+  /**
+   * Gets the tables on the floor plan
+   * @returns {Promise<Object>}
+   */
+  async getTables() {
+    return this.request('/tables');
+  }
+
   /**
    * Updates order status
    * @param {number} orderId - Order ID
@@ -338,6 +346,26 @@ class APIClient {
       body: JSON.stringify({ available })
     });
   }
+
+  // ==================== Events ====================
+
+  /**
+   * Opens the server-sent event stream. The browser reconnects on its own
+   * and resumes from the last event ID it saw.
+   * @param {string[]} topics - Topic filters (e.g. ['order', 'stock.low'])
+   * @param {Function} onEvent - Called with (topic, data) for each event
+   * @returns {EventSource}
+   */
+  subscribeEvents(topics, onEvent) {
+    const query = topics.length ? `?topics=${encodeURIComponent(topics.join(','))}` : '';
+    const source = new EventSource(`${this.baseURL}/events${query}`);
+
+    ['order.created', 'order.status_changed', 'stock.low', 'product.updated', 'stream.reset'].forEach(topic => {
+      source.addEventListener(topic, (e) => onEvent(topic, JSON.parse(e.data)));
+    });
+
+    return source;
+  }
 }
 
 // Create global instance
@@ -6,6 +6,7 @@ document.addEventListener('DOMContentLoaded', () => {
 
 function initializeCustomer() {
   loadMenu();
+  loadTables();
 
   document.getElementById('placeOrder').addEventListener('click', placeOrder);
 }
@@ -21,6 +22,22 @@ async function loadMenu() {
   }
 }
 
+async function loadTables() {
+  try {
+    const response = await apiClient.getTables();
+    const tableSelect = document.getElementById('tableSelect');
+    (response.data?.tables || []).forEach(table => {
+      const option = document.createElement('option');
+      option.value = table.number;
+      option.textContent = table.area ? `${table.number} (${table.area})` : table.number;
+      tableSelect.appendChild(option);
+    });
+  } catch (error) {
+    // Without tables the order can still be placed as takeaway
+    console.error('Failed to load tables:', error);
+  }
+}
+
 function displayMenu(products) {
   const menuItems = document.getElementById('menuItems');
 
@@ -30,7 +47,7 @@ function displayMenu(products) {
   }
 
   menuItems.innerHTML = products.map(product => `
-    <div class="menu-item" onclick="addToCart(${product.id}, '${product.name}', ${product.price})">
+    <div class="menu-item" onclick="addToCart('${product.id}', '${product.name}', ${product.price})">
       <h4>${product.name}</h4>
       <p>$${product.price}</p>
     </div>
@@ -82,10 +99,12 @@ async function placeOrder() {
     return;
   }
 
+  // Dine-in orders must name a table on the floor plan
+  const tableNumber = document.getElementById('tableSelect').value;
   const orderData = {
-    table_number: 'Table 1', // You can add input for this
-    items: cart,
-    total: cart.reduce((sum, item) => sum + (item.price * item.quantity), 0)
+    channel: tableNumber ? 'dine_in' : 'takeaway',
+    table_number: tableNumber,
+    items: cart.map(item => ({ product_id: item.id, quantity: item.quantity }))
   };
 
   try {
@@ -5,8 +5,13 @@ document.addEventListener('DOMContentLoaded', () => {
 function initializeKitchen() {
   loadOrders();
 
-  // Refresh orders every 5 seconds
-  setInterval(loadOrders, 5000);
+  // Reload whenever an order changes; fall back to polling every 5 seconds
+  if (window.EventSource) {
+    const source = apiClient.subscribeEvents(['order'], () => loadOrders());
+    source.onopen = () => loadOrders();
+  } else {
+    setInterval(loadOrders, 5000);
+  }
 }
 
 async function loadOrders() {
@@ -32,12 +37,13 @@ function displayOrders(orders) {
 
   ordersList.innerHTML = orders.map(order => `
     <div class="order-card ${order.status}">
-      <h3>Order #${order.id}</h3>
+      <h3>${order.ticket_number || `Order #${order.id}`}</h3>
+      ${(order.allergen_warnings || []).map(w => `<p class="allergen-warning">⚠ ${w.message}</p>`).join('')}
       <p><strong>Table:</strong> ${order.table_number}</p>
       <p><strong>Status:</strong> ${order.status}</p>
       <p><strong>Total:</strong> $${order.total}</p>
-      <button onclick="updateStatus(${order.id}, 'preparing')">Start Preparing</button>
-      <button onclick="updateStatus(${order.id}, 'ready')">Mark Ready</button>
+      <button onclick="updateStatus('${order.id}', 'preparing')">Start Preparing</button>
+      <button onclick="updateStatus('${order.id}', 'ready')">Mark Ready</button>
     </div>
   `).join('');
 }